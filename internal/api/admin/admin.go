@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"net"
 	"net/http"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
+	"connectrpc.com/otelconnect"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
@@ -27,20 +29,24 @@ import (
 	adminv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1/adminv1beta1connect"
 	"github.com/artefactual-labs/ccp/internal/controller"
 	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/webhook"
+	"github.com/artefactual-labs/ccp/internal/workers"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
 // Server implements the Admin API.
 type Server struct {
-	logger logr.Logger
-	config Config
-	ctrl   *controller.Controller
-	store  store.Store
-	wf     *workflow.Document
-	form   *workflow.ProcessingConfigForm
-	server *http.Server
-	ln     net.Listener
-	v      *protovalidate.Validator
+	logger  logr.Logger
+	config  Config
+	ctrl    *controller.Controller
+	hooks   *webhook.Dispatcher
+	workers *workers.Registry
+	store   store.Store
+	wf      *workflow.Document
+	form    *workflow.ProcessingConfigForm
+	server  *http.Server
+	ln      net.Listener
+	v       *protovalidate.Validator
 
 	// cache provides an in-memory cache with expiration to prevent concurrent
 	// clients from overloading the system.
@@ -48,14 +54,16 @@ type Server struct {
 	wg    sync.WaitGroup
 }
 
-func New(logger logr.Logger, config Config, ctrl *controller.Controller, store store.Store, wf *workflow.Document, form *workflow.ProcessingConfigForm) (*Server, error) {
+func New(logger logr.Logger, config Config, ctrl *controller.Controller, hooks *webhook.Dispatcher, workers *workers.Registry, store store.Store, wf *workflow.Document, form *workflow.ProcessingConfigForm) (*Server, error) {
 	srv := &Server{
-		logger: logger,
-		config: config,
-		ctrl:   ctrl,
-		store:  store,
-		wf:     wf,
-		form:   form,
+		logger:  logger,
+		config:  config,
+		ctrl:    ctrl,
+		hooks:   hooks,
+		workers: workers,
+		store:   store,
+		wf:      wf,
+		form:    form,
 	}
 
 	if v, err := protovalidate.New(); err != nil {
@@ -81,10 +89,17 @@ var _ adminv1connect.AdminServiceHandler = (*Server)(nil)
 func (s *Server) Run() error {
 	compress1KB := connect.WithCompressMinBytes(1024)
 
+	// Spans are recorded with the global tracer provider.
+	tracing, err := otelconnect.NewInterceptor(otelconnect.WithoutMetrics())
+	if err != nil {
+		return err
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle(adminv1connect.NewAdminServiceHandler(
 		s,
 		compress1KB,
+		connect.WithInterceptors(tracing),
 	))
 	mux.Handle(grpchealth.NewHandler(
 		grpchealth.NewStaticChecker(adminv1connect.AdminServiceName),
@@ -100,7 +115,7 @@ func (s *Server) Run() error {
 	))
 
 	auth := authenticate(s.logger, s.store)
-	handler := authn.NewMiddleware(auth).Wrap(mux)
+	handler := authn.NewMiddleware(auth).Wrap(streaming(mux))
 
 	s.server = &http.Server{
 		Addr: s.config.Addr,
@@ -114,7 +129,6 @@ func (s *Server) Run() error {
 		MaxHeaderBytes:    8 * 1024, // 8KiB
 	}
 
-	var err error
 	if s.ln, err = net.Listen("tcp", s.config.Addr); err != nil {
 		return err
 	}
@@ -130,6 +144,20 @@ func (s *Server) Run() error {
 	return nil
 }
 
+// streaming lifts the server timeouts for the streaming procedures, they are
+// long-lived by design.
+func streaming(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case adminv1connect.AdminServiceWatchPackagesProcedure, adminv1connect.AdminServiceWatchPackageProcedure:
+			rc := http.NewResponseController(w)
+			_ = rc.SetReadDeadline(time.Time{})
+			_ = rc.SetWriteDeadline(time.Time{})
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) Addr() string {
 	return s.ln.Addr().String()
 }
@@ -140,6 +168,15 @@ func (s *Server) CreatePackage(ctx context.Context, req *connect.Request[adminv1
 	}
 
 	pkg, err := s.ctrl.Submit(ctx, req.Msg)
+	if errors.Is(err, controller.ErrDraining) {
+		return nil, connect.NewError(connect.CodeUnavailable, controller.ErrDraining)
+	}
+	if errors.Is(err, controller.ErrInvalidSource) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if errors.Is(err, controller.ErrInsufficientSpace) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeUnknown, nil)
 	}
@@ -149,6 +186,31 @@ func (s *Server) CreatePackage(ctx context.Context, req *connect.Request[adminv1
 	}), nil
 }
 
+func (s *Server) StartReingest(ctx context.Context, req *connect.Request[adminv1.StartReingestRequest]) (*connect.Response[adminv1.StartReingestResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id, err := s.ctrl.Reingest(ctx, req.Msg)
+	if errors.Is(err, controller.ErrDraining) {
+		return nil, connect.NewError(connect.CodeUnavailable, controller.ErrDraining)
+	}
+	if errors.Is(err, controller.ErrInvalidSource) || errors.Is(err, controller.ErrUnknownProcessingConfig) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if errors.Is(err, controller.ErrInsufficientSpace) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if err != nil {
+		s.logger.Error(err, "Failed to start reingest.", "path", req.Msg.Path)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.StartReingestResponse{
+		Id: id.String(),
+	}), nil
+}
+
 func (s *Server) ReadPackage(ctx context.Context, req *connect.Request[adminv1.ReadPackageRequest]) (*connect.Response[adminv1.ReadPackageResponse], error) {
 	if err := s.v.Validate(req.Msg); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -190,6 +252,10 @@ func (s *Server) ReadPackage(ctx context.Context, req *connect.Request[adminv1.R
 		resp.Decision = decisions
 	}
 
+	if ingest, ok := s.ctrl.PackageIngest(id); ok {
+		resp.Pkg.Ingest = ingest
+	}
+
 	return connect.NewResponse(resp), nil
 }
 
@@ -276,6 +342,137 @@ func (s *Server) ListProcessingConfigurationFields(ctx context.Context, req *con
 	}), nil
 }
 
+func (s *Server) ListQueuedPackages(ctx context.Context, req *connect.Request[adminv1.ListQueuedPackagesRequest]) (*connect.Response[adminv1.ListQueuedPackagesResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&adminv1.ListQueuedPackagesResponse{
+		Package: s.ctrl.QueuedPackages(),
+	}), nil
+}
+
+func (s *Server) PromotePackage(ctx context.Context, req *connect.Request[adminv1.PromotePackageRequest]) (*connect.Response[adminv1.PromotePackageResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var priority *int32
+	if req.Msg.Priority != nil {
+		priority = &req.Msg.Priority.Value
+	}
+
+	id := uuid.MustParse(req.Msg.Id)
+	pos, err := s.ctrl.PromotePackage(id, priority)
+	if errors.Is(err, controller.ErrNotQueued) {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	if err != nil {
+		s.logger.Error(err, "Failed to promote package.", "id", id)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.PromotePackageResponse{
+		Position: int32(pos), //nolint:gosec // (G115) no risk of overflow
+	}), nil
+}
+
+func (s *Server) CancelPackage(ctx context.Context, req *connect.Request[adminv1.CancelPackageRequest]) (*connect.Response[adminv1.CancelPackageResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := uuid.MustParse(req.Msg.Id)
+	err := s.ctrl.CancelPackage(ctx, id, req.Msg.Reject)
+	if errors.Is(err, controller.ErrUnknownPackage) {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	if err != nil {
+		s.logger.Error(err, "Failed to cancel package.", "id", id)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.CancelPackageResponse{}), nil
+}
+
+func (s *Server) RetryPackage(ctx context.Context, req *connect.Request[adminv1.RetryPackageRequest]) (*connect.Response[adminv1.RetryPackageResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := uuid.MustParse(req.Msg.Id)
+	var linkID uuid.UUID
+	if req.Msg.LinkId != "" {
+		linkID = uuid.MustParse(req.Msg.LinkId)
+	}
+
+	linkID, err := s.ctrl.RetryPackage(ctx, id, linkID, req.Msg.Priority)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	if errors.Is(err, controller.ErrNotFailed) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, controller.ErrNotFailed)
+	}
+	if errors.Is(err, controller.ErrUnknownLink) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, controller.ErrUnknownLink)
+	}
+	if errors.Is(err, controller.ErrDraining) {
+		return nil, connect.NewError(connect.CodeUnavailable, controller.ErrDraining)
+	}
+	if err != nil {
+		s.logger.Error(err, "Failed to retry package.", "id", id)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.RetryPackageResponse{
+		LinkId: linkID.String(),
+	}), nil
+}
+
+func (s *Server) WatchPackages(ctx context.Context, req *connect.Request[adminv1.WatchPackagesRequest], stream *connect.ServerStream[adminv1.WatchPackagesResponse]) error {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return s.watch(ctx, uuid.Nil, func(event *adminv1.PackageEvent) error {
+		return stream.Send(&adminv1.WatchPackagesResponse{Event: event})
+	})
+}
+
+func (s *Server) WatchPackage(ctx context.Context, req *connect.Request[adminv1.WatchPackageRequest], stream *connect.ServerStream[adminv1.WatchPackageResponse]) error {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return s.watch(ctx, uuid.MustParse(req.Msg.Id), func(event *adminv1.PackageEvent) error {
+		return stream.Send(&adminv1.WatchPackageResponse{Event: event})
+	})
+}
+
+// watch sends the events published by the controller until the client goes
+// away or the controller is closed.
+func (s *Server) watch(ctx context.Context, pkgID uuid.UUID, send func(*adminv1.PackageEvent) error) error {
+	sub := s.ctrl.Subscribe(pkgID)
+	defer s.ctrl.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return connect.NewError(connect.CodeResourceExhausted, err)
+				}
+				return nil
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (s *Server) Close(ctx context.Context) error {
 	if s.server != nil {
 		if err := s.server.Shutdown(ctx); err != nil {
@@ -15,8 +15,6 @@ import (
 	"github.com/artefactual-labs/ccp/internal/store"
 )
 
-var approveAIPReingestChainID = uuid.MustParse("260ef4ea-f87d-4acf-830d-d0de41e6d2af")
-
 // ApproveJob is obsolote and exists for backward-compatibility purposes.
 func (s *Server) ApproveJob(ctx context.Context, req *connect.Request[adminv1.ApproveJobRequest]) (*connect.Response[adminv1.ApproveJobResponse], error) {
 	if err := s.v.Validate(req.Msg); err != nil {
@@ -86,7 +84,8 @@ func (s *Server) ApproveTransferByPath(ctx context.Context, req *connect.Request
 	}), nil
 }
 
-// ApprovePartialReingest is obsolete and exists for backward-compatibility purposes.
+// ApprovePartialReingest is obsolete and exists for backward-compatibility
+// purposes, see StartReingest.
 func (s *Server) ApprovePartialReingest(ctx context.Context, req *connect.Request[adminv1.ApprovePartialReingestRequest]) (*connect.Response[adminv1.ApprovePartialReingestResponse], error) {
 	if err := s.v.Validate(req.Msg); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -103,9 +102,9 @@ func (s *Server) ApprovePartialReingest(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("unable to find awaiting job: %s", req.Msg.Id))
 	}
 
-	chain, ok := s.wf.Chains[approveAIPReingestChainID]
+	chain, ok := s.wf.Chains[controller.ApproveAIPReingestChainID]
 	if !ok {
-		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("unable to find reingest chain: %s", approveAIPReingestChainID.String()))
+		return nil, connect.NewError(connect.CodeUnknown, fmt.Errorf("unable to find reingest chain: %s", controller.ApproveAIPReingestChainID.String()))
 	}
 
 	jobID, _ := uuid.Parse(job.Id)
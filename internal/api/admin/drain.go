@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+)
+
+// DrainServer starts draining the controller, the server shuts down once the
+// processing stops, see controller.Controller.Drain.
+func (s *Server) DrainServer(ctx context.Context, req *connect.Request[adminv1.DrainServerRequest]) (*connect.Response[adminv1.DrainServerResponse], error) {
+	resp := &adminv1.DrainServerResponse{
+		ActivePackages:   int32(len(s.ctrl.ActivePackages())), //nolint:gosec // (G115) no risk of overflow
+		AwaitingPackages: int32(len(s.ctrl.Decisions())),      //nolint:gosec // (G115) no risk of overflow
+		QueuedPackages:   int32(len(s.ctrl.QueuedPackages())), //nolint:gosec // (G115) no risk of overflow
+	}
+
+	s.logger.Info("Drain requested.")
+	s.ctrl.Drain()
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+)
+
+// ListRetentionCandidates lists the entries that the retention policies would
+// remove now, see controller.Controller.RetentionCandidates.
+func (s *Server) ListRetentionCandidates(ctx context.Context, req *connect.Request[adminv1.ListRetentionCandidatesRequest]) (*connect.Response[adminv1.ListRetentionCandidatesResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	candidates, err := s.ctrl.RetentionCandidates()
+	if err != nil {
+		s.logger.Error(err, "Failed to list retention candidates.")
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	resp := &adminv1.ListRetentionCandidatesResponse{
+		Candidates: make([]*adminv1.RetentionCandidate, 0, len(candidates)),
+	}
+	for _, item := range candidates {
+		var id string
+		if item.PackageID != uuid.Nil {
+			id = item.PackageID.String()
+		}
+		resp.Candidates = append(resp.Candidates, &adminv1.RetentionCandidate{
+			Path:       item.Path,
+			Size:       item.Size,
+			ModifiedAt: timestamppb.New(item.ModTime),
+			PackageId:  id,
+			Reason:     item.Reason,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
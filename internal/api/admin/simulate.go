@@ -0,0 +1,94 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/controller"
+)
+
+func (s *Server) SimulateWorkflow(ctx context.Context, req *connect.Request[adminv1.SimulateWorkflowRequest]) (*connect.Response[adminv1.SimulateWorkflowResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	name := req.Msg.ProcessingConfig
+	if name == "" {
+		name = "default"
+	}
+	choices, err := s.ctrl.ProcessingConfig(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	config := controller.SimulationConfig{
+		WatchedDir:       req.Msg.GetWatchedDirectory(),
+		TransferType:     req.Msg.GetTransferType(),
+		ProcessingConfig: choices,
+		ExitCodes:        make(map[string][]int, len(req.Msg.ExitCodes)),
+		ResolveBlocking:  req.Msg.ResolveBlocking,
+	}
+	for key, item := range req.Msg.ExitCodes {
+		for _, code := range item.Codes {
+			config.ExitCodes[key] = append(config.ExitCodes[key], int(code))
+		}
+	}
+
+	sim, err := controller.Simulate(s.wf, config)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resp := &adminv1.SimulateWorkflowResponse{
+		Steps:      make([]*adminv1.SimulationStep, 0, len(sim.Steps)),
+		Blocking:   make([]*adminv1.SimulationStep, 0, len(sim.Blocking)),
+		Unresolved: make([]*adminv1.UnresolvedBranch, 0, len(sim.Unresolved)),
+		Outcome:    string(sim.Outcome),
+		Reason:     sim.Reason,
+	}
+	for _, step := range sim.Steps {
+		resp.Steps = append(resp.Steps, simulationStep(step))
+	}
+	for _, step := range sim.Blocking {
+		resp.Blocking = append(resp.Blocking, simulationStep(step))
+	}
+	for _, item := range sim.Unresolved {
+		branch := &adminv1.UnresolvedBranch{
+			LinkId:      item.LinkID.String(),
+			Description: item.Description,
+			Reason:      item.Reason,
+		}
+		if item.ExitCode != nil {
+			code := int32(*item.ExitCode) //nolint:gosec // (G115) exit codes are small.
+			branch.ExitCode = &code
+		}
+		resp.Unresolved = append(resp.Unresolved, branch)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func simulationStep(step *controller.SimulationStep) *adminv1.SimulationStep {
+	ret := &adminv1.SimulationStep{
+		ChainId:          step.ChainID.String(),
+		Manager:          step.Manager,
+		Description:      step.Description,
+		WatchedDirectory: step.WatchedDir,
+		Script:           step.Script,
+		ExitCode:         int32(step.ExitCode), //nolint:gosec // (G115) exit codes are small.
+		Decision:         step.Decision,
+		Choices:          step.Choices,
+	}
+	if step.LinkID != uuid.Nil {
+		ret.LinkId = step.LinkID.String()
+	}
+
+	return ret
+}
@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/webhook"
+)
+
+// defaultDeliveriesLimit is the number of deliveries listed when the client
+// does not set a limit.
+const defaultDeliveriesLimit = 100
+
+var webhookEvents = map[adminv1.WebhookEvent]string{
+	adminv1.WebhookEvent_WEBHOOK_EVENT_PACKAGE_DONE:      webhook.EventPackageDone,
+	adminv1.WebhookEvent_WEBHOOK_EVENT_PACKAGE_FAILED:    webhook.EventPackageFailed,
+	adminv1.WebhookEvent_WEBHOOK_EVENT_DECISION_AWAITING: webhook.EventDecisionAwaiting,
+}
+
+func webhookEventProto(name string) adminv1.WebhookEvent {
+	for event, item := range webhookEvents {
+		if item == name {
+			return event
+		}
+	}
+
+	return adminv1.WebhookEvent_WEBHOOK_EVENT_UNSPECIFIED
+}
+
+func (s *Server) webhookProto(wh *store.Webhook) *adminv1.Webhook {
+	ret := &adminv1.Webhook{
+		Id:       wh.ID.String(),
+		Url:      wh.URL,
+		ReadOnly: s.hooks.ReadOnly(wh.ID),
+	}
+	for _, item := range wh.Events {
+		ret.Events = append(ret.Events, webhookEventProto(item))
+	}
+	if !wh.CreatedAt.IsZero() {
+		ret.CreatedAt = timestamppb.New(wh.CreatedAt)
+	}
+
+	return ret
+}
+
+func (s *Server) CreateWebhook(ctx context.Context, req *connect.Request[adminv1.CreateWebhookRequest]) (*connect.Response[adminv1.CreateWebhookResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	events := make([]string, 0, len(req.Msg.Events))
+	for _, item := range req.Msg.Events {
+		events = append(events, webhookEvents[item])
+	}
+
+	wh, err := s.hooks.CreateWebhook(ctx, req.Msg.Url, req.Msg.Secret, events)
+	if err != nil {
+		s.logger.Error(err, "Failed to create webhook.", "url", req.Msg.Url)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.CreateWebhookResponse{
+		Webhook: s.webhookProto(wh),
+	}), nil
+}
+
+func (s *Server) ListWebhooks(ctx context.Context, req *connect.Request[adminv1.ListWebhooksRequest]) (*connect.Response[adminv1.ListWebhooksResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	webhooks, err := s.hooks.Webhooks(ctx)
+	if err != nil {
+		s.logger.Error(err, "Failed to list webhooks.")
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	resp := &adminv1.ListWebhooksResponse{
+		Webhooks: make([]*adminv1.Webhook, 0, len(webhooks)),
+	}
+	for _, wh := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, s.webhookProto(wh))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *connect.Request[adminv1.DeleteWebhookRequest]) (*connect.Response[adminv1.DeleteWebhookResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := uuid.MustParse(req.Msg.Id)
+	err := s.hooks.DeleteWebhook(ctx, id)
+	if errors.Is(err, webhook.ErrReadOnly) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, webhook.ErrReadOnly)
+	}
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	if err != nil {
+		s.logger.Error(err, "Failed to delete webhook.", "id", id)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	return connect.NewResponse(&adminv1.DeleteWebhookResponse{}), nil
+}
+
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *connect.Request[adminv1.ListWebhookDeliveriesRequest]) (*connect.Response[adminv1.ListWebhookDeliveriesResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var webhookID uuid.UUID
+	if req.Msg.WebhookId != "" {
+		webhookID = uuid.MustParse(req.Msg.WebhookId)
+	}
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultDeliveriesLimit
+	}
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, webhookID, limit)
+	if err != nil {
+		s.logger.Error(err, "Failed to list webhook deliveries.", "webhookID", webhookID)
+		return nil, connect.NewError(connect.CodeUnknown, nil)
+	}
+
+	resp := &adminv1.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*adminv1.WebhookDelivery, 0, len(deliveries)),
+	}
+	for _, item := range deliveries {
+		delivery := &adminv1.WebhookDelivery{
+			Id:         item.ID.String(),
+			WebhookId:  item.WebhookID.String(),
+			Event:      webhookEventProto(item.Event),
+			PackageId:  item.PackageID.String(),
+			Payload:    item.Payload,
+			Attempts:   int32(item.Attempts),
+			StatusCode: int32(item.StatusCode),
+			Error:      item.Error,
+			DeadLetter: item.DeadLetter,
+			CreatedAt:  timestamppb.New(item.CreatedAt),
+		}
+		if !item.CompletedAt.IsZero() {
+			delivery.CompletedAt = timestamppb.New(item.CompletedAt)
+		}
+		resp.Deliveries = append(resp.Deliveries, delivery)
+	}
+
+	return connect.NewResponse(resp), nil
+}
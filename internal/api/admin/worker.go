@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workers"
+)
+
+func (s *Server) ListWorkers(ctx context.Context, req *connect.Request[adminv1.ListWorkersRequest]) (*connect.Response[adminv1.ListWorkersResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// The registry is only available when the Gearman executor is used.
+	var connected []workers.Worker
+	if s.workers != nil {
+		connected = s.workers.Workers()
+	}
+
+	resp := &adminv1.ListWorkersResponse{
+		Workers: make([]*adminv1.Worker, 0, len(connected)),
+	}
+	for _, w := range connected {
+		resp.Workers = append(resp.Workers, &adminv1.Worker{
+			Id:            w.ID,
+			ClientId:      w.ClientID,
+			Address:       w.Addr,
+			Functions:     w.Functions,
+			InFlightJobs:  int32(w.InFlightJobs),
+			CompletedJobs: int32(w.CompletedJobs),
+			ConnectedAt:   timestamppb.New(w.ConnectedAt),
+			LastSeenAt:    timestamppb.New(w.LastSeenAt),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
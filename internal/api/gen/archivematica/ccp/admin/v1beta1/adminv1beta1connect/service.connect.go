@@ -36,6 +36,9 @@ const (
 	// AdminServiceCreatePackageProcedure is the fully-qualified name of the AdminService's
 	// CreatePackage RPC.
 	AdminServiceCreatePackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/CreatePackage"
+	// AdminServiceStartReingestProcedure is the fully-qualified name of the AdminService's
+	// StartReingest RPC.
+	AdminServiceStartReingestProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/StartReingest"
 	// AdminServiceReadPackageProcedure is the fully-qualified name of the AdminService's ReadPackage
 	// RPC.
 	AdminServiceReadPackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ReadPackage"
@@ -51,6 +54,48 @@ const (
 	// AdminServiceListProcessingConfigurationFieldsProcedure is the fully-qualified name of the
 	// AdminService's ListProcessingConfigurationFields RPC.
 	AdminServiceListProcessingConfigurationFieldsProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListProcessingConfigurationFields"
+	// AdminServiceListQueuedPackagesProcedure is the fully-qualified name of the AdminService's
+	// ListQueuedPackages RPC.
+	AdminServiceListQueuedPackagesProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListQueuedPackages"
+	// AdminServicePromotePackageProcedure is the fully-qualified name of the AdminService's
+	// PromotePackage RPC.
+	AdminServicePromotePackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/PromotePackage"
+	// AdminServiceCancelPackageProcedure is the fully-qualified name of the AdminService's
+	// CancelPackage RPC.
+	AdminServiceCancelPackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/CancelPackage"
+	// AdminServiceRetryPackageProcedure is the fully-qualified name of the AdminService's RetryPackage
+	// RPC.
+	AdminServiceRetryPackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/RetryPackage"
+	// AdminServiceWatchPackagesProcedure is the fully-qualified name of the AdminService's
+	// WatchPackages RPC.
+	AdminServiceWatchPackagesProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/WatchPackages"
+	// AdminServiceWatchPackageProcedure is the fully-qualified name of the AdminService's WatchPackage
+	// RPC.
+	AdminServiceWatchPackageProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/WatchPackage"
+	// AdminServiceCreateWebhookProcedure is the fully-qualified name of the AdminService's
+	// CreateWebhook RPC.
+	AdminServiceCreateWebhookProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/CreateWebhook"
+	// AdminServiceListWebhooksProcedure is the fully-qualified name of the AdminService's ListWebhooks
+	// RPC.
+	AdminServiceListWebhooksProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListWebhooks"
+	// AdminServiceDeleteWebhookProcedure is the fully-qualified name of the AdminService's
+	// DeleteWebhook RPC.
+	AdminServiceDeleteWebhookProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/DeleteWebhook"
+	// AdminServiceListWebhookDeliveriesProcedure is the fully-qualified name of the AdminService's
+	// ListWebhookDeliveries RPC.
+	AdminServiceListWebhookDeliveriesProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListWebhookDeliveries"
+	// AdminServiceListWorkersProcedure is the fully-qualified name of the AdminService's ListWorkers
+	// RPC.
+	AdminServiceListWorkersProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListWorkers"
+	// AdminServiceSimulateWorkflowProcedure is the fully-qualified name of the AdminService's
+	// SimulateWorkflow RPC.
+	AdminServiceSimulateWorkflowProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/SimulateWorkflow"
+	// AdminServiceDrainServerProcedure is the fully-qualified name of the AdminService's DrainServer
+	// RPC.
+	AdminServiceDrainServerProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/DrainServer"
+	// AdminServiceListRetentionCandidatesProcedure is the fully-qualified name of the AdminService's
+	// ListRetentionCandidates RPC.
+	AdminServiceListRetentionCandidatesProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ListRetentionCandidates"
 	// AdminServiceApproveJobProcedure is the fully-qualified name of the AdminService's ApproveJob RPC.
 	AdminServiceApproveJobProcedure = "/archivematica.ccp.admin.v1beta1.AdminService/ApproveJob"
 	// AdminServiceApproveTransferByPathProcedure is the fully-qualified name of the AdminService's
@@ -65,11 +110,26 @@ const (
 var (
 	adminServiceServiceDescriptor                                 = v1beta1.File_archivematica_ccp_admin_v1beta1_service_proto.Services().ByName("AdminService")
 	adminServiceCreatePackageMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("CreatePackage")
+	adminServiceStartReingestMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("StartReingest")
 	adminServiceReadPackageMethodDescriptor                       = adminServiceServiceDescriptor.Methods().ByName("ReadPackage")
 	adminServiceListPackagesMethodDescriptor                      = adminServiceServiceDescriptor.Methods().ByName("ListPackages")
 	adminServiceListDecisionsMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("ListDecisions")
 	adminServiceResolveDecisionMethodDescriptor                   = adminServiceServiceDescriptor.Methods().ByName("ResolveDecision")
 	adminServiceListProcessingConfigurationFieldsMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("ListProcessingConfigurationFields")
+	adminServiceListQueuedPackagesMethodDescriptor                = adminServiceServiceDescriptor.Methods().ByName("ListQueuedPackages")
+	adminServicePromotePackageMethodDescriptor                    = adminServiceServiceDescriptor.Methods().ByName("PromotePackage")
+	adminServiceCancelPackageMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("CancelPackage")
+	adminServiceRetryPackageMethodDescriptor                      = adminServiceServiceDescriptor.Methods().ByName("RetryPackage")
+	adminServiceWatchPackagesMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("WatchPackages")
+	adminServiceWatchPackageMethodDescriptor                      = adminServiceServiceDescriptor.Methods().ByName("WatchPackage")
+	adminServiceCreateWebhookMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("CreateWebhook")
+	adminServiceListWebhooksMethodDescriptor                      = adminServiceServiceDescriptor.Methods().ByName("ListWebhooks")
+	adminServiceDeleteWebhookMethodDescriptor                     = adminServiceServiceDescriptor.Methods().ByName("DeleteWebhook")
+	adminServiceListWebhookDeliveriesMethodDescriptor             = adminServiceServiceDescriptor.Methods().ByName("ListWebhookDeliveries")
+	adminServiceListWorkersMethodDescriptor                       = adminServiceServiceDescriptor.Methods().ByName("ListWorkers")
+	adminServiceSimulateWorkflowMethodDescriptor                  = adminServiceServiceDescriptor.Methods().ByName("SimulateWorkflow")
+	adminServiceDrainServerMethodDescriptor                       = adminServiceServiceDescriptor.Methods().ByName("DrainServer")
+	adminServiceListRetentionCandidatesMethodDescriptor           = adminServiceServiceDescriptor.Methods().ByName("ListRetentionCandidates")
 	adminServiceApproveJobMethodDescriptor                        = adminServiceServiceDescriptor.Methods().ByName("ApproveJob")
 	adminServiceApproveTransferByPathMethodDescriptor             = adminServiceServiceDescriptor.Methods().ByName("ApproveTransferByPath")
 	adminServiceApprovePartialReingestMethodDescriptor            = adminServiceServiceDescriptor.Methods().ByName("ApprovePartialReingest")
@@ -81,6 +141,11 @@ type AdminServiceClient interface {
 	//
 	// It replaces `packageCreate` (_package_create_handler).
 	CreatePackage(context.Context, *connect.Request[v1beta1.CreatePackageRequest]) (*connect.Response[v1beta1.CreatePackageResponse], error)
+	// StartReingest starts the reingest of an AIP. Full reingests start a new
+	// transfer with the contents of the AIP. Partial and metadata-only reingests
+	// place the AIP in the reingestAIP watched directory and process it as a SIP
+	// with the identifier of the AIP, without awaiting the approval.
+	StartReingest(context.Context, *connect.Request[v1beta1.StartReingestRequest]) (*connect.Response[v1beta1.StartReingestResponse], error)
 	// ReadPackage ...
 	//
 	// It replaces `getUnitStatus` (_unit_status_handler).
@@ -99,6 +164,52 @@ type AdminServiceClient interface {
 	//
 	// It replaces `getProcessingConfigFields` (_get_processing_config_fields_handler).
 	ListProcessingConfigurationFields(context.Context, *connect.Request[v1beta1.ListProcessingConfigurationFieldsRequest]) (*connect.Response[v1beta1.ListProcessingConfigurationFieldsResponse], error)
+	// ListQueuedPackages lists the packages waiting to be processed in the order
+	// they are going to be picked by the scheduler.
+	ListQueuedPackages(context.Context, *connect.Request[v1beta1.ListQueuedPackagesRequest]) (*connect.Response[v1beta1.ListQueuedPackagesResponse], error)
+	// PromotePackage changes the position of a queued package. It updates the
+	// priority of the package when one is given, otherwise it moves the package
+	// to the front of the queue.
+	PromotePackage(context.Context, *connect.Request[v1beta1.PromotePackageRequest]) (*connect.Response[v1beta1.PromotePackageResponse], error)
+	// CancelPackage stops the processing of a package. Running jobs are
+	// abandoned, the package is removed from the processing queues and it is
+	// marked as failed. The package directory is moved to the rejected directory
+	// when requested.
+	CancelPackage(context.Context, *connect.Request[v1beta1.CancelPackageRequest]) (*connect.Response[v1beta1.CancelPackageResponse], error)
+	// RetryPackage restarts the processing of a failed package at the link that
+	// failed, or at the given link. The chain context is reloaded from the store.
+	RetryPackage(context.Context, *connect.Request[v1beta1.RetryPackageRequest]) (*connect.Response[v1beta1.RetryPackageResponse], error)
+	// WatchPackages streams the processing events of all packages as they
+	// happen, e.g. when a job starts or when a decision is awaiting.
+	WatchPackages(context.Context, *connect.Request[v1beta1.WatchPackagesRequest]) (*connect.ServerStreamForClient[v1beta1.WatchPackagesResponse], error)
+	// WatchPackage streams the processing events of a given package.
+	WatchPackage(context.Context, *connect.Request[v1beta1.WatchPackageRequest]) (*connect.ServerStreamForClient[v1beta1.WatchPackageResponse], error)
+	// CreateWebhook subscribes a URL to the package events.
+	CreateWebhook(context.Context, *connect.Request[v1beta1.CreateWebhookRequest]) (*connect.Response[v1beta1.CreateWebhookResponse], error)
+	// ListWebhooks lists the webhooks, including those set in the server
+	// configuration.
+	ListWebhooks(context.Context, *connect.Request[v1beta1.ListWebhooksRequest]) (*connect.Response[v1beta1.ListWebhooksResponse], error)
+	// DeleteWebhook deletes a webhook. Webhooks set in the server configuration
+	// cannot be deleted.
+	DeleteWebhook(context.Context, *connect.Request[v1beta1.DeleteWebhookRequest]) (*connect.Response[v1beta1.DeleteWebhookResponse], error)
+	// ListWebhookDeliveries lists the most recent webhook deliveries, first the
+	// most recent.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error)
+	// ListWorkers lists the MCPClient workers connected to the job server.
+	ListWorkers(context.Context, *connect.Request[v1beta1.ListWorkersRequest]) (*connect.Response[v1beta1.ListWorkersResponse], error)
+	// SimulateWorkflow walks the workflow like a package would be processed
+	// without running any job. Decisions are resolved with the processing
+	// configuration and client scripts return the exit codes requested.
+	SimulateWorkflow(context.Context, *connect.Request[v1beta1.SimulateWorkflowRequest]) (*connect.Response[v1beta1.SimulateWorkflowResponse], error)
+	// DrainServer stops picking new packages and shuts down the server once the
+	// active packages reach their next link boundary or decision point, or once
+	// the drain timeout of the server expires. The packages are resumed when the
+	// server is started again.
+	DrainServer(context.Context, *connect.Request[v1beta1.DrainServerRequest]) (*connect.Response[v1beta1.DrainServerResponse], error)
+	// ListRetentionCandidates lists the entries of the shared directory that
+	// the retention policies would remove if they were applied now. Nothing is
+	// removed.
+	ListRetentionCandidates(context.Context, *connect.Request[v1beta1.ListRetentionCandidatesRequest]) (*connect.Response[v1beta1.ListRetentionCandidatesResponse], error)
 	// ApproveJob ...
 	//
 	// It replaces `approveJob` (_job_approve_handler).
@@ -114,6 +225,7 @@ type AdminServiceClient interface {
 	// ApprovePartialReingest
 	//
 	// It replaces `approvePartialReingest` (_approve_partial_reingest_handler).
+	// Use StartReingest instead.
 	//
 	// Deprecated: do not use.
 	ApprovePartialReingest(context.Context, *connect.Request[v1beta1.ApprovePartialReingestRequest]) (*connect.Response[v1beta1.ApprovePartialReingestResponse], error)
@@ -135,6 +247,12 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceCreatePackageMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		startReingest: connect.NewClient[v1beta1.StartReingestRequest, v1beta1.StartReingestResponse](
+			httpClient,
+			baseURL+AdminServiceStartReingestProcedure,
+			connect.WithSchema(adminServiceStartReingestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		readPackage: connect.NewClient[v1beta1.ReadPackageRequest, v1beta1.ReadPackageResponse](
 			httpClient,
 			baseURL+AdminServiceReadPackageProcedure,
@@ -165,6 +283,90 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceListProcessingConfigurationFieldsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listQueuedPackages: connect.NewClient[v1beta1.ListQueuedPackagesRequest, v1beta1.ListQueuedPackagesResponse](
+			httpClient,
+			baseURL+AdminServiceListQueuedPackagesProcedure,
+			connect.WithSchema(adminServiceListQueuedPackagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		promotePackage: connect.NewClient[v1beta1.PromotePackageRequest, v1beta1.PromotePackageResponse](
+			httpClient,
+			baseURL+AdminServicePromotePackageProcedure,
+			connect.WithSchema(adminServicePromotePackageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelPackage: connect.NewClient[v1beta1.CancelPackageRequest, v1beta1.CancelPackageResponse](
+			httpClient,
+			baseURL+AdminServiceCancelPackageProcedure,
+			connect.WithSchema(adminServiceCancelPackageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		retryPackage: connect.NewClient[v1beta1.RetryPackageRequest, v1beta1.RetryPackageResponse](
+			httpClient,
+			baseURL+AdminServiceRetryPackageProcedure,
+			connect.WithSchema(adminServiceRetryPackageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watchPackages: connect.NewClient[v1beta1.WatchPackagesRequest, v1beta1.WatchPackagesResponse](
+			httpClient,
+			baseURL+AdminServiceWatchPackagesProcedure,
+			connect.WithSchema(adminServiceWatchPackagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watchPackage: connect.NewClient[v1beta1.WatchPackageRequest, v1beta1.WatchPackageResponse](
+			httpClient,
+			baseURL+AdminServiceWatchPackageProcedure,
+			connect.WithSchema(adminServiceWatchPackageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[v1beta1.CreateWebhookRequest, v1beta1.CreateWebhookResponse](
+			httpClient,
+			baseURL+AdminServiceCreateWebhookProcedure,
+			connect.WithSchema(adminServiceCreateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[v1beta1.ListWebhooksRequest, v1beta1.ListWebhooksResponse](
+			httpClient,
+			baseURL+AdminServiceListWebhooksProcedure,
+			connect.WithSchema(adminServiceListWebhooksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1beta1.DeleteWebhookRequest, v1beta1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteWebhookProcedure,
+			connect.WithSchema(adminServiceDeleteWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1beta1.ListWebhookDeliveriesRequest, v1beta1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+AdminServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(adminServiceListWebhookDeliveriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWorkers: connect.NewClient[v1beta1.ListWorkersRequest, v1beta1.ListWorkersResponse](
+			httpClient,
+			baseURL+AdminServiceListWorkersProcedure,
+			connect.WithSchema(adminServiceListWorkersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		simulateWorkflow: connect.NewClient[v1beta1.SimulateWorkflowRequest, v1beta1.SimulateWorkflowResponse](
+			httpClient,
+			baseURL+AdminServiceSimulateWorkflowProcedure,
+			connect.WithSchema(adminServiceSimulateWorkflowMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		drainServer: connect.NewClient[v1beta1.DrainServerRequest, v1beta1.DrainServerResponse](
+			httpClient,
+			baseURL+AdminServiceDrainServerProcedure,
+			connect.WithSchema(adminServiceDrainServerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRetentionCandidates: connect.NewClient[v1beta1.ListRetentionCandidatesRequest, v1beta1.ListRetentionCandidatesResponse](
+			httpClient,
+			baseURL+AdminServiceListRetentionCandidatesProcedure,
+			connect.WithSchema(adminServiceListRetentionCandidatesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		approveJob: connect.NewClient[v1beta1.ApproveJobRequest, v1beta1.ApproveJobResponse](
 			httpClient,
 			baseURL+AdminServiceApproveJobProcedure,
@@ -189,11 +391,26 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
 	createPackage                     *connect.Client[v1beta1.CreatePackageRequest, v1beta1.CreatePackageResponse]
+	startReingest                     *connect.Client[v1beta1.StartReingestRequest, v1beta1.StartReingestResponse]
 	readPackage                       *connect.Client[v1beta1.ReadPackageRequest, v1beta1.ReadPackageResponse]
 	listPackages                      *connect.Client[v1beta1.ListPackagesRequest, v1beta1.ListPackagesResponse]
 	listDecisions                     *connect.Client[v1beta1.ListDecisionsRequest, v1beta1.ListDecisionsResponse]
 	resolveDecision                   *connect.Client[v1beta1.ResolveDecisionRequest, v1beta1.ResolveDecisionResponse]
 	listProcessingConfigurationFields *connect.Client[v1beta1.ListProcessingConfigurationFieldsRequest, v1beta1.ListProcessingConfigurationFieldsResponse]
+	listQueuedPackages                *connect.Client[v1beta1.ListQueuedPackagesRequest, v1beta1.ListQueuedPackagesResponse]
+	promotePackage                    *connect.Client[v1beta1.PromotePackageRequest, v1beta1.PromotePackageResponse]
+	cancelPackage                     *connect.Client[v1beta1.CancelPackageRequest, v1beta1.CancelPackageResponse]
+	retryPackage                      *connect.Client[v1beta1.RetryPackageRequest, v1beta1.RetryPackageResponse]
+	watchPackages                     *connect.Client[v1beta1.WatchPackagesRequest, v1beta1.WatchPackagesResponse]
+	watchPackage                      *connect.Client[v1beta1.WatchPackageRequest, v1beta1.WatchPackageResponse]
+	createWebhook                     *connect.Client[v1beta1.CreateWebhookRequest, v1beta1.CreateWebhookResponse]
+	listWebhooks                      *connect.Client[v1beta1.ListWebhooksRequest, v1beta1.ListWebhooksResponse]
+	deleteWebhook                     *connect.Client[v1beta1.DeleteWebhookRequest, v1beta1.DeleteWebhookResponse]
+	listWebhookDeliveries             *connect.Client[v1beta1.ListWebhookDeliveriesRequest, v1beta1.ListWebhookDeliveriesResponse]
+	listWorkers                       *connect.Client[v1beta1.ListWorkersRequest, v1beta1.ListWorkersResponse]
+	simulateWorkflow                  *connect.Client[v1beta1.SimulateWorkflowRequest, v1beta1.SimulateWorkflowResponse]
+	drainServer                       *connect.Client[v1beta1.DrainServerRequest, v1beta1.DrainServerResponse]
+	listRetentionCandidates           *connect.Client[v1beta1.ListRetentionCandidatesRequest, v1beta1.ListRetentionCandidatesResponse]
 	approveJob                        *connect.Client[v1beta1.ApproveJobRequest, v1beta1.ApproveJobResponse]
 	approveTransferByPath             *connect.Client[v1beta1.ApproveTransferByPathRequest, v1beta1.ApproveTransferByPathResponse]
 	approvePartialReingest            *connect.Client[v1beta1.ApprovePartialReingestRequest, v1beta1.ApprovePartialReingestResponse]
@@ -204,6 +421,11 @@ func (c *adminServiceClient) CreatePackage(ctx context.Context, req *connect.Req
 	return c.createPackage.CallUnary(ctx, req)
 }
 
+// StartReingest calls archivematica.ccp.admin.v1beta1.AdminService.StartReingest.
+func (c *adminServiceClient) StartReingest(ctx context.Context, req *connect.Request[v1beta1.StartReingestRequest]) (*connect.Response[v1beta1.StartReingestResponse], error) {
+	return c.startReingest.CallUnary(ctx, req)
+}
+
 // ReadPackage calls archivematica.ccp.admin.v1beta1.AdminService.ReadPackage.
 func (c *adminServiceClient) ReadPackage(ctx context.Context, req *connect.Request[v1beta1.ReadPackageRequest]) (*connect.Response[v1beta1.ReadPackageResponse], error) {
 	return c.readPackage.CallUnary(ctx, req)
@@ -230,6 +452,77 @@ func (c *adminServiceClient) ListProcessingConfigurationFields(ctx context.Conte
 	return c.listProcessingConfigurationFields.CallUnary(ctx, req)
 }
 
+// ListQueuedPackages calls archivematica.ccp.admin.v1beta1.AdminService.ListQueuedPackages.
+func (c *adminServiceClient) ListQueuedPackages(ctx context.Context, req *connect.Request[v1beta1.ListQueuedPackagesRequest]) (*connect.Response[v1beta1.ListQueuedPackagesResponse], error) {
+	return c.listQueuedPackages.CallUnary(ctx, req)
+}
+
+// PromotePackage calls archivematica.ccp.admin.v1beta1.AdminService.PromotePackage.
+func (c *adminServiceClient) PromotePackage(ctx context.Context, req *connect.Request[v1beta1.PromotePackageRequest]) (*connect.Response[v1beta1.PromotePackageResponse], error) {
+	return c.promotePackage.CallUnary(ctx, req)
+}
+
+// CancelPackage calls archivematica.ccp.admin.v1beta1.AdminService.CancelPackage.
+func (c *adminServiceClient) CancelPackage(ctx context.Context, req *connect.Request[v1beta1.CancelPackageRequest]) (*connect.Response[v1beta1.CancelPackageResponse], error) {
+	return c.cancelPackage.CallUnary(ctx, req)
+}
+
+// RetryPackage calls archivematica.ccp.admin.v1beta1.AdminService.RetryPackage.
+func (c *adminServiceClient) RetryPackage(ctx context.Context, req *connect.Request[v1beta1.RetryPackageRequest]) (*connect.Response[v1beta1.RetryPackageResponse], error) {
+	return c.retryPackage.CallUnary(ctx, req)
+}
+
+// WatchPackages calls archivematica.ccp.admin.v1beta1.AdminService.WatchPackages.
+func (c *adminServiceClient) WatchPackages(ctx context.Context, req *connect.Request[v1beta1.WatchPackagesRequest]) (*connect.ServerStreamForClient[v1beta1.WatchPackagesResponse], error) {
+	return c.watchPackages.CallServerStream(ctx, req)
+}
+
+// WatchPackage calls archivematica.ccp.admin.v1beta1.AdminService.WatchPackage.
+func (c *adminServiceClient) WatchPackage(ctx context.Context, req *connect.Request[v1beta1.WatchPackageRequest]) (*connect.ServerStreamForClient[v1beta1.WatchPackageResponse], error) {
+	return c.watchPackage.CallServerStream(ctx, req)
+}
+
+// CreateWebhook calls archivematica.ccp.admin.v1beta1.AdminService.CreateWebhook.
+func (c *adminServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[v1beta1.CreateWebhookRequest]) (*connect.Response[v1beta1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls archivematica.ccp.admin.v1beta1.AdminService.ListWebhooks.
+func (c *adminServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[v1beta1.ListWebhooksRequest]) (*connect.Response[v1beta1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls archivematica.ccp.admin.v1beta1.AdminService.DeleteWebhook.
+func (c *adminServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1beta1.DeleteWebhookRequest]) (*connect.Response[v1beta1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls archivematica.ccp.admin.v1beta1.AdminService.ListWebhookDeliveries.
+func (c *adminServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ListWorkers calls archivematica.ccp.admin.v1beta1.AdminService.ListWorkers.
+func (c *adminServiceClient) ListWorkers(ctx context.Context, req *connect.Request[v1beta1.ListWorkersRequest]) (*connect.Response[v1beta1.ListWorkersResponse], error) {
+	return c.listWorkers.CallUnary(ctx, req)
+}
+
+// SimulateWorkflow calls archivematica.ccp.admin.v1beta1.AdminService.SimulateWorkflow.
+func (c *adminServiceClient) SimulateWorkflow(ctx context.Context, req *connect.Request[v1beta1.SimulateWorkflowRequest]) (*connect.Response[v1beta1.SimulateWorkflowResponse], error) {
+	return c.simulateWorkflow.CallUnary(ctx, req)
+}
+
+// DrainServer calls archivematica.ccp.admin.v1beta1.AdminService.DrainServer.
+func (c *adminServiceClient) DrainServer(ctx context.Context, req *connect.Request[v1beta1.DrainServerRequest]) (*connect.Response[v1beta1.DrainServerResponse], error) {
+	return c.drainServer.CallUnary(ctx, req)
+}
+
+// ListRetentionCandidates calls
+// archivematica.ccp.admin.v1beta1.AdminService.ListRetentionCandidates.
+func (c *adminServiceClient) ListRetentionCandidates(ctx context.Context, req *connect.Request[v1beta1.ListRetentionCandidatesRequest]) (*connect.Response[v1beta1.ListRetentionCandidatesResponse], error) {
+	return c.listRetentionCandidates.CallUnary(ctx, req)
+}
+
 // ApproveJob calls archivematica.ccp.admin.v1beta1.AdminService.ApproveJob.
 //
 // Deprecated: do not use.
@@ -258,6 +551,11 @@ type AdminServiceHandler interface {
 	//
 	// It replaces `packageCreate` (_package_create_handler).
 	CreatePackage(context.Context, *connect.Request[v1beta1.CreatePackageRequest]) (*connect.Response[v1beta1.CreatePackageResponse], error)
+	// StartReingest starts the reingest of an AIP. Full reingests start a new
+	// transfer with the contents of the AIP. Partial and metadata-only reingests
+	// place the AIP in the reingestAIP watched directory and process it as a SIP
+	// with the identifier of the AIP, without awaiting the approval.
+	StartReingest(context.Context, *connect.Request[v1beta1.StartReingestRequest]) (*connect.Response[v1beta1.StartReingestResponse], error)
 	// ReadPackage ...
 	//
 	// It replaces `getUnitStatus` (_unit_status_handler).
@@ -276,6 +574,52 @@ type AdminServiceHandler interface {
 	//
 	// It replaces `getProcessingConfigFields` (_get_processing_config_fields_handler).
 	ListProcessingConfigurationFields(context.Context, *connect.Request[v1beta1.ListProcessingConfigurationFieldsRequest]) (*connect.Response[v1beta1.ListProcessingConfigurationFieldsResponse], error)
+	// ListQueuedPackages lists the packages waiting to be processed in the order
+	// they are going to be picked by the scheduler.
+	ListQueuedPackages(context.Context, *connect.Request[v1beta1.ListQueuedPackagesRequest]) (*connect.Response[v1beta1.ListQueuedPackagesResponse], error)
+	// PromotePackage changes the position of a queued package. It updates the
+	// priority of the package when one is given, otherwise it moves the package
+	// to the front of the queue.
+	PromotePackage(context.Context, *connect.Request[v1beta1.PromotePackageRequest]) (*connect.Response[v1beta1.PromotePackageResponse], error)
+	// CancelPackage stops the processing of a package. Running jobs are
+	// abandoned, the package is removed from the processing queues and it is
+	// marked as failed. The package directory is moved to the rejected directory
+	// when requested.
+	CancelPackage(context.Context, *connect.Request[v1beta1.CancelPackageRequest]) (*connect.Response[v1beta1.CancelPackageResponse], error)
+	// RetryPackage restarts the processing of a failed package at the link that
+	// failed, or at the given link. The chain context is reloaded from the store.
+	RetryPackage(context.Context, *connect.Request[v1beta1.RetryPackageRequest]) (*connect.Response[v1beta1.RetryPackageResponse], error)
+	// WatchPackages streams the processing events of all packages as they
+	// happen, e.g. when a job starts or when a decision is awaiting.
+	WatchPackages(context.Context, *connect.Request[v1beta1.WatchPackagesRequest], *connect.ServerStream[v1beta1.WatchPackagesResponse]) error
+	// WatchPackage streams the processing events of a given package.
+	WatchPackage(context.Context, *connect.Request[v1beta1.WatchPackageRequest], *connect.ServerStream[v1beta1.WatchPackageResponse]) error
+	// CreateWebhook subscribes a URL to the package events.
+	CreateWebhook(context.Context, *connect.Request[v1beta1.CreateWebhookRequest]) (*connect.Response[v1beta1.CreateWebhookResponse], error)
+	// ListWebhooks lists the webhooks, including those set in the server
+	// configuration.
+	ListWebhooks(context.Context, *connect.Request[v1beta1.ListWebhooksRequest]) (*connect.Response[v1beta1.ListWebhooksResponse], error)
+	// DeleteWebhook deletes a webhook. Webhooks set in the server configuration
+	// cannot be deleted.
+	DeleteWebhook(context.Context, *connect.Request[v1beta1.DeleteWebhookRequest]) (*connect.Response[v1beta1.DeleteWebhookResponse], error)
+	// ListWebhookDeliveries lists the most recent webhook deliveries, first the
+	// most recent.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error)
+	// ListWorkers lists the MCPClient workers connected to the job server.
+	ListWorkers(context.Context, *connect.Request[v1beta1.ListWorkersRequest]) (*connect.Response[v1beta1.ListWorkersResponse], error)
+	// SimulateWorkflow walks the workflow like a package would be processed
+	// without running any job. Decisions are resolved with the processing
+	// configuration and client scripts return the exit codes requested.
+	SimulateWorkflow(context.Context, *connect.Request[v1beta1.SimulateWorkflowRequest]) (*connect.Response[v1beta1.SimulateWorkflowResponse], error)
+	// DrainServer stops picking new packages and shuts down the server once the
+	// active packages reach their next link boundary or decision point, or once
+	// the drain timeout of the server expires. The packages are resumed when the
+	// server is started again.
+	DrainServer(context.Context, *connect.Request[v1beta1.DrainServerRequest]) (*connect.Response[v1beta1.DrainServerResponse], error)
+	// ListRetentionCandidates lists the entries of the shared directory that
+	// the retention policies would remove if they were applied now. Nothing is
+	// removed.
+	ListRetentionCandidates(context.Context, *connect.Request[v1beta1.ListRetentionCandidatesRequest]) (*connect.Response[v1beta1.ListRetentionCandidatesResponse], error)
 	// ApproveJob ...
 	//
 	// It replaces `approveJob` (_job_approve_handler).
@@ -291,6 +635,7 @@ type AdminServiceHandler interface {
 	// ApprovePartialReingest
 	//
 	// It replaces `approvePartialReingest` (_approve_partial_reingest_handler).
+	// Use StartReingest instead.
 	//
 	// Deprecated: do not use.
 	ApprovePartialReingest(context.Context, *connect.Request[v1beta1.ApprovePartialReingestRequest]) (*connect.Response[v1beta1.ApprovePartialReingestResponse], error)
@@ -308,6 +653,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceCreatePackageMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceStartReingestHandler := connect.NewUnaryHandler(
+		AdminServiceStartReingestProcedure,
+		svc.StartReingest,
+		connect.WithSchema(adminServiceStartReingestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceReadPackageHandler := connect.NewUnaryHandler(
 		AdminServiceReadPackageProcedure,
 		svc.ReadPackage,
@@ -338,6 +689,90 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceListProcessingConfigurationFieldsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceListQueuedPackagesHandler := connect.NewUnaryHandler(
+		AdminServiceListQueuedPackagesProcedure,
+		svc.ListQueuedPackages,
+		connect.WithSchema(adminServiceListQueuedPackagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServicePromotePackageHandler := connect.NewUnaryHandler(
+		AdminServicePromotePackageProcedure,
+		svc.PromotePackage,
+		connect.WithSchema(adminServicePromotePackageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCancelPackageHandler := connect.NewUnaryHandler(
+		AdminServiceCancelPackageProcedure,
+		svc.CancelPackage,
+		connect.WithSchema(adminServiceCancelPackageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRetryPackageHandler := connect.NewUnaryHandler(
+		AdminServiceRetryPackageProcedure,
+		svc.RetryPackage,
+		connect.WithSchema(adminServiceRetryPackageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceWatchPackagesHandler := connect.NewServerStreamHandler(
+		AdminServiceWatchPackagesProcedure,
+		svc.WatchPackages,
+		connect.WithSchema(adminServiceWatchPackagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceWatchPackageHandler := connect.NewServerStreamHandler(
+		AdminServiceWatchPackageProcedure,
+		svc.WatchPackage,
+		connect.WithSchema(adminServiceWatchPackageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		AdminServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(adminServiceCreateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListWebhooksHandler := connect.NewUnaryHandler(
+		AdminServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(adminServiceListWebhooksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(adminServiceDeleteWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		AdminServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(adminServiceListWebhookDeliveriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListWorkersHandler := connect.NewUnaryHandler(
+		AdminServiceListWorkersProcedure,
+		svc.ListWorkers,
+		connect.WithSchema(adminServiceListWorkersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSimulateWorkflowHandler := connect.NewUnaryHandler(
+		AdminServiceSimulateWorkflowProcedure,
+		svc.SimulateWorkflow,
+		connect.WithSchema(adminServiceSimulateWorkflowMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDrainServerHandler := connect.NewUnaryHandler(
+		AdminServiceDrainServerProcedure,
+		svc.DrainServer,
+		connect.WithSchema(adminServiceDrainServerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListRetentionCandidatesHandler := connect.NewUnaryHandler(
+		AdminServiceListRetentionCandidatesProcedure,
+		svc.ListRetentionCandidates,
+		connect.WithSchema(adminServiceListRetentionCandidatesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceApproveJobHandler := connect.NewUnaryHandler(
 		AdminServiceApproveJobProcedure,
 		svc.ApproveJob,
@@ -360,6 +795,8 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		switch r.URL.Path {
 		case AdminServiceCreatePackageProcedure:
 			adminServiceCreatePackageHandler.ServeHTTP(w, r)
+		case AdminServiceStartReingestProcedure:
+			adminServiceStartReingestHandler.ServeHTTP(w, r)
 		case AdminServiceReadPackageProcedure:
 			adminServiceReadPackageHandler.ServeHTTP(w, r)
 		case AdminServiceListPackagesProcedure:
@@ -370,6 +807,34 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceResolveDecisionHandler.ServeHTTP(w, r)
 		case AdminServiceListProcessingConfigurationFieldsProcedure:
 			adminServiceListProcessingConfigurationFieldsHandler.ServeHTTP(w, r)
+		case AdminServiceListQueuedPackagesProcedure:
+			adminServiceListQueuedPackagesHandler.ServeHTTP(w, r)
+		case AdminServicePromotePackageProcedure:
+			adminServicePromotePackageHandler.ServeHTTP(w, r)
+		case AdminServiceCancelPackageProcedure:
+			adminServiceCancelPackageHandler.ServeHTTP(w, r)
+		case AdminServiceRetryPackageProcedure:
+			adminServiceRetryPackageHandler.ServeHTTP(w, r)
+		case AdminServiceWatchPackagesProcedure:
+			adminServiceWatchPackagesHandler.ServeHTTP(w, r)
+		case AdminServiceWatchPackageProcedure:
+			adminServiceWatchPackageHandler.ServeHTTP(w, r)
+		case AdminServiceCreateWebhookProcedure:
+			adminServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case AdminServiceListWebhooksProcedure:
+			adminServiceListWebhooksHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteWebhookProcedure:
+			adminServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case AdminServiceListWebhookDeliveriesProcedure:
+			adminServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case AdminServiceListWorkersProcedure:
+			adminServiceListWorkersHandler.ServeHTTP(w, r)
+		case AdminServiceSimulateWorkflowProcedure:
+			adminServiceSimulateWorkflowHandler.ServeHTTP(w, r)
+		case AdminServiceDrainServerProcedure:
+			adminServiceDrainServerHandler.ServeHTTP(w, r)
+		case AdminServiceListRetentionCandidatesProcedure:
+			adminServiceListRetentionCandidatesHandler.ServeHTTP(w, r)
 		case AdminServiceApproveJobProcedure:
 			adminServiceApproveJobHandler.ServeHTTP(w, r)
 		case AdminServiceApproveTransferByPathProcedure:
@@ -389,6 +854,10 @@ func (UnimplementedAdminServiceHandler) CreatePackage(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.CreatePackage is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) StartReingest(context.Context, *connect.Request[v1beta1.StartReingestRequest]) (*connect.Response[v1beta1.StartReingestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.StartReingest is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) ReadPackage(context.Context, *connect.Request[v1beta1.ReadPackageRequest]) (*connect.Response[v1beta1.ReadPackageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ReadPackage is not implemented"))
 }
@@ -409,6 +878,62 @@ func (UnimplementedAdminServiceHandler) ListProcessingConfigurationFields(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListProcessingConfigurationFields is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) ListQueuedPackages(context.Context, *connect.Request[v1beta1.ListQueuedPackagesRequest]) (*connect.Response[v1beta1.ListQueuedPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListQueuedPackages is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) PromotePackage(context.Context, *connect.Request[v1beta1.PromotePackageRequest]) (*connect.Response[v1beta1.PromotePackageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.PromotePackage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CancelPackage(context.Context, *connect.Request[v1beta1.CancelPackageRequest]) (*connect.Response[v1beta1.CancelPackageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.CancelPackage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RetryPackage(context.Context, *connect.Request[v1beta1.RetryPackageRequest]) (*connect.Response[v1beta1.RetryPackageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.RetryPackage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) WatchPackages(context.Context, *connect.Request[v1beta1.WatchPackagesRequest], *connect.ServerStream[v1beta1.WatchPackagesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.WatchPackages is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) WatchPackage(context.Context, *connect.Request[v1beta1.WatchPackageRequest], *connect.ServerStream[v1beta1.WatchPackageResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.WatchPackage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateWebhook(context.Context, *connect.Request[v1beta1.CreateWebhookRequest]) (*connect.Response[v1beta1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListWebhooks(context.Context, *connect.Request[v1beta1.ListWebhooksRequest]) (*connect.Response[v1beta1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteWebhook(context.Context, *connect.Request[v1beta1.DeleteWebhookRequest]) (*connect.Response[v1beta1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListWorkers(context.Context, *connect.Request[v1beta1.ListWorkersRequest]) (*connect.Response[v1beta1.ListWorkersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListWorkers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SimulateWorkflow(context.Context, *connect.Request[v1beta1.SimulateWorkflowRequest]) (*connect.Response[v1beta1.SimulateWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.SimulateWorkflow is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DrainServer(context.Context, *connect.Request[v1beta1.DrainServerRequest]) (*connect.Response[v1beta1.DrainServerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.DrainServer is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListRetentionCandidates(context.Context, *connect.Request[v1beta1.ListRetentionCandidatesRequest]) (*connect.Response[v1beta1.ListRetentionCandidatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ListRetentionCandidates is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) ApproveJob(context.Context, *connect.Request[v1beta1.ApproveJobRequest]) (*connect.Response[v1beta1.ApproveJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.admin.v1beta1.AdminService.ApproveJob is not implemented"))
 }
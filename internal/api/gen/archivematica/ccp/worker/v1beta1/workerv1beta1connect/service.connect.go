@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: archivematica/ccp/worker/v1beta1/service.proto
+
+package workerv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/worker/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WorkerServiceName is the fully-qualified name of the WorkerService service.
+	WorkerServiceName = "archivematica.ccp.worker.v1beta1.WorkerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WorkerServicePullBatchProcedure is the fully-qualified name of the WorkerService's PullBatch RPC.
+	WorkerServicePullBatchProcedure = "/archivematica.ccp.worker.v1beta1.WorkerService/PullBatch"
+	// WorkerServiceReportProgressProcedure is the fully-qualified name of the WorkerService's
+	// ReportProgress RPC.
+	WorkerServiceReportProgressProcedure = "/archivematica.ccp.worker.v1beta1.WorkerService/ReportProgress"
+	// WorkerServiceCompleteBatchProcedure is the fully-qualified name of the WorkerService's
+	// CompleteBatch RPC.
+	WorkerServiceCompleteBatchProcedure = "/archivematica.ccp.worker.v1beta1.WorkerService/CompleteBatch"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	workerServiceServiceDescriptor              = v1beta1.File_archivematica_ccp_worker_v1beta1_service_proto.Services().ByName("WorkerService")
+	workerServicePullBatchMethodDescriptor      = workerServiceServiceDescriptor.Methods().ByName("PullBatch")
+	workerServiceReportProgressMethodDescriptor = workerServiceServiceDescriptor.Methods().ByName("ReportProgress")
+	workerServiceCompleteBatchMethodDescriptor  = workerServiceServiceDescriptor.Methods().ByName("CompleteBatch")
+)
+
+// WorkerServiceClient is a client for the archivematica.ccp.worker.v1beta1.WorkerService service.
+type WorkerServiceClient interface {
+	// PullBatch waits for a batch of tasks that the worker can process. The
+	// response has no batch when none is available within the waiting time.
+	PullBatch(context.Context, *connect.Request[v1beta1.PullBatchRequest]) (*connect.Response[v1beta1.PullBatchResponse], error)
+	// ReportProgress streams the progress of a batch. The batch is considered
+	// abandoned by the server when the stream fails with NOT_FOUND, e.g. when
+	// the batch times out, and the worker should stop processing it.
+	ReportProgress(context.Context) *connect.ClientStreamForClient[v1beta1.ReportProgressRequest, v1beta1.ReportProgressResponse]
+	// CompleteBatch reports the results of a batch, or why the worker failed to
+	// process it.
+	CompleteBatch(context.Context, *connect.Request[v1beta1.CompleteBatchRequest]) (*connect.Response[v1beta1.CompleteBatchResponse], error)
+}
+
+// NewWorkerServiceClient constructs a client for the archivematica.ccp.worker.v1beta1.WorkerService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWorkerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WorkerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &workerServiceClient{
+		pullBatch: connect.NewClient[v1beta1.PullBatchRequest, v1beta1.PullBatchResponse](
+			httpClient,
+			baseURL+WorkerServicePullBatchProcedure,
+			connect.WithSchema(workerServicePullBatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reportProgress: connect.NewClient[v1beta1.ReportProgressRequest, v1beta1.ReportProgressResponse](
+			httpClient,
+			baseURL+WorkerServiceReportProgressProcedure,
+			connect.WithSchema(workerServiceReportProgressMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		completeBatch: connect.NewClient[v1beta1.CompleteBatchRequest, v1beta1.CompleteBatchResponse](
+			httpClient,
+			baseURL+WorkerServiceCompleteBatchProcedure,
+			connect.WithSchema(workerServiceCompleteBatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// workerServiceClient implements WorkerServiceClient.
+type workerServiceClient struct {
+	pullBatch      *connect.Client[v1beta1.PullBatchRequest, v1beta1.PullBatchResponse]
+	reportProgress *connect.Client[v1beta1.ReportProgressRequest, v1beta1.ReportProgressResponse]
+	completeBatch  *connect.Client[v1beta1.CompleteBatchRequest, v1beta1.CompleteBatchResponse]
+}
+
+// PullBatch calls archivematica.ccp.worker.v1beta1.WorkerService.PullBatch.
+func (c *workerServiceClient) PullBatch(ctx context.Context, req *connect.Request[v1beta1.PullBatchRequest]) (*connect.Response[v1beta1.PullBatchResponse], error) {
+	return c.pullBatch.CallUnary(ctx, req)
+}
+
+// ReportProgress calls archivematica.ccp.worker.v1beta1.WorkerService.ReportProgress.
+func (c *workerServiceClient) ReportProgress(ctx context.Context) *connect.ClientStreamForClient[v1beta1.ReportProgressRequest, v1beta1.ReportProgressResponse] {
+	return c.reportProgress.CallClientStream(ctx)
+}
+
+// CompleteBatch calls archivematica.ccp.worker.v1beta1.WorkerService.CompleteBatch.
+func (c *workerServiceClient) CompleteBatch(ctx context.Context, req *connect.Request[v1beta1.CompleteBatchRequest]) (*connect.Response[v1beta1.CompleteBatchResponse], error) {
+	return c.completeBatch.CallUnary(ctx, req)
+}
+
+// WorkerServiceHandler is an implementation of the archivematica.ccp.worker.v1beta1.WorkerService
+// service.
+type WorkerServiceHandler interface {
+	// PullBatch waits for a batch of tasks that the worker can process. The
+	// response has no batch when none is available within the waiting time.
+	PullBatch(context.Context, *connect.Request[v1beta1.PullBatchRequest]) (*connect.Response[v1beta1.PullBatchResponse], error)
+	// ReportProgress streams the progress of a batch. The batch is considered
+	// abandoned by the server when the stream fails with NOT_FOUND, e.g. when
+	// the batch times out, and the worker should stop processing it.
+	ReportProgress(context.Context, *connect.ClientStream[v1beta1.ReportProgressRequest]) (*connect.Response[v1beta1.ReportProgressResponse], error)
+	// CompleteBatch reports the results of a batch, or why the worker failed to
+	// process it.
+	CompleteBatch(context.Context, *connect.Request[v1beta1.CompleteBatchRequest]) (*connect.Response[v1beta1.CompleteBatchResponse], error)
+}
+
+// NewWorkerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWorkerServiceHandler(svc WorkerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	workerServicePullBatchHandler := connect.NewUnaryHandler(
+		WorkerServicePullBatchProcedure,
+		svc.PullBatch,
+		connect.WithSchema(workerServicePullBatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	workerServiceReportProgressHandler := connect.NewClientStreamHandler(
+		WorkerServiceReportProgressProcedure,
+		svc.ReportProgress,
+		connect.WithSchema(workerServiceReportProgressMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	workerServiceCompleteBatchHandler := connect.NewUnaryHandler(
+		WorkerServiceCompleteBatchProcedure,
+		svc.CompleteBatch,
+		connect.WithSchema(workerServiceCompleteBatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/archivematica.ccp.worker.v1beta1.WorkerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WorkerServicePullBatchProcedure:
+			workerServicePullBatchHandler.ServeHTTP(w, r)
+		case WorkerServiceReportProgressProcedure:
+			workerServiceReportProgressHandler.ServeHTTP(w, r)
+		case WorkerServiceCompleteBatchProcedure:
+			workerServiceCompleteBatchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWorkerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWorkerServiceHandler struct{}
+
+func (UnimplementedWorkerServiceHandler) PullBatch(context.Context, *connect.Request[v1beta1.PullBatchRequest]) (*connect.Response[v1beta1.PullBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.worker.v1beta1.WorkerService.PullBatch is not implemented"))
+}
+
+func (UnimplementedWorkerServiceHandler) ReportProgress(context.Context, *connect.ClientStream[v1beta1.ReportProgressRequest]) (*connect.Response[v1beta1.ReportProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.worker.v1beta1.WorkerService.ReportProgress is not implemented"))
+}
+
+func (UnimplementedWorkerServiceHandler) CompleteBatch(context.Context, *connect.Request[v1beta1.CompleteBatchRequest]) (*connect.Response[v1beta1.CompleteBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("archivematica.ccp.worker.v1beta1.WorkerService.CompleteBatch is not implemented"))
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+)
+
+var errInvalidAuth = authn.Errorf("invalid authorization")
+
+// authenticate returns the identifier of the worker that owns the bearer
+// token of the request.
+func (s *Server) authenticate(ctx context.Context, req *http.Request) (any, error) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, errInvalidAuth
+	}
+
+	// Every credential is compared so the time taken does not tell which
+	// worker the token is close to.
+	var workerID string
+	for id, item := range s.config.Credentials {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(item)) == 1 {
+			workerID = id
+		}
+	}
+	if workerID == "" {
+		return nil, errInvalidAuth
+	}
+
+	return workerID, nil
+}
+
+// authorize returns an error unless the request is made with the credentials
+// of the given worker.
+func (s *Server) authorize(ctx context.Context, workerID string) error {
+	if s.config.Insecure {
+		return nil
+	}
+	if id, _ := authn.GetInfo(ctx).(string); id != workerID {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("worker identifier does not match the credentials"))
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	Addr string
+
+	// Lease is how long a worker can keep a batch without reporting progress.
+	// The batch is considered lost once the lease expires, it is retried
+	// according to the retry policy of the script.
+	Lease time.Duration
+
+	// Credentials are the tokens of the workers allowed to use the API indexed
+	// by worker identifier. Workers send their token as a bearer token and can
+	// only use the identifier the token belongs to.
+	Credentials map[string]string
+
+	// Insecure accepts requests without credentials, the workers can use any
+	// identifier. It is meant for development only.
+	Insecure bool
+}
+
+// ParseCredential parses the credential of a worker given as a list of
+// space-separated key-value pairs, e.g.:
+//
+//	id=worker-1 token=s3cr3t
+func ParseCredential(value string) (id, token string, err error) {
+	for _, field := range strings.Fields(value) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return "", "", fmt.Errorf("invalid field %q: missing value", field)
+		}
+		switch key {
+		case "id":
+			id = val
+		case "token":
+			token = val
+		default:
+			return "", "", fmt.Errorf("invalid field %q: unknown key", field)
+		}
+	}
+
+	if id == "" {
+		return "", "", errors.New("missing id")
+	}
+	if token == "" {
+		return "", "", errors.New("missing token")
+	}
+
+	return id, token, nil
+}
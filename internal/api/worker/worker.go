@@ -0,0 +1,492 @@
+// Package worker implements the Worker API, a Connect/gRPC alternative to
+// Gearman where the workers pull batches of tasks when they are ready to
+// process them, stream their progress and report typed results.
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	workerv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/worker/v1beta1"
+	workerv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/worker/v1beta1/workerv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/controller"
+)
+
+const (
+	// defaultWait is how long PullBatch waits for a batch when the worker does
+	// not set a waiting time.
+	defaultWait = 30 * time.Second
+
+	// maxWait is the maximum waiting time accepted by PullBatch.
+	maxWait = time.Minute
+
+	// defaultLease is used when the configuration does not set a lease.
+	defaultLease = time.Minute
+)
+
+// Server implements the Worker API. It is also a controller.Executor that
+// hands the batches submitted by the controller to the workers.
+type Server struct {
+	logger logr.Logger
+	config Config
+	server *http.Server
+	ln     net.Listener
+	v      *protovalidate.Validator
+
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// queue holds the batches waiting for a worker in order of submission.
+	queue []*batch
+
+	// pullers are the workers waiting for a batch.
+	pullers []*puller
+
+	// assigned holds the batches being processed indexed by identifier.
+	assigned map[uuid.UUID]*batch
+
+	// workers holds the workers seen indexed by identifier.
+	workers map[string]*workerState
+}
+
+var (
+	_ workerv1connect.WorkerServiceHandler = (*Server)(nil)
+	_ controller.Executor                  = (*Server)(nil)
+)
+
+type batch struct {
+	*controller.Batch
+	done     chan *controller.BatchResult
+	workerID string
+	lease    *time.Timer
+}
+
+type puller struct {
+	workerID  string
+	functions []string
+	ch        chan *batch
+}
+
+type workerState struct {
+	functions []string
+	lastSeen  time.Time
+}
+
+func New(logger logr.Logger, config Config) (*Server, error) {
+	if config.Lease <= 0 {
+		config.Lease = defaultLease
+	}
+	if len(config.Credentials) == 0 && !config.Insecure {
+		return nil, errors.New("no worker credentials configured")
+	}
+
+	srv := &Server{
+		logger:   logger,
+		config:   config,
+		assigned: map[uuid.UUID]*batch{},
+		workers:  map[string]*workerState{},
+	}
+
+	if v, err := protovalidate.New(); err != nil {
+		return nil, err
+	} else {
+		srv.v = v
+	}
+
+	return srv, nil
+}
+
+func (s *Server) Run() error {
+	compress1KB := connect.WithCompressMinBytes(1024)
+
+	mux := http.NewServeMux()
+	mux.Handle(workerv1connect.NewWorkerServiceHandler(
+		s,
+		compress1KB,
+	))
+	mux.Handle(grpchealth.NewHandler(
+		grpchealth.NewStaticChecker(workerv1connect.WorkerServiceName),
+		compress1KB,
+	))
+	mux.Handle(grpcreflect.NewHandlerV1(
+		grpcreflect.NewStaticReflector(workerv1connect.WorkerServiceName),
+		compress1KB,
+	))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(
+		grpcreflect.NewStaticReflector(workerv1connect.WorkerServiceName),
+		compress1KB,
+	))
+
+	handler := streaming(mux)
+	if !s.config.Insecure {
+		handler = authn.NewMiddleware(s.authenticate).Wrap(handler)
+	}
+
+	s.server = &http.Server{
+		Addr:              s.config.Addr,
+		Handler:           h2c.NewHandler(handler, &http2.Server{}),
+		ReadHeaderTimeout: time.Second,
+		ReadTimeout:       5 * time.Minute,
+		WriteTimeout:      5 * time.Minute,
+		MaxHeaderBytes:    8 * 1024, // 8KiB
+	}
+
+	var err error
+	if s.ln, err = net.Listen("tcp", s.config.Addr); err != nil {
+		return err
+	}
+
+	go func() {
+		s.logger.Info("Listening...", "addr", s.ln.Addr())
+		err := s.server.Serve(s.ln)
+		if err != nil && err != http.ErrServerClosed {
+			s.logger.Error(err, "Failed to start http.Server")
+		}
+	}()
+
+	return nil
+}
+
+// streaming lifts the server timeouts for the streaming procedures, they last
+// as long as the batch is processed.
+func streaming(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == workerv1connect.WorkerServiceReportProgressProcedure {
+			rc := http.NewResponseController(w)
+			_ = rc.SetReadDeadline(time.Time{})
+			_ = rc.SetWriteDeadline(time.Time{})
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) Addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *Server) Close(ctx context.Context) error {
+	if s.server != nil {
+		if err := s.server.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Execute queues the batch until a worker that registered the function pulls
+// it. The batch is withdrawn when ctx is cancelled.
+func (s *Server) Execute(ctx context.Context, b *controller.Batch) <-chan *controller.BatchResult {
+	item := &batch{Batch: b, done: make(chan *controller.BatchResult, 1)}
+
+	s.mu.Lock()
+	s.dispatch(item)
+	s.mu.Unlock()
+
+	context.AfterFunc(ctx, func() { s.withdraw(item) })
+
+	return item.done
+}
+
+// CanExecute reports whether a worker that registered the function has been
+// seen recently.
+func (s *Server) CanExecute(funcName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	since := time.Now().Add(-(maxWait + s.config.Lease))
+	for _, w := range s.workers {
+		if w.lastSeen.After(since) && slices.Contains(w.functions, funcName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dispatch hands the batch to a waiting worker or queues it otherwise. The
+// caller must hold the lock.
+func (s *Server) dispatch(item *batch) {
+	for i, p := range s.pullers {
+		if slices.Contains(p.functions, item.FuncName) {
+			s.pullers = slices.Delete(s.pullers, i, i+1)
+			s.assign(item, p.workerID)
+			p.ch <- item
+			return
+		}
+	}
+
+	s.queue = append(s.queue, item)
+}
+
+// next removes and returns the oldest batch queued that runs one of the given
+// functions. The caller must hold the lock.
+func (s *Server) next(functions []string) *batch {
+	for i, item := range s.queue {
+		if slices.Contains(functions, item.FuncName) {
+			s.queue = slices.Delete(s.queue, i, i+1)
+			return item
+		}
+	}
+
+	return nil
+}
+
+// assign records that the batch is processed by the worker and starts its
+// lease. The caller must hold the lock.
+func (s *Server) assign(item *batch, workerID string) {
+	item.workerID = workerID
+	item.lease = time.AfterFunc(s.config.Lease, func() { s.expire(item) })
+	s.assigned[item.ID] = item
+}
+
+// release takes the batch back from a worker that did not receive it, e.g.
+// when the request is cancelled, and dispatches it again.
+func (s *Server) release(item *batch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.assigned[item.ID] != item {
+		return // Withdrawn or expired in the meantime.
+	}
+	item.lease.Stop()
+	delete(s.assigned, item.ID)
+	s.dispatch(item)
+}
+
+// withdraw forgets a batch that the controller gave up on. The worker finds
+// out when it reports progress or the results.
+func (s *Server) withdraw(item *batch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := slices.Index(s.queue, item); i >= 0 {
+		s.queue = slices.Delete(s.queue, i, i+1)
+	}
+	if s.assigned[item.ID] == item {
+		item.lease.Stop()
+		delete(s.assigned, item.ID)
+	}
+}
+
+// expire fails a batch whose worker stopped reporting progress.
+func (s *Server) expire(item *batch) {
+	s.mu.Lock()
+	if s.assigned[item.ID] != item {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.assigned, item.ID)
+	s.mu.Unlock()
+
+	s.logger.Info("Worker lease expired.", "batch", item.ID, "worker", item.workerID, "lease", s.config.Lease)
+	item.done <- &controller.BatchResult{
+		Err: fmt.Errorf("worker %q stopped reporting progress", item.workerID),
+	}
+}
+
+// complete removes a batch assigned to the worker, it reports whether the
+// batch was found.
+func (s *Server) complete(id uuid.UUID, workerID string) (*batch, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.assigned[id]
+	if !ok || item.workerID != workerID {
+		return nil, false
+	}
+	item.lease.Stop()
+	delete(s.assigned, id)
+	s.seen(workerID, nil)
+
+	return item, true
+}
+
+// seen records the activity of a worker, functions are only updated when
+// given. The caller must hold the lock.
+func (s *Server) seen(workerID string, functions []string) {
+	w, ok := s.workers[workerID]
+	if !ok {
+		w = &workerState{}
+		s.workers[workerID] = w
+	}
+	if functions != nil {
+		w.functions = functions
+	}
+	w.lastSeen = time.Now()
+}
+
+func (s *Server) PullBatch(ctx context.Context, req *connect.Request[workerv1.PullBatchRequest]) (*connect.Response[workerv1.PullBatchResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.authorize(ctx, req.Msg.WorkerId); err != nil {
+		return nil, err
+	}
+
+	wait := defaultWait
+	if req.Msg.Wait != nil {
+		wait = min(req.Msg.Wait.AsDuration(), maxWait)
+	}
+
+	// MCPClient lowercases the function names, see taskBackend.
+	functions := make([]string, 0, len(req.Msg.Functions))
+	for _, item := range req.Msg.Functions {
+		functions = append(functions, strings.ToLower(item))
+	}
+
+	p := &puller{
+		workerID:  req.Msg.WorkerId,
+		functions: functions,
+		ch:        make(chan *batch, 1),
+	}
+
+	s.mu.Lock()
+	s.seen(p.workerID, functions)
+	if item := s.next(functions); item != nil {
+		s.assign(item, p.workerID)
+		s.mu.Unlock()
+		return connect.NewResponse(&workerv1.PullBatchResponse{Batch: batchProto(item.Batch)}), nil
+	}
+	if wait == 0 {
+		s.mu.Unlock()
+		return connect.NewResponse(&workerv1.PullBatchResponse{}), nil
+	}
+	s.pullers = append(s.pullers, p)
+	s.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case item := <-p.ch:
+		return connect.NewResponse(&workerv1.PullBatchResponse{Batch: batchProto(item.Batch)}), nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	i := slices.Index(s.pullers, p)
+	if i >= 0 {
+		s.pullers = slices.Delete(s.pullers, i, i+1)
+	}
+	s.mu.Unlock()
+
+	if i < 0 {
+		// A batch was assigned in the meantime.
+		item := <-p.ch
+		if ctx.Err() != nil {
+			s.release(item)
+			return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+		return connect.NewResponse(&workerv1.PullBatchResponse{Batch: batchProto(item.Batch)}), nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, connect.NewError(connect.CodeCanceled, err)
+	}
+
+	return connect.NewResponse(&workerv1.PullBatchResponse{}), nil
+}
+
+func (s *Server) ReportProgress(ctx context.Context, stream *connect.ClientStream[workerv1.ReportProgressRequest]) (*connect.Response[workerv1.ReportProgressResponse], error) {
+	for stream.Receive() {
+		msg := stream.Msg()
+		if err := s.v.Validate(msg); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := s.authorize(ctx, msg.WorkerId); err != nil {
+			return nil, err
+		}
+
+		id := uuid.MustParse(msg.BatchId)
+
+		s.mu.Lock()
+		item, ok := s.assigned[id]
+		if ok && item.workerID == msg.WorkerId {
+			item.lease.Reset(s.config.Lease)
+			s.seen(msg.WorkerId, nil)
+		}
+		s.mu.Unlock()
+
+		if !ok || item.workerID != msg.WorkerId {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("batch not assigned to the worker"))
+		}
+
+		s.logger.V(2).Info("Worker reported progress.", "batch", id, "worker", msg.WorkerId, "completed", msg.CompletedTasks, "tasks", len(item.Tasks), "message", msg.Message)
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&workerv1.ReportProgressResponse{}), nil
+}
+
+func (s *Server) CompleteBatch(ctx context.Context, req *connect.Request[workerv1.CompleteBatchRequest]) (*connect.Response[workerv1.CompleteBatchResponse], error) {
+	if err := s.v.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.authorize(ctx, req.Msg.WorkerId); err != nil {
+		return nil, err
+	}
+
+	// Only the worker that leased the batch can complete it.
+	item, ok := s.complete(uuid.MustParse(req.Msg.BatchId), req.Msg.WorkerId)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("batch not assigned to the worker"))
+	}
+
+	result := &controller.BatchResult{}
+	if req.Msg.Error != "" {
+		result.Err = errors.New(req.Msg.Error)
+	} else {
+		result.Results = make(map[uuid.UUID]*controller.TaskResult, len(req.Msg.Results))
+		for _, r := range req.Msg.Results {
+			result.Results[uuid.MustParse(r.TaskId)] = &controller.TaskResult{
+				ExitCode:   int(r.ExitCode),
+				FinishedAt: r.FinishedAt.AsTime(),
+				Stdout:     r.Stdout,
+				Stderr:     r.Stderr,
+			}
+		}
+	}
+	item.done <- result
+
+	return connect.NewResponse(&workerv1.CompleteBatchResponse{}), nil
+}
+
+func batchProto(b *controller.Batch) *workerv1.Batch {
+	ret := &workerv1.Batch{
+		Id:       b.ID.String(),
+		Function: b.FuncName,
+		Tasks:    make([]*workerv1.Task, 0, len(b.Tasks)),
+	}
+	for _, t := range b.Tasks {
+		ret.Tasks = append(ret.Tasks, &workerv1.Task{
+			Id:          t.ID.String(),
+			Arguments:   t.Args,
+			WantsOutput: t.WantsOutput,
+			CreatedAt:   timestamppb.New(t.CreatedAt),
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,326 @@
+package worker_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	workerv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/worker/v1beta1"
+	workerv1connect "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/worker/v1beta1/workerv1beta1connect"
+	"github.com/artefactual-labs/ccp/internal/api/worker"
+	"github.com/artefactual-labs/ccp/internal/controller"
+)
+
+func setUp(t *testing.T, config worker.Config) (*worker.Server, workerv1connect.WorkerServiceClient) {
+	t.Helper()
+
+	config.Addr = "127.0.0.1:0"
+	if config.Credentials == nil && !config.Insecure {
+		config.Credentials = credentials
+	}
+	srv, err := worker.New(logr.Discard(), config)
+	assert.NilError(t, err)
+	assert.NilError(t, srv.Run())
+	t.Cleanup(func() { _ = srv.Close(context.Background()) })
+
+	return srv, newClient(srv, credentials["worker-1"])
+}
+
+var credentials = map[string]string{
+	"worker-1": "s3cr3t",
+	"worker-2": "t0k3n",
+}
+
+// newClient returns a client that sends the given token as a bearer token.
+func newClient(srv *worker.Server, token string) workerv1connect.WorkerServiceClient {
+	httpClient := &http.Client{Transport: bearer{token: token}}
+
+	return workerv1connect.NewWorkerServiceClient(httpClient, "http://"+srv.Addr())
+}
+
+type bearer struct {
+	token string
+}
+
+func (b bearer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if b.token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+b.token)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newBatch(funcName string) *controller.Batch {
+	return &controller.Batch{
+		ID:       uuid.New(),
+		FuncName: funcName,
+		Tasks: []*controller.BatchTask{
+			{
+				ID:          uuid.New(),
+				CreatedAt:   time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+				Args:        `"%SIPUUID%"`,
+				WantsOutput: true,
+			},
+		},
+	}
+}
+
+func pull(t *testing.T, client workerv1connect.WorkerServiceClient, wait time.Duration, functions ...string) *workerv1.Batch {
+	t.Helper()
+
+	resp, err := client.PullBatch(context.Background(), connect.NewRequest(&workerv1.PullBatchRequest{
+		WorkerId:  "worker-1",
+		Functions: functions,
+		Wait:      durationpb.New(wait),
+	}))
+	assert.NilError(t, err)
+
+	return resp.Msg.Batch
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Delivers batches to the workers", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		batch := newBatch("normalize_v1.0")
+		done := srv.Execute(context.Background(), batch)
+
+		got := pull(t, client, 0, "Normalize_v1.0")
+		assert.Equal(t, got.Id, batch.ID.String())
+		assert.Equal(t, got.Function, "normalize_v1.0")
+		assert.Equal(t, len(got.Tasks), 1)
+		assert.Equal(t, got.Tasks[0].Id, batch.Tasks[0].ID.String())
+		assert.Equal(t, got.Tasks[0].Arguments, `"%SIPUUID%"`)
+		assert.Equal(t, got.Tasks[0].WantsOutput, true)
+		assert.Assert(t, srv.CanExecute("normalize_v1.0"))
+		assert.Assert(t, !srv.CanExecute("copy_v0.0"))
+
+		finishedAt := time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC)
+		_, err := client.CompleteBatch(context.Background(), connect.NewRequest(&workerv1.CompleteBatchRequest{
+			BatchId:  got.Id,
+			WorkerId: "worker-1",
+			Results: []*workerv1.TaskResult{
+				{
+					TaskId:     got.Tasks[0].Id,
+					ExitCode:   1,
+					Stdout:     "stdout",
+					Stderr:     "stderr",
+					FinishedAt: timestamppb.New(finishedAt),
+				},
+			},
+		}))
+		assert.NilError(t, err)
+
+		result := <-done
+		assert.NilError(t, result.Err)
+		assert.DeepEqual(t, result.Results, map[uuid.UUID]*controller.TaskResult{
+			batch.Tasks[0].ID: {
+				ExitCode:   1,
+				FinishedAt: finishedAt,
+				Stdout:     "stdout",
+				Stderr:     "stderr",
+			},
+		})
+	})
+
+	t.Run("Waits for batches", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+
+		batch := newBatch("normalize_v1.0")
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			srv.Execute(context.Background(), newBatch("copy_v0.0"))
+			srv.Execute(context.Background(), batch)
+		}()
+
+		got := pull(t, client, 5*time.Second, "normalize_v1.0")
+		assert.Equal(t, got.Id, batch.ID.String())
+	})
+
+	t.Run("Returns no batch when the waiting time is over", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		srv.Execute(context.Background(), newBatch("copy_v0.0"))
+
+		got := pull(t, client, 10*time.Millisecond, "normalize_v1.0")
+		assert.Assert(t, got == nil)
+	})
+
+	t.Run("Reports worker failures", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		done := srv.Execute(context.Background(), newBatch("normalize_v1.0"))
+		got := pull(t, client, 0, "normalize_v1.0")
+
+		_, err := client.CompleteBatch(context.Background(), connect.NewRequest(&workerv1.CompleteBatchRequest{
+			BatchId:  got.Id,
+			WorkerId: "worker-1",
+			Error:    "Lost connection to the database.",
+		}))
+		assert.NilError(t, err)
+
+		result := <-done
+		assert.Error(t, result.Err, "Lost connection to the database.")
+	})
+
+	t.Run("Withdraws batches when the context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		ctx, cancel := context.WithCancel(context.Background())
+		srv.Execute(ctx, newBatch("normalize_v1.0"))
+		cancel()
+
+		assert.Assert(t, pull(t, client, 10*time.Millisecond, "normalize_v1.0") == nil)
+	})
+
+	t.Run("Rejects the results of batches withdrawn", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		ctx, cancel := context.WithCancel(context.Background())
+		srv.Execute(ctx, newBatch("normalize_v1.0"))
+		got := pull(t, client, 0, "normalize_v1.0")
+		cancel()
+
+		stream := client.ReportProgress(context.Background())
+		_ = stream.Send(&workerv1.ReportProgressRequest{BatchId: got.Id, WorkerId: "worker-1", CompletedTasks: 1})
+		_, err := stream.CloseAndReceive()
+		assert.Equal(t, connect.CodeOf(err), connect.CodeNotFound)
+
+		_, err = client.CompleteBatch(context.Background(), connect.NewRequest(&workerv1.CompleteBatchRequest{
+			BatchId:  got.Id,
+			WorkerId: "worker-1",
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodeNotFound)
+	})
+
+	t.Run("Fails batches when the lease expires", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{Lease: 100 * time.Millisecond})
+		done := srv.Execute(context.Background(), newBatch("normalize_v1.0"))
+		got := pull(t, client, 0, "normalize_v1.0")
+
+		// Progress reports extend the lease.
+		stream := client.ReportProgress(context.Background())
+		for range 3 {
+			time.Sleep(50 * time.Millisecond)
+			assert.NilError(t, stream.Send(&workerv1.ReportProgressRequest{BatchId: got.Id, WorkerId: "worker-1"}))
+		}
+		_, err := stream.CloseAndReceive()
+		assert.NilError(t, err)
+		assert.Equal(t, len(done), 0)
+
+		result := <-done
+		assert.Error(t, result.Err, `worker "worker-1" stopped reporting progress`)
+	})
+
+	t.Run("Rejects requests without valid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := setUp(t, worker.Config{})
+
+		for _, token := range []string{"", "unknown"} {
+			_, err := newClient(srv, token).PullBatch(context.Background(), connect.NewRequest(&workerv1.PullBatchRequest{
+				WorkerId:  "worker-1",
+				Functions: []string{"normalize_v1.0"},
+			}))
+			assert.Equal(t, connect.CodeOf(err), connect.CodeUnauthenticated)
+		}
+	})
+
+	t.Run("Rejects worker identifiers of other workers", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		srv.Execute(context.Background(), newBatch("normalize_v1.0"))
+
+		_, err := client.PullBatch(context.Background(), connect.NewRequest(&workerv1.PullBatchRequest{
+			WorkerId:  "worker-2",
+			Functions: []string{"normalize_v1.0"},
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodePermissionDenied)
+	})
+
+	t.Run("Rejects the results of batches leased by other workers", func(t *testing.T) {
+		t.Parallel()
+
+		srv, client := setUp(t, worker.Config{})
+		done := srv.Execute(context.Background(), newBatch("normalize_v1.0"))
+		got := pull(t, client, 0, "normalize_v1.0")
+
+		other := newClient(srv, credentials["worker-2"])
+		stream := other.ReportProgress(context.Background())
+		_ = stream.Send(&workerv1.ReportProgressRequest{BatchId: got.Id, WorkerId: "worker-2", CompletedTasks: 1})
+		_, err := stream.CloseAndReceive()
+		assert.Equal(t, connect.CodeOf(err), connect.CodeNotFound)
+
+		_, err = other.CompleteBatch(context.Background(), connect.NewRequest(&workerv1.CompleteBatchRequest{
+			BatchId:  got.Id,
+			WorkerId: "worker-2",
+			Error:    "Lost connection to the database.",
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodeNotFound)
+		assert.Equal(t, len(done), 0)
+	})
+
+	t.Run("Accepts requests without credentials when insecure", func(t *testing.T) {
+		t.Parallel()
+
+		srv, _ := setUp(t, worker.Config{Insecure: true})
+		batch := newBatch("normalize_v1.0")
+		srv.Execute(context.Background(), batch)
+
+		got := pull(t, newClient(srv, ""), 0, "normalize_v1.0")
+		assert.Equal(t, got.Id, batch.ID.String())
+	})
+
+	t.Run("Validates requests", func(t *testing.T) {
+		t.Parallel()
+
+		_, client := setUp(t, worker.Config{})
+		_, err := client.PullBatch(context.Background(), connect.NewRequest(&workerv1.PullBatchRequest{
+			WorkerId: "worker-1",
+		}))
+		assert.Equal(t, connect.CodeOf(err), connect.CodeInvalidArgument)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := worker.New(logr.Discard(), worker.Config{})
+	assert.Error(t, err, "no worker credentials configured")
+}
+
+func TestParseCredential(t *testing.T) {
+	t.Parallel()
+
+	id, token, err := worker.ParseCredential("id=worker-1 token=s3cr3t")
+	assert.NilError(t, err)
+	assert.Equal(t, id, "worker-1")
+	assert.Equal(t, token, "s3cr3t")
+
+	_, _, err = worker.ParseCredential("id=worker-1")
+	assert.Error(t, err, "missing token")
+
+	_, _, err = worker.ParseCredential("id=worker-1 secret=s3cr3t")
+	assert.Error(t, err, `invalid field "secret=s3cr3t": unknown key`)
+}
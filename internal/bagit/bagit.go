@@ -0,0 +1,419 @@
+// Package bagit validates bags as described by the BagIt File Packaging
+// Format (RFC 8493), stored in a directory or in a ZIP or tar archive.
+package bagit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"  //nolint:gosec // Used for checksum verification only.
+	"crypto/sha1" //nolint:gosec // Used for checksum verification only.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// algorithms are the checksum algorithms supported in manifests.
+var algorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// Problem is a reason why a bag is not valid.
+type Problem struct {
+	// Path of the file affected relative to the base directory of the bag,
+	// empty when the problem affects the bag as a whole.
+	Path string
+
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+
+	return p.Path + ": " + p.Message
+}
+
+// ValidationError is returned when a bag is not valid, it lists all the
+// problems found.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	switch len(e.Problems) {
+	case 0:
+		return "invalid bag"
+	case 1:
+		return "invalid bag: " + e.Problems[0].String()
+	default:
+		return fmt.Sprintf("invalid bag: %s (and %d more problems)", e.Problems[0], len(e.Problems)-1)
+	}
+}
+
+// Validate checks that the bag at the given path is complete and valid. The
+// path is a directory or a ZIP or tar archive, optionally compressed with
+// gzip. The base directory of the bag is the path itself or its only
+// top-level directory, as usual in archives.
+//
+// It returns a *ValidationError listing the problems found when the bag is
+// not valid, or ErrUnsupportedArchive when the path is a file in an archive
+// format that is not supported.
+func Validate(ctx context.Context, p string) error {
+	c, err := openContainer(p)
+	if err != nil {
+		return err
+	}
+	defer c.close()
+
+	names, err := c.names()
+	if err != nil {
+		return err
+	}
+
+	v := &validator{c: c, base: baseDir(names), files: map[string]int64{}}
+	for _, name := range names {
+		if rel, ok := v.rel(name); ok {
+			v.files[rel] = 0
+		}
+	}
+
+	if err := v.validate(ctx); err != nil {
+		return err
+	}
+	if len(v.problems) > 0 {
+		slices.SortStableFunc(v.problems, func(a, b Problem) int {
+			return strings.Compare(a.Path, b.Path)
+		})
+		return &ValidationError{Problems: v.problems}
+	}
+
+	return nil
+}
+
+// baseDir returns the base directory of the bag: the root when it contains
+// bagit.txt, otherwise the only top-level directory if there is one.
+func baseDir(names []string) string {
+	var base string
+	for _, name := range names {
+		if name == "bagit.txt" {
+			return ""
+		}
+		dir, _, ok := strings.Cut(name, "/")
+		if !ok {
+			return ""
+		}
+		if base != "" && base != dir {
+			return ""
+		}
+		base = dir
+	}
+
+	return base
+}
+
+type validator struct {
+	c    container
+	base string
+
+	// files are the sizes of the regular files of the bag indexed by their
+	// paths relative to the base directory. Sizes are set when the files
+	// are read.
+	files map[string]int64
+
+	problems []Problem
+}
+
+func (v *validator) problem(p, format string, args ...any) {
+	v.problems = append(v.problems, Problem{Path: p, Message: fmt.Sprintf(format, args...)})
+}
+
+// rel returns the path relative to the base directory of the bag.
+func (v *validator) rel(name string) (string, bool) {
+	if v.base == "" {
+		return name, true
+	}
+
+	return strings.CutPrefix(name, v.base+"/")
+}
+
+func (v *validator) readFile(rel string) ([]byte, bool, error) {
+	blob, err := v.c.readFile(path.Join(v.base, rel))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return blob, true, nil
+}
+
+func (v *validator) validate(ctx context.Context) error {
+	if err := v.checkDeclaration(); err != nil {
+		return err
+	}
+	if _, ok := v.files["fetch.txt"]; ok {
+		v.problem("fetch.txt", "fetching remote files is not supported")
+	}
+
+	payload, err := v.readManifests("manifest-")
+	if err != nil {
+		return err
+	}
+	if len(payload) == 0 {
+		v.problem("", "no payload manifest found")
+	}
+	tags, err := v.readManifests("tagmanifest-")
+	if err != nil {
+		return err
+	}
+
+	// Every payload file must be listed in every payload manifest.
+	for name := range v.files {
+		if !strings.HasPrefix(name, "data/") {
+			continue
+		}
+		for _, m := range payload {
+			if _, ok := m.entries[name]; !ok {
+				v.problem(name, "not listed in %s", m.name)
+			}
+		}
+	}
+
+	if err := v.checkChecksums(ctx, append(payload, tags...)); err != nil {
+		return err
+	}
+
+	return v.checkOxum()
+}
+
+// checkDeclaration checks the bag declaration, bagit.txt.
+func (v *validator) checkDeclaration() error {
+	blob, ok, err := v.readFile("bagit.txt")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		v.problem("bagit.txt", "missing bag declaration")
+		return nil
+	}
+
+	fields := parseTags(blob)
+	if fields["BagIt-Version"] == "" {
+		v.problem("bagit.txt", "missing BagIt-Version")
+	}
+	if fields["Tag-File-Character-Encoding"] == "" {
+		v.problem("bagit.txt", "missing Tag-File-Character-Encoding")
+	}
+
+	return nil
+}
+
+// manifest is a payload or a tag manifest.
+type manifest struct {
+	name      string
+	algorithm string
+
+	// entries are the expected checksums indexed by path.
+	entries map[string]string
+}
+
+// readManifests reads the manifests with the given prefix, sorted by name.
+func (v *validator) readManifests(prefix string) ([]*manifest, error) {
+	names := []string{}
+	for name := range v.files {
+		if strings.HasPrefix(name, prefix) && strings.HasSuffix(name, ".txt") && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	manifests := make([]*manifest, 0, len(names))
+	for _, name := range names {
+		algorithm := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".txt")
+		if _, ok := algorithms[algorithm]; !ok {
+			v.problem(name, "unsupported algorithm %q", algorithm)
+			continue
+		}
+
+		blob, _, err := v.readFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		m := &manifest{name: name, algorithm: algorithm, entries: map[string]string{}}
+		for i, line := range lines(blob) {
+			checksum, p, ok := strings.Cut(line, " ")
+			p = decodePath(strings.TrimLeft(p, " *"))
+			if !ok || p == "" {
+				v.problem(name, "line %d: invalid entry", i+1)
+				continue
+			}
+			if !fs.ValidPath(p) {
+				v.problem(name, "line %d: invalid path %q", i+1, p)
+				continue
+			}
+			if prefix == "manifest-" && !strings.HasPrefix(p, "data/") {
+				v.problem(name, "line %d: %q is not in the payload directory", i+1, p)
+				continue
+			}
+			if _, ok := m.entries[p]; ok {
+				v.problem(name, "line %d: %q is duplicated", i+1, p)
+				continue
+			}
+			m.entries[p] = strings.ToLower(checksum)
+		}
+		manifests = append(manifests, m)
+	}
+
+	return manifests, nil
+}
+
+// checkChecksums reads every file once to compute the checksums of all the
+// manifests listing it.
+func (v *validator) checkChecksums(ctx context.Context, manifests []*manifest) error {
+	err := v.c.walk(func(name string, r io.Reader) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, ok := v.rel(name)
+		if !ok {
+			return nil
+		}
+
+		hashes := map[*manifest]hash.Hash{}
+		writers := []io.Writer{}
+		for _, m := range manifests {
+			if _, ok := m.entries[rel]; ok {
+				h := algorithms[m.algorithm]()
+				hashes[m] = h
+				writers = append(writers, h)
+			}
+		}
+
+		n, err := io.Copy(io.MultiWriter(writers...), r)
+		if err != nil {
+			return fmt.Errorf("read %s: %v", rel, err)
+		}
+		v.files[rel] = n
+
+		for _, m := range manifests {
+			h, ok := hashes[m]
+			if !ok {
+				continue
+			}
+			if got := hex.EncodeToString(h.Sum(nil)); got != m.entries[rel] {
+				v.problem(rel, "%s checksum mismatch: expected %s, got %s", m.algorithm, m.entries[rel], got)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		for p := range m.entries {
+			if _, ok := v.files[p]; !ok {
+				v.problem(p, "listed in %s but missing", m.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkOxum checks the Payload-Oxum of bag-info.txt when it is present.
+func (v *validator) checkOxum() error {
+	blob, ok, err := v.readFile("bag-info.txt")
+	if err != nil || !ok {
+		return err
+	}
+	oxum, ok := parseTags(blob)["Payload-Oxum"]
+	if !ok {
+		return nil
+	}
+
+	octets, streams, ok := strings.Cut(oxum, ".")
+	wantOctets, err1 := strconv.ParseInt(octets, 10, 64)
+	wantStreams, err2 := strconv.ParseInt(streams, 10, 64)
+	if !ok || err1 != nil || err2 != nil {
+		v.problem("bag-info.txt", "invalid Payload-Oxum %q", oxum)
+		return nil
+	}
+
+	var gotOctets, gotStreams int64
+	for name, size := range v.files {
+		if strings.HasPrefix(name, "data/") {
+			gotOctets += size
+			gotStreams++
+		}
+	}
+	if gotOctets != wantOctets || gotStreams != wantStreams {
+		v.problem("bag-info.txt", "Payload-Oxum mismatch: expected %s, got %d.%d", oxum, gotOctets, gotStreams)
+	}
+
+	return nil
+}
+
+// lines returns the non-empty lines of a tag file.
+func lines(blob []byte) []string {
+	blob = bytes.TrimPrefix(blob, []byte("\ufeff"))
+	ret := []string{}
+	s := bufio.NewScanner(bytes.NewReader(blob))
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		if line := strings.TrimRight(s.Text(), "\r"); strings.TrimSpace(line) != "" {
+			ret = append(ret, line)
+		}
+	}
+
+	return ret
+}
+
+// parseTags parses the "Label: Value" lines of a tag file, continuation lines
+// start with whitespace. The first value wins when a label is repeated.
+func parseTags(blob []byte) map[string]string {
+	tags := map[string]string{}
+	var label string
+	for _, line := range lines(blob) {
+		if (line[0] == ' ' || line[0] == '\t') && label != "" {
+			tags[label] += " " + strings.TrimSpace(line)
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			label = ""
+			continue
+		}
+		label = strings.TrimSpace(key)
+		if _, ok := tags[label]; ok {
+			label = ""
+			continue
+		}
+		tags[label] = strings.TrimSpace(value)
+	}
+
+	return tags
+}
+
+// decodePath decodes the percent-encoded characters allowed in manifest
+// paths.
+func decodePath(p string) string {
+	return strings.NewReplacer("%0A", "\n", "%0a", "\n", "%0D", "\r", "%0d", "\r", "%25", "%").Replace(p)
+}
@@ -0,0 +1,303 @@
+package bagit_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"crypto/md5" //nolint:gosec // Used for checksum verification only.
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/bagit"
+)
+
+var payload = map[string]string{
+	"data/image.jpg":       "12345",
+	"data/notes/notes.txt": "abc",
+}
+
+// newBag returns the files of a valid bag with md5 and sha256 manifests and
+// a sha256 tag manifest.
+func newBag() map[string]string {
+	files := map[string]string{
+		"bagit.txt":    "BagIt-Version: 1.0\nTag-File-Character-Encoding: UTF-8\n",
+		"bag-info.txt": "Source-Organization: Artefactual\nPayload-Oxum: 8.2\n",
+	}
+	for name, contents := range payload {
+		files[name] = contents
+	}
+	files["manifest-md5.txt"] = manifest(files, md5Sum, "data/")
+	files["manifest-sha256.txt"] = manifest(files, sha256Sum, "data/")
+	files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+
+	return files
+}
+
+func md5Sum(s string) string {
+	sum := md5.Sum([]byte(s)) //nolint:gosec // Used for checksum verification only.
+	return hex.EncodeToString(sum[:])
+}
+
+func sha256Sum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// manifest lists the files with the given prefix.
+func manifest(files map[string]string, sum func(string) string, prefix string) string {
+	names := []string{}
+	for name := range files {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s  %s\n", sum(files[name]), name)
+	}
+
+	return b.String()
+}
+
+func writeDir(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, contents := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NilError(t, os.MkdirAll(filepath.Dir(p), 0o700))
+		assert.NilError(t, os.WriteFile(p, []byte(contents), 0o600))
+	}
+}
+
+func writeZip(t *testing.T, p, base string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(p)
+	assert.NilError(t, err)
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for name, contents := range files {
+		w, err := zw.Create(base + name)
+		assert.NilError(t, err)
+		_, err = io.WriteString(w, contents)
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, zw.Close())
+}
+
+func writeTarGz(t *testing.T, p, base string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(p)
+	assert.NilError(t, err)
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	for name, contents := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     base + name,
+			Mode:     0o600,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		})
+		assert.NilError(t, err)
+		_, err = io.WriteString(tw, contents)
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, tw.Close())
+	assert.NilError(t, gw.Close())
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		update  func(files map[string]string)
+		problem []bagit.Problem
+	}{
+		"Accepts a valid bag": {},
+		"Accepts bags without oxum": {
+			update: func(files map[string]string) {
+				delete(files, "bag-info.txt")
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+		},
+		"Rejects bags without declaration": {
+			update: func(files map[string]string) {
+				delete(files, "bagit.txt")
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+			problem: []bagit.Problem{{Path: "bagit.txt", Message: "missing bag declaration"}},
+		},
+		"Rejects incomplete declarations": {
+			update: func(files map[string]string) {
+				files["bagit.txt"] = "BagIt-Version: 1.0\n"
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+			problem: []bagit.Problem{{Path: "bagit.txt", Message: "missing Tag-File-Character-Encoding"}},
+		},
+		"Rejects bags with fetch.txt": {
+			update: func(files map[string]string) {
+				files["fetch.txt"] = "https://example.com/image.jpg 5 data/image.jpg\n"
+			},
+			problem: []bagit.Problem{{Path: "fetch.txt", Message: "fetching remote files is not supported"}},
+		},
+		"Rejects bags without payload manifests": {
+			update: func(files map[string]string) {
+				delete(files, "manifest-md5.txt")
+				delete(files, "manifest-sha256.txt")
+				delete(files, "tagmanifest-sha256.txt")
+			},
+			problem: []bagit.Problem{{Message: "no payload manifest found"}},
+		},
+		"Rejects unsupported algorithms": {
+			update: func(files map[string]string) {
+				files["manifest-sha3.txt"] = files["manifest-sha256.txt"]
+			},
+			problem: []bagit.Problem{{Path: "manifest-sha3.txt", Message: `unsupported algorithm "sha3"`}},
+		},
+		"Rejects payload checksum mismatches": {
+			update: func(files map[string]string) {
+				files["data/image.jpg"] = "54321"
+			},
+			problem: []bagit.Problem{
+				{Path: "data/image.jpg", Message: "md5 checksum mismatch: expected 827ccb0eea8a706c4c34a16891f84e7b, got 01cfcd4f6b8770febfb40cb906715822"},
+				{Path: "data/image.jpg", Message: "sha256 checksum mismatch: expected 5994471abb01112afcc18159f6cc74b4f511b99806da59b3caf5a9c173cacfc5, got 20f3765880a5c269b747e1e906054a4b4a3a991259f1e16b5dde4742cec2319a"},
+			},
+		},
+		"Rejects tag checksum mismatches": {
+			update: func(files map[string]string) {
+				files["bag-info.txt"] += "Contact-Name: Archivist\n"
+			},
+			problem: []bagit.Problem{{Path: "bag-info.txt", Message: fmt.Sprintf(
+				"sha256 checksum mismatch: expected %s, got %s",
+				sha256Sum(newBag()["bag-info.txt"]),
+				sha256Sum(newBag()["bag-info.txt"]+"Contact-Name: Archivist\n"),
+			)}},
+		},
+		"Rejects missing payload files": {
+			update: func(files map[string]string) {
+				delete(files, "data/notes/notes.txt")
+			},
+			problem: []bagit.Problem{
+				{Path: "bag-info.txt", Message: "Payload-Oxum mismatch: expected 8.2, got 5.1"},
+				{Path: "data/notes/notes.txt", Message: "listed in manifest-md5.txt but missing"},
+				{Path: "data/notes/notes.txt", Message: "listed in manifest-sha256.txt but missing"},
+			},
+		},
+		"Rejects unlisted payload files": {
+			update: func(files map[string]string) {
+				files["data/extra.txt"] = ""
+				files["bag-info.txt"] = strings.Replace(files["bag-info.txt"], "8.2", "8.3", 1)
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+			problem: []bagit.Problem{
+				{Path: "data/extra.txt", Message: "not listed in manifest-md5.txt"},
+				{Path: "data/extra.txt", Message: "not listed in manifest-sha256.txt"},
+			},
+		},
+		"Rejects oxum mismatches": {
+			update: func(files map[string]string) {
+				files["bag-info.txt"] = "Payload-Oxum: 9.2\n"
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+			problem: []bagit.Problem{{Path: "bag-info.txt", Message: "Payload-Oxum mismatch: expected 9.2, got 8.2"}},
+		},
+		"Rejects manifest paths outside of the payload directory": {
+			update: func(files map[string]string) {
+				files["manifest-md5.txt"] += md5Sum("") + "  ../etc/passwd\n" + md5Sum("") + "  bagit.txt\n"
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+			problem: []bagit.Problem{
+				{Path: "manifest-md5.txt", Message: `line 3: invalid path "../etc/passwd"`},
+				{Path: "manifest-md5.txt", Message: `line 4: "bagit.txt" is not in the payload directory`},
+			},
+		},
+		"Decodes manifest paths": {
+			update: func(files map[string]string) {
+				files["data/100%.txt"] = "100"
+				files["bag-info.txt"] = "Payload-Oxum: 11.3\n"
+				files["manifest-md5.txt"] = manifest(files, md5Sum, "data/")
+				files["manifest-sha256.txt"] = strings.Replace(manifest(files, sha256Sum, "data/"), "100%", "100%25", 1)
+				files["tagmanifest-sha256.txt"] = manifest(files, sha256Sum, "bag")
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files := newBag()
+			if tc.update != nil {
+				tc.update(files)
+			}
+			dir := t.TempDir()
+			writeDir(t, dir, files)
+
+			err := bagit.Validate(ctx, dir)
+			if tc.problem == nil {
+				assert.NilError(t, err)
+				return
+			}
+			verr, ok := err.(*bagit.ValidationError)
+			assert.Assert(t, ok, "unexpected error: %v", err)
+			assert.DeepEqual(t, verr.Problems, tc.problem)
+		})
+	}
+
+	t.Run("Validates archives", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		files := newBag()
+
+		writeZip(t, filepath.Join(dir, "bag.zip"), "bag/", files)
+		assert.NilError(t, bagit.Validate(ctx, filepath.Join(dir, "bag.zip")))
+
+		writeTarGz(t, filepath.Join(dir, "bag.tar.gz"), "./", files)
+		assert.NilError(t, bagit.Validate(ctx, filepath.Join(dir, "bag.tar.gz")))
+
+		files["data/image.jpg"] = "1234"
+		writeTarGz(t, filepath.Join(dir, "invalid.tgz"), "bag/", files)
+		err := bagit.Validate(ctx, filepath.Join(dir, "invalid.tgz"))
+		assert.ErrorContains(t, err, "invalid bag: bag-info.txt: Payload-Oxum mismatch: expected 8.2, got 7.2 (and 2 more problems)")
+	})
+
+	t.Run("Rejects unsupported archives", func(t *testing.T) {
+		t.Parallel()
+
+		p := filepath.Join(t.TempDir(), "bag.7z")
+		assert.NilError(t, os.WriteFile(p, []byte("7z\xbc\xaf\x27\x1c"), 0o600))
+
+		err := bagit.Validate(ctx, p)
+		assert.ErrorIs(t, err, bagit.ErrUnsupportedArchive)
+	})
+
+	t.Run("Stops when the context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeDir(t, dir, newBag())
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := bagit.Validate(ctx, dir)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,217 @@
+package bagit
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// ErrUnsupportedArchive is returned when the bag is a file that is not a ZIP
+// or tar archive, e.g. a 7z archive.
+var ErrUnsupportedArchive = errors.New("unsupported archive format")
+
+// container gives access to the files of a bag, stored in a directory or in
+// an archive. Names are slash-separated and relative to the root of the
+// container.
+type container interface {
+	// names returns the names of all the regular files.
+	names() ([]string, error)
+
+	// readFile returns the contents of a regular file, the error wraps
+	// fs.ErrNotExist when the file does not exist.
+	readFile(name string) ([]byte, error)
+
+	// walk calls fn for every regular file with a reader of its contents.
+	walk(fn func(name string, r io.Reader) error) error
+
+	close() error
+}
+
+// openContainer opens the directory or the archive at the given path.
+func openContainer(p string) (container, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return &fsContainer{fsys: os.DirFS(p)}, nil
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	header := make([]byte, 512)
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		r, err := zip.OpenReader(p)
+		if err != nil {
+			return nil, err
+		}
+		return &fsContainer{fsys: r, closer: r}, nil
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		return &tarContainer{path: p, gzip: true}, nil
+	case len(header) > 262 && string(header[257:262]) == "ustar":
+		return &tarContainer{path: p}, nil
+	default:
+		return nil, ErrUnsupportedArchive
+	}
+}
+
+// fsContainer is a container backed by a filesystem: a directory or a ZIP
+// archive.
+type fsContainer struct {
+	fsys   fs.FS
+	closer io.Closer
+}
+
+func (c *fsContainer) names() ([]string, error) {
+	names := []string{}
+	err := fs.WalkDir(c.fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			names = append(names, name)
+		}
+		return nil
+	})
+
+	return names, err
+}
+
+func (c *fsContainer) readFile(name string) ([]byte, error) {
+	return fs.ReadFile(c.fsys, name)
+}
+
+func (c *fsContainer) walk(fn func(name string, r io.Reader) error) error {
+	return fs.WalkDir(c.fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		f, err := c.fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return fn(name, bufio.NewReader(f))
+	})
+}
+
+func (c *fsContainer) close() error {
+	if c.closer != nil {
+		return c.closer.Close()
+	}
+
+	return nil
+}
+
+// tarContainer is a container backed by a tar archive, optionally compressed
+// with gzip. Tar archives cannot be accessed randomly, every operation reads
+// the archive from the beginning.
+type tarContainer struct {
+	path string
+	gzip bool
+}
+
+// each calls fn for every regular file of the archive until fn returns
+// false.
+func (c *tarContainer) each(fn func(hdr *tar.Header, r io.Reader) (bool, error)) error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if c.gzip {
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if more, err := fn(hdr, tr); err != nil || !more {
+			return err
+		}
+	}
+}
+
+func tarName(hdr *tar.Header) string {
+	return strings.TrimPrefix(path.Clean(hdr.Name), "./")
+}
+
+func (c *tarContainer) names() ([]string, error) {
+	names := []string{}
+	err := c.each(func(hdr *tar.Header, _ io.Reader) (bool, error) {
+		names = append(names, tarName(hdr))
+		return true, nil
+	})
+
+	return names, err
+}
+
+func (c *tarContainer) readFile(name string) ([]byte, error) {
+	var blob []byte
+	err := c.each(func(hdr *tar.Header, r io.Reader) (bool, error) {
+		if tarName(hdr) != name {
+			return true, nil
+		}
+		var err error
+		blob, err = io.ReadAll(r)
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, fmt.Errorf("read %s: %w", name, fs.ErrNotExist)
+	}
+
+	return blob, nil
+}
+
+func (c *tarContainer) walk(fn func(name string, r io.Reader) error) error {
+	return c.each(func(hdr *tar.Header, r io.Reader) (bool, error) {
+		return true, fn(tarName(hdr), r)
+	})
+}
+
+func (c *tarContainer) close() error {
+	return nil
+}
@@ -11,14 +11,19 @@ import (
 	"path/filepath"
 	"runtime"
 	"syscall"
+	"time"
 
 	"github.com/peterbourgon/ff/v3"
 	"github.com/peterbourgon/ff/v3/ffcli"
 	"github.com/peterbourgon/ff/v3/fftoml"
 	"go.artefactual.dev/tools/log"
 
+	"github.com/artefactual-labs/ccp/internal/api/worker"
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/storage"
 	"github.com/artefactual-labs/ccp/internal/version"
+	"github.com/artefactual-labs/ccp/internal/webhook"
 )
 
 func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
@@ -31,12 +36,109 @@ func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
 	fs.String("config", "", "Configuration file in the TOML file format")
 	fs.StringVar(&cfg.sharedDir, "shared-dir", "", "Shared directory")
 	fs.StringVar(&cfg.workflow, "workflow", "", "Workflow document")
-	fs.StringVar(&cfg.db.driver, "db.driver", "", "Database driver")
+	fs.StringVar(&cfg.db.driver, "db.driver", "", "Database driver: \"mysql\", \"sqlite\" or \"postgres\"")
 	fs.StringVar(&cfg.db.dsn, "db.dsn", "", "Database DSN")
 	fs.StringVar(&cfg.api.admin.Addr, "api.admin.addr", ":8000", "Admin API listen address")
 	fs.StringVar(&cfg.webui.Addr, "webui.addr", ":8001", "Web UI listen address")
+	fs.StringVar(&cfg.executor, "executor", executorGearman, "Executor used to dispatch tasks to the workers: \"gearman\" or \"connect\" (Worker API)")
 	fs.StringVar(&cfg.gearmin.addr, "gearmin.addr", ":4730", "Gearmin job server listen address")
+	fs.StringVar(&cfg.api.worker.Addr, "api.worker.addr", ":8002", "Worker API listen address, used by the connect executor")
+	fs.DurationVar(&cfg.api.worker.Lease, "api.worker.lease", time.Minute, "Time a worker can process a batch without reporting progress before it is considered lost")
+	fs.Func("api.worker.credential", "Credential of a worker of the Worker API, sent as a bearer token, e.g. \"id=worker-1 token=s3cr3t\" (repeatable)", func(value string) error {
+		id, token, err := worker.ParseCredential(value)
+		if err != nil {
+			return err
+		}
+		if cfg.api.worker.Credentials == nil {
+			cfg.api.worker.Credentials = map[string]string{}
+		}
+		cfg.api.worker.Credentials[id] = token
+		return nil
+	})
+	fs.BoolVar(&cfg.api.worker.Insecure, "api.worker.insecure", false, "Accept Worker API requests without credentials (development only)")
 	fs.StringVar(&cfg.metrics.Addr, "metrics.addr", "", "Prometheus HTTP API listen address")
+	fs.BoolVar(&cfg.shutdown.drain, "shutdown.drain", false, "Drain the controller before shutting down: stop picking packages and let the active ones reach their next link boundary (SIGUSR1 and the DrainServer RPC always drain)")
+	fs.DurationVar(&cfg.shutdown.drainTimeout, "shutdown.drain-timeout", 5*time.Minute, "Maximum time given to the active packages to reach their next link boundary when draining")
+	fs.StringVar(&cfg.tracing.Exporter, "tracing.exporter", "", "OpenTelemetry span exporter: \"otlp\" (OTLP/HTTP), \"file\" or \"stdout\" (tracing is disabled when empty)")
+	fs.StringVar(&cfg.tracing.Endpoint, "tracing.otlp.endpoint", "", "OTLP/HTTP collector address, e.g. \"localhost:4318\" (defaults to the OTEL_EXPORTER_OTLP_* environment variables)")
+	fs.BoolVar(&cfg.tracing.Insecure, "tracing.otlp.insecure", false, "Connect to the OTLP/HTTP collector without TLS")
+	fs.StringVar(&cfg.tracing.Path, "tracing.file.path", "", "File where the file exporter appends the spans")
+	fs.Float64Var(&cfg.tracing.SampleRatio, "tracing.sample-ratio", 1, "Fraction of packages and API requests traced, between 0 and 1")
+	fs.IntVar(&cfg.controller.MaxActivePackages, "controller.max-active-packages", 2, "Maximum number of packages processed concurrently")
+	fs.IntVar(&cfg.controller.MaxActiveTransfers, "controller.max-active-transfers", 0, "Maximum number of transfers processed concurrently (0 means no quota)")
+	fs.IntVar(&cfg.controller.MaxActiveSIPs, "controller.max-active-sips", 0, "Maximum number of SIPs processed concurrently (0 means no quota)")
+	fs.IntVar(&cfg.controller.MaxActiveDIPs, "controller.max-active-dips", 0, "Maximum number of DIPs processed concurrently (0 means no quota)")
+	fs.IntVar(&cfg.controller.MaxActiveIngests, "controller.max-active-ingests", 2, "Maximum number of transfers copied concurrently into the processing directory")
+	fs.Func("controller.retry", "Retry policy of a script run by the workers, e.g. \"script=copy_v0.0 attempts=3 backoff=10s max-backoff=5m\", use script=* for the default policy (repeatable)", func(value string) error {
+		script, policy, err := controller.ParseRetryPolicy(value)
+		if err != nil {
+			return err
+		}
+		if cfg.controller.Retries == nil {
+			cfg.controller.Retries = controller.RetryPolicies{}
+		}
+		cfg.controller.Retries[script] = policy
+		return nil
+	})
+	cfg.controller.Timeouts = controller.TimeoutPolicies{"*": {Warning: time.Hour}}
+	fs.Func("controller.timeout", "Timeout policy of a script run by the workers, e.g. \"script=normalize_v1.0 timeout=6h task-timeout=10m warning=1h\", use script=* for the default policy (repeatable, default \"script=* warning=1h\")", func(value string) error {
+		script, policy, err := controller.ParseTimeoutPolicy(value)
+		if err != nil {
+			return err
+		}
+		cfg.controller.Timeouts[script] = policy
+		return nil
+	})
+	fs.BoolVar(&cfg.controller.Runners, "controller.runners", false, "Run simple scripts in-process instead of sending them to the workers, e.g. copy_v0.0 or move_v0.0")
+	fs.Func("controller.retention", "Retention policy of a directory of the shared directory: completed, currentlyProcessing, failed, rejected, tmp or one of their subdirectories, e.g. \"dir=failed max-age=720h max-size=10GiB\" (repeatable)", func(value string) error {
+		dir, policy, err := controller.ParseRetentionPolicy(value)
+		if err != nil {
+			return err
+		}
+		if cfg.controller.Retention == nil {
+			cfg.controller.Retention = controller.RetentionPolicies{}
+		}
+		cfg.controller.Retention[dir] = policy
+		return nil
+	})
+	fs.DurationVar(&cfg.controller.RetentionInterval, "controller.retention-interval", time.Hour, "Time between the applications of the retention policies (0 disables them)")
+	fs.BoolVar(&cfg.controller.RetentionDryRun, "controller.retention-dry-run", false, "Log the entries selected by the retention policies instead of removing them")
+	fs.Func("controller.min-free-space", "Low-water mark of the free space of the shared directory, new transfers are refused and queued packages are held below it, e.g. \"20GiB\" (0 disables it)", func(value string) error {
+		n, err := controller.ParseSize(value)
+		if err != nil {
+			return err
+		}
+		cfg.controller.MinFreeSpace = n
+		return nil
+	})
+	fs.BoolVar(&cfg.controller.EstimateTransferSize, "controller.estimate-transfer-size", false, "Measure the sources of the transfers submitted and refuse those that would cross the low-water mark of the shared directory")
+	parseLocation := func(value string) error {
+		location, err := storage.ParseLocation(value)
+		if err != nil {
+			return err
+		}
+		cfg.storage.Locations = append(cfg.storage.Locations, location)
+		return nil
+	}
+	fs.Func("storage.location", "Storage location of transfer sources, referenced as <location-uuid>:<path> in the transfers, e.g. \"id=c059a454-dafa-418e-a126-74d0c7219ce6 path=/home\", \"id=<uuid> type=s3 endpoint=minio:9000 bucket=transfers access-key=<key> secret-key=<secret>\" or \"id=<uuid> type=sftp address=sftp:22 user=<user> key-file=<path> known-hosts=<path>\" (repeatable)", parseLocation)
+	fs.Func("controller.location", "Deprecated alias of storage.location (repeatable)", parseLocation)
+	fs.StringVar(&cfg.watcher.backend, "watcher.backend", watcherFsnotify, "Backend used to detect the packages placed in the watched directories: \"fsnotify\" (filesystem events) or \"poll\" (periodic snapshots, e.g. for NFS)")
+	fs.DurationVar(&cfg.watcher.pollInterval, "watcher.poll-interval", 2*time.Second, "Time between the snapshots taken by the poll watcher backend, new entries are processed once unchanged between two snapshots")
+	fs.DurationVar(&cfg.watcher.rescanInterval, "watcher.rescan-interval", 5*time.Minute, "Time between the rescans of the watched directories that recover the packages missed by the filesystem watcher (0 disables them, the directories are always scanned at startup); not used by the poll backend")
+	fs.Func("webhooks.subscription", "Webhook subscription, e.g. \"url=https://example.com/hook secret=s3cr3t events=package.done,package.failed\" (repeatable)", func(value string) error {
+		sub, err := webhook.ParseSubscription(value)
+		if err != nil {
+			return err
+		}
+		cfg.webhooks.Subscriptions = append(cfg.webhooks.Subscriptions, sub)
+		return nil
+	})
+	fs.IntVar(&cfg.webhooks.MaxAttempts, "webhooks.max-attempts", 5, "Maximum number of attempts made to deliver a webhook event")
+	fs.DurationVar(&cfg.webhooks.Backoff, "webhooks.backoff", time.Second, "Delay before retrying a failed webhook delivery, doubled with every attempt")
+	fs.DurationVar(&cfg.webhooks.MaxBackoff, "webhooks.max-backoff", time.Minute, "Maximum delay between webhook delivery attempts")
+	fs.DurationVar(&cfg.webhooks.Timeout, "webhooks.timeout", 10*time.Second, "Timeout of webhook delivery attempts")
+	fs.IntVar(&cfg.webhooks.Workers, "webhooks.workers", 4, "Number of webhook events delivered concurrently")
+	fs.IntVar(&cfg.webhooks.QueueSize, "webhooks.queue-size", 1000, "Maximum number of webhook events waiting for delivery")
 
 	rootConfig.RegisterFlags(fs)
 
@@ -79,6 +181,10 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	drainSig := make(chan os.Signal, 1)
+	signal.Notify(drainSig, syscall.SIGUSR1)
+	defer signal.Stop(drainSig)
+
 	s := NewServer(logger, c)
 	if err := s.Run(); err != nil {
 		logger.Error(err, "Failed to start server.")
@@ -86,7 +192,29 @@ func (c *Config) Exec(ctx context.Context, args []string) error {
 		return err
 	}
 
-	<-ctx.Done()
+	drain := c.shutdown.drain
+	select {
+	case <-ctx.Done():
+	case <-drainSig:
+		logger.Info("Drain requested by signal.")
+		drain = true
+	case <-s.Draining():
+		drain = true
+	}
+
+	if drain {
+		// A drain that is not triggered by a termination signal can still be
+		// interrupted by one.
+		drainCtx := ctx
+		if ctx.Err() != nil {
+			drainCtx = context.Background()
+		}
+		drainCtx, cancel := context.WithTimeout(drainCtx, c.shutdown.drainTimeout)
+		if err := s.Drain(drainCtx); err != nil {
+			logger.Info("Processing did not stop before shutting down, running jobs are abandoned.", "err", err)
+		}
+		cancel()
+	}
 
 	if err := s.Close(); err != nil {
 		if !errors.Is(err, context.Canceled) {
@@ -2,10 +2,16 @@ package servercmd
 
 import (
 	"io"
+	"time"
 
 	"github.com/artefactual-labs/ccp/internal/api/admin"
+	"github.com/artefactual-labs/ccp/internal/api/worker"
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/tracing"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/storage"
+	"github.com/artefactual-labs/ccp/internal/webhook"
 	"github.com/artefactual-labs/ccp/internal/webui"
 )
 
@@ -16,9 +22,16 @@ type Config struct {
 	workflow   string
 	db         databaseConfig
 	api        apiConfig
+	executor   string
 	gearmin    gearminConfig
+	controller controller.Config
+	storage    storage.Config
+	watcher    watcherConfig
+	webhooks   webhook.Config
 	webui      webui.Config
 	metrics    metrics.Config
+	tracing    tracing.Config
+	shutdown   shutdownConfig
 }
 
 type databaseConfig struct {
@@ -27,9 +40,46 @@ type databaseConfig struct {
 }
 
 type apiConfig struct {
-	admin admin.Config
+	admin  admin.Config
+	worker worker.Config
 }
 
+// Executors that dispatch the tasks to the workers.
+const (
+	executorGearman = "gearman"
+	executorConnect = "connect"
+)
+
 type gearminConfig struct {
 	addr string
 }
+
+// Backends of the filesystem watcher.
+const (
+	watcherFsnotify = "fsnotify"
+	watcherPoll     = "poll"
+)
+
+type watcherConfig struct {
+	// backend used to detect the packages placed in the watched directories.
+	backend string
+
+	// pollInterval is the time between the snapshots taken by the poll
+	// backend.
+	pollInterval time.Duration
+
+	// rescanInterval is the time between the rescans of the watched
+	// directories that recover the filesystem events missed, zero disables
+	// them. It is not used by the poll backend, which scans the directories
+	// on every poll.
+	rescanInterval time.Duration
+}
+
+type shutdownConfig struct {
+	// drain the controller before shutting down, see controller.Drain.
+	drain bool
+
+	// drainTimeout is the maximum time given to the active packages to reach
+	// their next link boundary.
+	drainTimeout time.Duration
+}
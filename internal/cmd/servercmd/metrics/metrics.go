@@ -54,6 +54,14 @@ type Metrics struct {
 	// to be submitted to Gearman.
 	GearmanPendingJobsGauge prometheus.Gauge
 
+	// GearmanSlowJobsCounter counts the job batches that run past the warning
+	// threshold of their timeout policy, labeled by script name.
+	GearmanSlowJobsCounter *prometheus.CounterVec
+
+	// GearmanTimedOutJobsCounter counts the job batches abandoned because they
+	// exceeded their deadline, labeled by script name.
+	GearmanTimedOutJobsCounter *prometheus.CounterVec
+
 	// TaskCounter counts the number of tasks that have been completed.
 	TaskCounter *prometheus.CounterVec
 
@@ -81,6 +89,11 @@ type Metrics struct {
 	// PackageQueueLengthGauge tracks the length of the package queue, segmented
 	// by package type (DIP, SIP, Transfer).
 	PackageQueueLengthGauge *prometheus.GaugeVec
+
+	// SharedDirectoryHeadroomGauge tracks the free space of the shared
+	// directory above the low-water mark, it is negative when the mark is
+	// crossed.
+	SharedDirectoryHeadroomGauge prometheus.Gauge
 }
 
 func NewMetrics(wf *workflow.Document) *Metrics {
@@ -102,6 +115,14 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 			Name: "mcpserver_gearman_pending_jobs",
 			Help: "Number of gearman jobs pending submission",
 		}),
+		GearmanSlowJobsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mcpserver_gearman_slow_jobs_total",
+			Help: "Number of gearman jobs that ran past the warning threshold, labeled by script name",
+		}, []string{"script_name"}),
+		GearmanTimedOutJobsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mcpserver_gearman_timed_out_jobs_total",
+			Help: "Number of gearman jobs that exceeded their deadline, labeled by script name",
+		}, []string{"script_name"}),
 		TaskCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "mcpserver_task_total",
 			Help: "Number of tasks processed, labeled by task group, task name",
@@ -131,6 +152,10 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 			Name: "mcpserver_package_queue_length",
 			Help: "Number of queued packages",
 		}, []string{"package_type"}),
+		SharedDirectoryHeadroomGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mcpserver_shared_directory_headroom_bytes",
+			Help: "Free space of the shared directory above the low-water mark in bytes",
+		}),
 	}
 
 	m.initLabels(wf)
@@ -140,6 +165,8 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 		m.EnvironmentInfo,
 		m.GearmanActiveJobsGauge,
 		m.GearmanPendingJobsGauge,
+		m.GearmanSlowJobsCounter,
+		m.GearmanTimedOutJobsCounter,
 		m.TaskCounter,
 		m.TaskSuccessTimestamp,
 		m.TaskDurationHistogram,
@@ -147,6 +174,7 @@ func NewMetrics(wf *workflow.Document) *Metrics {
 		m.ActiveJobsGauge,
 		m.JobQueueLengthGauge,
 		m.PackageQueueLengthGauge,
+		m.SharedDirectoryHeadroomGauge,
 		collectors.NewBuildInfoCollector(),
 	)
 
@@ -181,6 +209,12 @@ func (m *Metrics) initLabels(wf *workflow.Document) {
 	}
 }
 
+// MustRegister registers additional collectors, e.g. those provided by other
+// components of the application.
+func (m *Metrics) MustRegister(cs ...prometheus.Collector) {
+	m.reg.MustRegister(cs...)
+}
+
 func (m *Metrics) Handler() http.Handler {
 	return promhttp.HandlerFor(
 		m.reg,
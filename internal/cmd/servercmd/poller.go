@@ -0,0 +1,164 @@
+package servercmd
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// poller watches directories comparing snapshots of their entries taken
+// periodically, for filesystems that do not deliver inotify events, e.g. NFS
+// mounts written by other hosts.
+//
+// A new entry is reported once its snapshot is unchanged between two polls,
+// so entries that are still being written are not processed too early. The
+// entries claimed by the observer are skipped, every poll takes the place of
+// a rescan of the watched directories.
+type poller struct {
+	logger logr.Logger
+	o      observer
+	dirs   []string
+	config watcherConfig
+
+	// listed are the entries found by the previous poll.
+	listed map[string]struct{}
+
+	// pending are the new entries waiting to be stable.
+	pending map[string]snapshot
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// snapshot describes an entry of a watched directory. Directories are
+// described by the number, the total size and the latest modification time of
+// their contents.
+type snapshot struct {
+	files   int
+	size    int64
+	modTime time.Time
+}
+
+func newPoller(logger logr.Logger, o observer, dirs []string, config watcherConfig) *poller {
+	p := &poller{
+		logger:  logger,
+		o:       o,
+		dirs:    dirs,
+		config:  config,
+		listed:  map[string]struct{}{},
+		pending: map[string]snapshot{},
+		done:    make(chan struct{}),
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.run()
+	}()
+
+	return p
+}
+
+func (p *poller) run() {
+	ticker := time.NewTicker(p.config.pollInterval)
+	defer ticker.Stop()
+
+	p.poll()
+	for {
+		select {
+		case <-ticker.C:
+			p.poll()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// poll takes a snapshot of every entry of the watched directories that is not
+// claimed. It notifies the observer of the new entries that are stable and
+// releases the entries that are gone.
+func (p *poller) poll() {
+	current := map[string]struct{}{}
+	failed := map[string]struct{}{}
+
+	for _, dir := range p.dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			p.logger.V(1).Info("Error while polling.", "err", err, "path", dir)
+			failed[dir] = struct{}{}
+			continue
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			current[path] = struct{}{}
+			if p.o.Claimed(path) {
+				delete(p.pending, path)
+				continue
+			}
+
+			snap, err := takeSnapshot(path)
+			if err != nil {
+				// The entry may have been removed meanwhile.
+				p.logger.V(2).Info("Error while taking snapshot.", "err", err, "path", path)
+				continue
+			}
+			if prev, ok := p.pending[path]; !ok || prev != snap {
+				p.pending[path] = snap
+				continue
+			}
+
+			delete(p.pending, path)
+			if err := p.o.Notify(path); err != nil {
+				p.logger.Error(err, "Failed to notify controller with a new entry.", "path", path)
+			}
+		}
+	}
+
+	for path := range p.listed {
+		if _, ok := current[path]; ok {
+			continue
+		}
+		if _, ok := failed[filepath.Dir(path)]; ok {
+			current[path] = struct{}{}
+			continue
+		}
+		p.o.Release(path)
+	}
+	p.listed = current
+	for path := range p.pending {
+		if _, ok := current[path]; !ok {
+			delete(p.pending, path)
+		}
+	}
+}
+
+// Close stops the polling.
+func (p *poller) Close() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+func takeSnapshot(path string) (snapshot, error) {
+	var snap snapshot
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		snap.files++
+		snap.size += info.Size()
+		if info.ModTime().After(snap.modTime) {
+			snap.modTime = info.ModTime()
+		}
+		return nil
+	})
+
+	return snap, err
+}
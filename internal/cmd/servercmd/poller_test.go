@@ -0,0 +1,175 @@
+package servercmd
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+	"gotest.tools/v3/poll"
+)
+
+// fakeObserver claims the paths notified like the controller does.
+type fakeObserver struct {
+	notified []string
+	released []string
+	claimed  map[string]struct{}
+	rescans  int
+	mu       sync.Mutex
+}
+
+func (o *fakeObserver) Notify(path string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.claimed[path]; ok {
+		return nil
+	}
+	if o.claimed == nil {
+		o.claimed = map[string]struct{}{}
+	}
+	o.claimed[path] = struct{}{}
+	o.notified = append(o.notified, path)
+	return nil
+}
+
+func (o *fakeObserver) Release(path string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.claimed[path]; ok {
+		delete(o.claimed, path)
+		o.released = append(o.released, path)
+	}
+}
+
+func (o *fakeObserver) Claimed(path string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, ok := o.claimed[path]
+	return ok
+}
+
+func (o *fakeObserver) Rescan() (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.rescans++
+	return 0, nil
+}
+
+func (o *fakeObserver) state() ([]string, []string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]string{}, o.notified...), append([]string{}, o.released...)
+}
+
+func TestPoller(t *testing.T) {
+	t.Parallel()
+
+	newSnapshotPoller := func(dirs ...string) (*poller, *fakeObserver) {
+		o := &fakeObserver{}
+		return &poller{
+			logger:  logr.Discard(),
+			o:       o,
+			dirs:    dirs,
+			listed:  map[string]struct{}{},
+			pending: map[string]snapshot{},
+		}, o
+	}
+
+	t.Run("Notifies new entries once stable", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "ccp", fs.WithDir("Images", fs.WithFile("image.jpg", "12345")))
+		p, o := newSnapshotPoller(dir.Path())
+
+		p.poll()
+		assert.Equal(t, len(o.notified), 0)
+
+		// The entry is still being written.
+		assert.NilError(t, os.WriteFile(dir.Join("Images", "notes.txt"), []byte("123"), 0o600))
+		p.poll()
+		assert.Equal(t, len(o.notified), 0)
+
+		p.poll()
+		assert.DeepEqual(t, o.notified, []string{dir.Join("Images")})
+
+		// Entries are notified only once.
+		p.poll()
+		assert.DeepEqual(t, o.notified, []string{dir.Join("Images")})
+	})
+
+	t.Run("Releases entries that are gone", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "ccp", fs.WithFile("image.jpg", "12345"))
+		p, o := newSnapshotPoller(dir.Path())
+
+		p.poll()
+		p.poll()
+		assert.DeepEqual(t, o.notified, []string{dir.Join("image.jpg")})
+
+		assert.NilError(t, os.Rename(dir.Join("image.jpg"), dir.Join("photo.jpg")))
+		p.poll()
+		assert.DeepEqual(t, o.released, []string{dir.Join("image.jpg")})
+
+		p.poll()
+		assert.DeepEqual(t, o.notified, []string{dir.Join("image.jpg"), dir.Join("photo.jpg")})
+	})
+
+	t.Run("Skips entries claimed by the observer", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "ccp", fs.WithDir("Images", fs.WithFile("image.jpg", "12345")))
+		p, o := newSnapshotPoller(dir.Path())
+		assert.NilError(t, o.Notify(dir.Join("Images"))) // e.g. claimed by an ingest.
+
+		p.poll()
+		p.poll()
+		assert.DeepEqual(t, o.notified, []string{dir.Join("Images")})
+		assert.Equal(t, len(p.pending), 0)
+	})
+
+	t.Run("Keeps entries of directories that cannot be read", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "ccp", fs.WithDir("transfers", fs.WithFile("image.jpg", "12345")))
+		p, o := newSnapshotPoller(dir.Join("transfers"))
+
+		p.poll()
+		p.poll()
+		assert.Equal(t, len(o.notified), 1)
+
+		assert.NilError(t, os.Rename(dir.Join("transfers"), dir.Join("unmounted")))
+		p.poll()
+		p.poll()
+		assert.Equal(t, len(o.released), 0)
+	})
+
+	t.Run("Polls until closed", func(t *testing.T) {
+		t.Parallel()
+
+		dir := fs.NewDir(t, "ccp", fs.WithFile("image.jpg", "12345"))
+		o := &fakeObserver{}
+		p := newPoller(logr.Discard(), o, []string{dir.Path()}, watcherConfig{pollInterval: 10 * time.Millisecond, rescanInterval: time.Millisecond})
+		defer p.Close()
+
+		poll.WaitOn(t, func(poll.LogT) poll.Result {
+			if notified, _ := o.state(); len(notified) == 0 {
+				return poll.Continue("waiting for notification")
+			}
+			return poll.Success()
+		}, poll.WithDelay(10*time.Millisecond))
+
+		// Rescans would bypass the stability check.
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		assert.Equal(t, o.rescans, 0)
+	})
+}
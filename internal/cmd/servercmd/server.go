@@ -10,12 +10,18 @@ import (
 
 	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
-	"github.com/gohugoio/hugo/watcher"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/artefactual-labs/ccp/internal/api/admin"
+	"github.com/artefactual-labs/ccp/internal/api/worker"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/tracing"
 	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/storage"
 	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/webhook"
 	"github.com/artefactual-labs/ccp/internal/webui"
+	"github.com/artefactual-labs/ccp/internal/workers"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
@@ -28,18 +34,33 @@ type Server struct {
 	// Metrics server.
 	metrics *metricsServer
 
+	// Tracer provider.
+	tracing *tracing.Provider
+
 	// Data store.
 	store store.Store
 
 	// Embedded job server compatible with Gearman.
 	gearman *gearmin.Server
 
+	// Workers connected to the job server.
+	workers *workers.Registry
+
+	// Worker API, used instead of the job server by the connect executor.
+	worker *worker.Server
+
 	// Filesystem watcher.
-	watcher *watcher.Batcher
+	watcher dirWatcher
+
+	// Storage locations of the transfer sources.
+	locations *storage.Registry
 
 	// Workflow processor.
 	controller *controller.Controller
 
+	// Webhook dispatcher.
+	webhooks *webhook.Dispatcher
+
 	// Admin API.
 	admin *admin.Server
 
@@ -79,23 +100,19 @@ func (s *Server) Run() error {
 		return fmt.Errorf("error creating metrics server: %v", err)
 	}
 
+	s.logger.V(1).Info("Creating tracer provider.", "exporter", s.config.tracing.Exporter)
+	if s.tracing, err = tracing.NewProvider(s.ctx, s.config.tracing); err != nil {
+		return fmt.Errorf("error creating tracer provider: %v", err)
+	}
+	otel.SetTracerProvider(s.tracing)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
 	s.logger.V(1).Info("Creating database store.")
 	s.store, err = store.New(s.logger.WithName("store"), s.config.db.driver, s.config.db.dsn)
 	if err != nil {
 		return fmt.Errorf("error creating database store: %v", err)
 	}
 
-	s.logger.V(1).Info("Cleaning up database.")
-	{
-		ctx, cancel := context.WithTimeout(s.ctx, time.Second*10)
-		defer cancel()
-
-		err = s.store.RemoveTransientData(ctx)
-	}
-	if err != nil {
-		return fmt.Errorf("error cleaning up database: %v", err)
-	}
-
 	s.logger.V(1).Info("Creating shared directories.", "path", s.config.sharedDir)
 	if err := createSharedDirs(s.config.sharedDir); err != nil {
 		return fmt.Errorf("error creating shared directories: %v", err)
@@ -111,28 +128,91 @@ func (s *Server) Run() error {
 		return fmt.Errorf("error creating built-in processing configurations: %v", err)
 	}
 
-	s.logger.V(1).Info("Creating Gearman job server.")
-	ln, err := net.Listen("tcp", s.config.gearmin.addr)
-	if err != nil {
-		return fmt.Errorf("error creating gearmin listener: %v", err)
-	} else {
-		s.gearman = gearmin.NewServer(ln)
+	var executor controller.Executor
+	switch s.config.executor {
+	case executorGearman:
+		s.logger.V(1).Info("Creating Gearman job server.")
+		ln, err := net.Listen("tcp", s.config.gearmin.addr)
+		if err != nil {
+			return fmt.Errorf("error creating gearmin listener: %v", err)
+		} else {
+			s.workers = workers.NewRegistry()
+			s.metrics.metrics.MustRegister(s.workers)
+			s.gearman = gearmin.NewServer(s.workers.Listen(ln))
+		}
+		executor = controller.NewGearmanExecutor(s.gearman, s.workers)
+	case executorConnect:
+		s.logger.V(1).Info("Creating Worker API.")
+		if s.worker, err = worker.New(s.logger.WithName("api.worker"), s.config.api.worker); err != nil {
+			return fmt.Errorf("error creating Worker API: %v", err)
+		}
+		if err := s.worker.Run(); err != nil {
+			return fmt.Errorf("error running Worker API: %v", err)
+		}
+		executor = s.worker
+	default:
+		return fmt.Errorf("unknown executor %q", s.config.executor)
 	}
 
+	s.logger.V(1).Info("Creating storage locations.", "count", len(s.config.storage.Locations))
+	if s.locations, err = storage.NewRegistry(s.config.storage); err != nil {
+		return fmt.Errorf("error creating storage locations: %v", err)
+	}
+	s.config.controller.Locations = s.locations
+
 	s.logger.V(1).Info("Creating controller.")
-	s.controller = controller.New(s.logger.WithName("controller"), s.metrics.metrics, s.store, s.gearman, wf, s.config.sharedDir, watchedDir)
+	s.controller = controller.New(s.logger.WithName("controller"), s.metrics.metrics, s.store, executor, wf, s.config.controller, s.config.sharedDir, watchedDir)
+
+	s.logger.V(1).Info("Creating webhook dispatcher.")
+	if s.webhooks, err = webhook.New(s.logger.WithName("webhook"), s.config.webhooks, s.store); err != nil {
+		return fmt.Errorf("error creating webhook dispatcher: %v", err)
+	}
+	s.webhooks.Run(s.controller)
+
+	s.logger.V(1).Info("Resuming packages.")
+	var results []controller.ResumeResult
+	{
+		ctx, cancel := context.WithTimeout(s.ctx, time.Second*30)
+		defer cancel()
+
+		results, err = s.controller.Resume(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("error resuming packages: %v", err)
+	}
+	if len(results) > 0 {
+		var resumed int
+		for _, item := range results {
+			if item.Resumed {
+				resumed++
+			}
+		}
+		s.logger.Info("Packages left in processing found.", "resumed", resumed, "unresumable", len(results)-resumed)
+	}
+
+	s.logger.V(1).Info("Cleaning up database.")
+	{
+		ctx, cancel := context.WithTimeout(s.ctx, time.Second*10)
+		defer cancel()
+
+		err = s.store.RemoveTransientData(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("error cleaning up database: %v", err)
+	}
+
 	if err := s.controller.Run(); err != nil {
 		return fmt.Errorf("error creating controller: %v", err)
 	}
 
 	s.logger.V(1).Info("Creating filesystem watchers.", "path", watchedDir)
-	if s.watcher, err = watch(s.logger.WithName("watcher"), s.controller, wf, watchedDir); err != nil {
+	if s.watcher, err = watch(s.logger.WithName("watcher"), s.controller, wf, watchedDir, s.config.watcher); err != nil {
 		return fmt.Errorf("error creating filesystem watchers: %v", err)
 	}
 
 	s.logger.V(1).Info("Creating admin API.")
 	processingConfigForm := workflow.NewProcessingConfigForm(wf)
-	if s.admin, err = admin.New(s.logger.WithName("api.admin"), s.config.api.admin, s.controller, s.store, wf, processingConfigForm); err != nil {
+	if s.admin, err = admin.New(s.logger.WithName("api.admin"), s.config.api.admin, s.controller, s.webhooks, s.workers, s.store, wf, processingConfigForm); err != nil {
 		return fmt.Errorf("error creating admin API: %v", err)
 	}
 	if err := s.admin.Run(); err != nil {
@@ -150,6 +230,28 @@ func (s *Server) Run() error {
 	return nil
 }
 
+// Draining returns a channel that is closed when the controller starts
+// draining, e.g. when requested via the admin API.
+func (s *Server) Draining() <-chan struct{} {
+	if s.controller == nil {
+		return nil
+	}
+
+	return s.controller.Draining()
+}
+
+// Drain stops the processing of packages gracefully and waits until the active
+// packages reach their next link boundary or the context is done.
+func (s *Server) Drain(ctx context.Context) error {
+	if s.controller == nil {
+		return nil
+	}
+
+	s.controller.Drain()
+
+	return s.controller.WaitDrained(ctx)
+}
+
 func (s *Server) Close() error {
 	var errs error
 
@@ -168,6 +270,14 @@ func (s *Server) Close() error {
 		errs = errors.Join(errs, s.controller.Close())
 	}
 
+	if s.webhooks != nil {
+		errs = errors.Join(errs, s.webhooks.Close())
+	}
+
+	if s.locations != nil {
+		errs = errors.Join(errs, s.locations.Close())
+	}
+
 	if s.watcher != nil {
 		s.watcher.Close()
 	}
@@ -184,7 +294,17 @@ func (s *Server) Close() error {
 		errs = errors.Join(errs, s.metrics.Close(ctx))
 	}
 
-	s.gearman.Stop()
+	if s.worker != nil {
+		errs = errors.Join(errs, s.worker.Close(ctx))
+	}
+
+	if s.gearman != nil {
+		s.gearman.Stop()
+	}
+
+	if s.tracing != nil {
+		errs = errors.Join(errs, s.tracing.Shutdown(ctx))
+	}
 
 	return errs
 }
@@ -0,0 +1,21 @@
+package tracing
+
+type Config struct {
+	// Exporter is the span exporter: "otlp", "file" or "stdout". Tracing is
+	// disabled when empty.
+	Exporter string
+
+	// Endpoint is the address of the OTLP/HTTP collector, e.g.
+	// "localhost:4318". The OTEL_EXPORTER_OTLP_* environment variables are
+	// used when empty.
+	Endpoint string
+
+	// Insecure disables TLS when connecting to the OTLP collector.
+	Insecure bool
+
+	// Path is the file where the spans are written by the file exporter.
+	Path string
+
+	// SampleRatio is the fraction of packages and requests traced.
+	SampleRatio float64
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/artefactual-labs/ccp/internal/version"
+)
+
+// Span exporters.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterFile   = "file"
+	ExporterStdout = "stdout"
+)
+
+// Provider creates the tracers used across the application.
+type Provider struct {
+	trace.TracerProvider
+
+	sdk    *sdktrace.TracerProvider
+	closer io.Closer
+}
+
+// NewProvider returns a tracer provider that sends the spans to the exporter
+// configured. The provider does not record spans when tracing is disabled.
+func NewProvider(ctx context.Context, config Config) (*Provider, error) {
+	if config.Exporter == "" {
+		return &Provider{TracerProvider: noop.NewTracerProvider()}, nil
+	}
+
+	p := &Provider{}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch config.Exporter {
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if config.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(config.Endpoint))
+		}
+		if config.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case ExporterFile:
+		if config.Path == "" {
+			return nil, errors.New("file exporter requires a path")
+		}
+		var f *os.File
+		f, err = os.OpenFile(config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		p.closer = f
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", config.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s exporter: %v", config.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("ccp"),
+		semconv.ServiceVersion(version.Version()),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	p.sdk = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	p.TracerProvider = p.sdk
+
+	return p, nil
+}
+
+// Shutdown flushes the spans pending and stops the exporter.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs error
+	if p.sdk != nil {
+		errs = errors.Join(errs, p.sdk.Shutdown(ctx))
+	}
+	if p.closer != nil {
+		errs = errors.Join(errs, p.closer.Close())
+	}
+
+	return errs
+}
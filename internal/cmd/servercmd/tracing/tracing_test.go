@@ -0,0 +1,62 @@
+package tracing_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/tracing"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Disables tracing by default", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := tracing.NewProvider(context.Background(), tracing.Config{})
+		assert.NilError(t, err)
+
+		_, span := p.Tracer("test").Start(context.Background(), "span")
+		assert.Assert(t, !span.IsRecording())
+		assert.NilError(t, p.Shutdown(context.Background()))
+	})
+
+	t.Run("Writes spans to a file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "spans.json")
+		p, err := tracing.NewProvider(context.Background(), tracing.Config{
+			Exporter:    tracing.ExporterFile,
+			Path:        path,
+			SampleRatio: 1,
+		})
+		assert.NilError(t, err)
+
+		_, span := p.Tracer("test").Start(context.Background(), "package")
+		span.End()
+		assert.NilError(t, p.Shutdown(context.Background()))
+
+		blob, err := os.ReadFile(path)
+		assert.NilError(t, err)
+		assert.Assert(t, strings.Contains(string(blob), `"Name":"package"`), string(blob))
+	})
+
+	t.Run("Rejects unknown exporters", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tracing.NewProvider(context.Background(), tracing.Config{Exporter: "jaeger"})
+		assert.Error(t, err, `unknown exporter "jaeger"`)
+	})
+
+	t.Run("Requires a path for the file exporter", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tracing.NewProvider(context.Background(), tracing.Config{Exporter: tracing.ExporterFile})
+		assert.Error(t, err, "file exporter requires a path")
+	})
+}
@@ -2,6 +2,7 @@ package servercmd
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -15,15 +16,21 @@ import (
 
 type observer interface {
 	Notify(path string) error
+	Release(path string)
+	Claimed(path string) bool
+	Rescan() (int, error)
 }
 
-func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (*watcher.Batcher, error) {
-	w, err := watcher.New(500*time.Millisecond, 700*time.Millisecond, false)
-	if err != nil {
-		return nil, err
-	}
+// dirWatcher reports the packages placed in the watched directories.
+type dirWatcher interface {
+	Close()
+}
 
-	var errs error
+func watch(logger logr.Logger, o observer, wf *workflow.Document, path string, config watcherConfig) (dirWatcher, error) {
+	var (
+		dirs []string
+		errs error
+	)
 	for _, wd := range wf.WatchedDirectories {
 		wdPath := filepath.Join(path, wd.Path)
 		info, err := os.Stat(wdPath)
@@ -36,20 +43,66 @@ func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (
 			continue
 		}
 		logger.V(2).Info("Watching directory.", "path", wdPath)
-		if err := w.Add(wdPath); err != nil {
-			errs = errors.Join(errs, err)
-			continue
-		}
+		dirs = append(dirs, wdPath)
 	}
 	if errs != nil {
 		return nil, errs
 	}
 
+	var w dirWatcher
+	switch config.backend {
+	case watcherFsnotify, "":
+		batcher, err := watchEvents(logger, o, dirs, config)
+		if err != nil {
+			return nil, err
+		}
+		w = batcher
+
+		// Packages placed while the application was not running.
+		rescan(logger, o)
+	case watcherPoll:
+		// The packages placed while the application was not running are
+		// found by the first polls, once they are stable.
+		if config.pollInterval <= 0 {
+			return nil, fmt.Errorf("invalid poll interval %s", config.pollInterval)
+		}
+		w = newPoller(logger, o, dirs, config)
+	default:
+		return nil, fmt.Errorf("unknown watcher backend %q", config.backend)
+	}
+
+	return w, nil
+}
+
+// watchEvents watches the directories using the filesystem events, e.g.
+// inotify in Linux.
+func watchEvents(logger logr.Logger, o observer, dirs []string, config watcherConfig) (*watcher.Batcher, error) {
+	w, err := watcher.New(500*time.Millisecond, 700*time.Millisecond, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dir := range dirs {
+		if err := w.Add(dir); err != nil {
+			w.Close()
+			return nil, err
+		}
+	}
+
 	go func() {
+		var tick <-chan time.Time
+		if config.rescanInterval > 0 {
+			ticker := time.NewTicker(config.rescanInterval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+
 		for {
 			select {
 			case evs := <-w.Events:
 				notify(logger, o, evs)
+			case <-tick:
+				rescan(logger, o)
 			case err := <-w.Errors():
 				if err != nil {
 					logger.V(1).Info("Error while watching.", "err", err)
@@ -64,7 +117,12 @@ func watch(logger logr.Logger, o observer, wf *workflow.Document, path string) (
 
 func notify(logger logr.Logger, o observer, evs []fsnotify.Event) {
 	for _, ev := range evs {
-		if ev.Op&fsnotify.Create != fsnotify.Create {
+		// The package was moved out of the watched directory.
+		if ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename) {
+			o.Release(ev.Name)
+			continue
+		}
+		if !ev.Has(fsnotify.Create) {
 			continue
 		}
 		if err := o.Notify(ev.Name); err != nil {
@@ -72,3 +130,15 @@ func notify(logger logr.Logger, o observer, evs []fsnotify.Event) {
 		}
 	}
 }
+
+// rescan notifies the observer of the packages found in the watched
+// directories that are not claimed yet.
+func rescan(logger logr.Logger, o observer) {
+	n, err := o.Rescan()
+	if err != nil {
+		logger.Error(err, "Failed to rescan watched directories.")
+	}
+	if n > 0 {
+		logger.Info("Found unclaimed packages in watched directories.", "count", n)
+	}
+}
@@ -0,0 +1,28 @@
+package workflowcmd
+
+import (
+	"context"
+	"flag"
+	"io"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
+)
+
+func New(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	fs := flag.NewFlagSet("ccp workflow", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "workflow",
+		ShortUsage: "ccp workflow <subcommand> [flags]",
+		ShortHelp:  "Inspect workflow documents.",
+		FlagSet:    fs,
+		Subcommands: []*ffcli.Command{
+			newSimulateCommand(rootConfig, out),
+		},
+		Exec: func(context.Context, []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
@@ -0,0 +1,183 @@
+package workflowcmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+type simulateConfig struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+
+	workflow         string
+	watchedDir       string
+	transferType     string
+	processingConfig string
+	exitCodes        map[string][]int
+	resolveBlocking  bool
+	json             bool
+}
+
+func newSimulateCommand(rootConfig *rootcmd.Config, out io.Writer) *ffcli.Command {
+	cfg := simulateConfig{
+		rootConfig: rootConfig,
+		out:        out,
+		exitCodes:  map[string][]int{},
+	}
+
+	fs := flag.NewFlagSet("ccp workflow simulate", flag.ExitOnError)
+	fs.StringVar(&cfg.workflow, "workflow", "", "Path to the workflow document (defaults to the embedded workflow)")
+	fs.StringVar(&cfg.watchedDir, "watched-dir", "", "Watched directory where the package is placed, e.g. activeTransfers/standardTransfer")
+	fs.StringVar(&cfg.transferType, "transfer-type", "standard", "Transfer type submitted via the API when -watched-dir is not set")
+	fs.StringVar(&cfg.processingConfig, "processing-config", "default", "Built-in processing configuration (default, automated) or path to a processing configuration file")
+	fs.Func("exit-code", "Exit codes returned by a script or link, e.g. hasPackages_v0.0=0,1 (repeatable)", func(value string) error {
+		key, codes, err := controller.ParseExitCodes(value)
+		if err != nil {
+			return err
+		}
+		cfg.exitCodes[key] = codes
+		return nil
+	})
+	fs.BoolVar(&cfg.resolveBlocking, "resolve-blocking", false, "Continue past blocking decision points using their first choice")
+	fs.BoolVar(&cfg.json, "json", false, "Print the simulation in JSON format")
+
+	return &ffcli.Command{
+		Name:       "simulate",
+		ShortUsage: "ccp workflow simulate [flags]",
+		ShortHelp:  "Walk the workflow without running any job.",
+		LongHelp: "Walk the workflow like the controller would process a package, resolving\n" +
+			"decision points with the processing configuration. Client scripts are not\n" +
+			"run, they exit with zero unless configured otherwise with -exit-code. The\n" +
+			"command fails unless the package reaches a terminal link.",
+		FlagSet: fs,
+		Exec:    cfg.exec,
+	}
+}
+
+func (c *simulateConfig) exec(ctx context.Context, args []string) error {
+	var (
+		wf  *workflow.Document
+		err error
+	)
+	if c.workflow != "" {
+		wf, err = workflow.LoadFromFile(c.workflow)
+	} else {
+		wf, err = workflow.Default()
+	}
+	if err != nil {
+		return fmt.Errorf("load workflow: %v", err)
+	}
+
+	choices, err := c.loadProcessingConfig()
+	if err != nil {
+		return fmt.Errorf("load processing configuration: %v", err)
+	}
+
+	config := controller.SimulationConfig{
+		WatchedDir:       c.watchedDir,
+		ProcessingConfig: choices,
+		ExitCodes:        c.exitCodes,
+		ResolveBlocking:  c.resolveBlocking,
+	}
+	if c.watchedDir == "" {
+		tt := controller.Transfers.WithName(c.transferType)
+		if tt == nil {
+			return fmt.Errorf("unknown transfer type %q", c.transferType)
+		}
+		config.TransferType = tt.Type
+	}
+
+	sim, err := controller.Simulate(wf, config)
+	if err != nil {
+		return err
+	}
+
+	if c.json {
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(sim); err != nil {
+			return err
+		}
+	} else {
+		printSimulation(c.out, sim)
+	}
+
+	if sim.Outcome != controller.SimulationOutcomeDone {
+		return fmt.Errorf("simulation outcome: %s", sim.Outcome)
+	}
+
+	return nil
+}
+
+// loadProcessingConfig returns the choices of a built-in processing
+// configuration or of the processing configuration file given.
+func (c *simulateConfig) loadProcessingConfig() ([]workflow.Choice, error) {
+	if config, ok := workflow.BuiltinConfig(c.processingConfig); ok {
+		return config.Choices, nil
+	}
+	if c.processingConfig == "" {
+		return nil, errors.New("empty name")
+	}
+
+	return workflow.ParseConfigFile(c.processingConfig)
+}
+
+func printSimulation(w io.Writer, sim *controller.Simulation) {
+	fmt.Fprintln(w, "Path:")
+	for _, step := range sim.Steps {
+		if step.LinkID == uuid.Nil {
+			fmt.Fprintf(w, "  Chain %s %q", step.ChainID, step.Description)
+			if step.WatchedDir != "" {
+				fmt.Fprintf(w, " (watched directory: %s)", step.WatchedDir)
+			}
+			fmt.Fprintln(w)
+			continue
+		}
+		fmt.Fprintf(w, "    Link %s %q", step.LinkID, step.Description)
+		if step.Script != "" {
+			fmt.Fprintf(w, " [%s exit=%d]", step.Script, step.ExitCode)
+		}
+		if step.Decision != "" {
+			fmt.Fprintf(w, " [%s]", step.Decision)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(sim.Blocking) > 0 {
+		fmt.Fprintln(w, "\nBlocking decisions:")
+		for _, step := range sim.Blocking {
+			fmt.Fprintf(w, "  Link %s %q\n", step.LinkID, step.Description)
+			if len(step.Choices) > 0 {
+				fmt.Fprintf(w, "    Choices: %s\n", strings.Join(step.Choices, ", "))
+			}
+		}
+	}
+
+	if len(sim.Unresolved) > 0 {
+		fmt.Fprintln(w, "\nUnresolved branches:")
+		for _, item := range sim.Unresolved {
+			branch := "fallback"
+			if item.ExitCode != nil {
+				branch = fmt.Sprintf("exit code %d", *item.ExitCode)
+			}
+			fmt.Fprintf(w, "  Link %s %q (%s): %s\n", item.LinkID, item.Description, branch, item.Reason)
+		}
+	}
+
+	fmt.Fprintf(w, "\nOutcome: %s\n", sim.Outcome)
+	if sim.Reason != "" {
+		fmt.Fprintf(w, "Reason: %s\n", sim.Reason)
+	}
+}
@@ -0,0 +1,355 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+// defaultMaxActivePackages is the concurrency limit used when the
+// configuration does not provide one.
+const defaultMaxActivePackages = 2
+
+// defaultMaxActiveIngests is the limit of transfers copied concurrently used
+// when the configuration does not provide one.
+const defaultMaxActiveIngests = 2
+
+// defaultMaxRetryBackoff is the longest delay between the attempts of a batch
+// used when the retry policy does not provide one.
+const defaultMaxRetryBackoff = 10 * time.Minute
+
+// Config describes how the controller schedules the processing of packages.
+type Config struct {
+	// MaxActivePackages is the maximum number of packages that can be
+	// processed concurrently. Packages awaiting a decision do not count
+	// towards this limit.
+	MaxActivePackages int
+
+	// MaxActiveTransfers is the maximum number of transfers that can be
+	// processed concurrently. Zero means that transfers are only bound by
+	// MaxActivePackages.
+	MaxActiveTransfers int
+
+	// MaxActiveSIPs is the maximum number of SIPs that can be processed
+	// concurrently. Zero means that SIPs are only bound by MaxActivePackages.
+	MaxActiveSIPs int
+
+	// MaxActiveDIPs is the maximum number of DIPs that can be processed
+	// concurrently. Zero means that DIPs are only bound by MaxActivePackages.
+	MaxActiveDIPs int
+
+	// MaxActiveIngests is the maximum number of transfers whose sources are
+	// copied into the processing directory concurrently.
+	MaxActiveIngests int
+
+	// Retries is the retry policy of the scripts run by the workers, indexed
+	// by script name. Batches of tasks are not retried unless a policy is set
+	// for the script or a default policy is set with the "*" key.
+	Retries RetryPolicies
+
+	// Timeouts is the timeout policy of the scripts run by the workers,
+	// indexed by script name. The "*" key sets the default policy.
+	Timeouts TimeoutPolicies
+
+	// Runners enables the in-process runners, the batches of the scripts
+	// that have a runner are not sent to the workers.
+	Runners bool
+
+	// Locations are the storage locations used to resolve the
+	// <location-uuid>:<path> sources of the transfers submitted.
+	Locations *storage.Registry
+
+	// Retention is the retention policy of the directories of the shared
+	// directory, indexed by path relative to the shared directory, e.g.
+	// "failed". Entries of other directories are never removed.
+	Retention RetentionPolicies
+
+	// RetentionInterval is the time between the applications of the
+	// retention policies, zero disables them.
+	RetentionInterval time.Duration
+
+	// RetentionDryRun logs the entries that the retention policies select
+	// instead of removing them.
+	RetentionDryRun bool
+
+	// MinFreeSpace is the low-water mark of the free space of the shared
+	// directory in bytes. New transfers are refused and queued packages are
+	// held while the free space is below the mark. Zero disables the check.
+	MinFreeSpace int64
+
+	// EstimateTransferSize measures the sources of the transfers submitted
+	// before they are copied, transfers that would cross the low-water mark
+	// are refused.
+	EstimateTransferSize bool
+}
+
+// RetryPolicy describes how a batch of tasks is retried when the worker fails
+// to process it, e.g. when the worker crashes or raises an exception. Tasks
+// that complete with a non-zero exit code are not retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of times a batch is submitted.
+	Attempts int
+
+	// Backoff is the delay before the first retry, it doubles with every
+	// attempt up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// RetryPolicies is a set of retry policies indexed by script name.
+type RetryPolicies map[string]RetryPolicy
+
+// policy returns the retry policy of the given script.
+func (p RetryPolicies) policy(script string) RetryPolicy {
+	policy, ok := p[script]
+	if !ok {
+		policy = p["*"]
+	}
+	if policy.Attempts < 1 {
+		policy.Attempts = 1
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultMaxRetryBackoff
+	}
+
+	return policy
+}
+
+// ParseRetryPolicy parses a retry policy given as a list of space-separated
+// key-value pairs, e.g.:
+//
+//	script=copy_v0.0 attempts=3 backoff=10s max-backoff=5m
+//
+// Use script=* to set the default policy.
+func ParseRetryPolicy(value string) (string, RetryPolicy, error) {
+	policy := RetryPolicy{Attempts: 1}
+
+	script, err := parseScriptPolicy(value, func(key, val string) error {
+		switch key {
+		case "attempts":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid attempts %q", val)
+			}
+			policy.Attempts = n
+		case "backoff":
+			d, err := parseDuration("backoff", val)
+			if err != nil {
+				return err
+			}
+			policy.Backoff = d
+		case "max-backoff":
+			d, err := parseDuration("max-backoff", val)
+			if err != nil {
+				return err
+			}
+			policy.MaxBackoff = d
+		default:
+			return errUnknownKey
+		}
+		return nil
+	})
+
+	return script, policy, err
+}
+
+// TimeoutPolicy describes how long a batch of tasks can run. Batches that run
+// past the deadline are abandoned and their tasks are marked as timed out.
+type TimeoutPolicy struct {
+	// Timeout is the maximum duration of a batch, zero means no limit.
+	Timeout time.Duration
+
+	// TaskTimeout is the maximum duration of each task. The deadline of a
+	// batch is TaskTimeout times the number of tasks in the batch when it is
+	// shorter than Timeout. Zero means no limit.
+	TaskTimeout time.Duration
+
+	// Warning is the duration after which a batch is reported as slow, zero
+	// disables the report.
+	Warning time.Duration
+}
+
+// deadline returns the maximum duration of a batch with the given number of
+// tasks, zero means no limit.
+func (p TimeoutPolicy) deadline(tasks int) time.Duration {
+	deadline := p.Timeout
+	if p.TaskTimeout > 0 {
+		if d := p.TaskTimeout * time.Duration(tasks); deadline == 0 || d < deadline {
+			deadline = d
+		}
+	}
+
+	return deadline
+}
+
+// TimeoutPolicies is a set of timeout policies indexed by script name.
+type TimeoutPolicies map[string]TimeoutPolicy
+
+// policy returns the timeout policy of the given script.
+func (p TimeoutPolicies) policy(script string) TimeoutPolicy {
+	if policy, ok := p[script]; ok {
+		return policy
+	}
+
+	return p["*"]
+}
+
+// ParseTimeoutPolicy parses a timeout policy given as a list of space-separated
+// key-value pairs, e.g.:
+//
+//	script=normalize_v1.0 timeout=6h task-timeout=10m warning=1h
+//
+// Use script=* to set the default policy.
+func ParseTimeoutPolicy(value string) (string, TimeoutPolicy, error) {
+	var policy TimeoutPolicy
+
+	script, err := parseScriptPolicy(value, func(key, val string) (err error) {
+		switch key {
+		case "timeout":
+			policy.Timeout, err = parseDuration(key, val)
+		case "task-timeout":
+			policy.TaskTimeout, err = parseDuration(key, val)
+		case "warning":
+			policy.Warning, err = parseDuration(key, val)
+		default:
+			err = errUnknownKey
+		}
+		return err
+	})
+
+	return script, policy, err
+}
+
+// RetentionPolicy describes when the entries of a directory of the shared
+// directory are removed, e.g. the packages left in the failed directory. An
+// entry is selected when it is older than MaxAge, then the oldest entries are
+// selected until the directory is not larger than MaxSize.
+type RetentionPolicy struct {
+	// MaxAge is the maximum age of the entries, measured from the most
+	// recent modification of the entry or its contents. Zero means no limit.
+	MaxAge time.Duration
+
+	// MaxSize is the maximum size of the directory in bytes, zero means no
+	// limit.
+	MaxSize int64
+}
+
+// RetentionPolicies is a set of retention policies indexed by directory.
+type RetentionPolicies map[string]RetentionPolicy
+
+// retentionDirs are the directories of the shared directory that retention
+// policies can be applied to, including their subdirectories.
+var retentionDirs = []string{"completed", "currentlyProcessing", "failed", "rejected", "tmp"}
+
+// ParseRetentionPolicy parses a retention policy given as a list of
+// space-separated key-value pairs, e.g.:
+//
+//	dir=failed max-age=720h max-size=10GiB
+//
+// The directory is relative to the shared directory, it must be one of
+// retentionDirs or one of their subdirectories. Sizes are parsed with
+// ParseSize.
+func ParseRetentionPolicy(value string) (string, RetentionPolicy, error) {
+	var policy RetentionPolicy
+
+	dir, err := parsePolicy(value, "dir", func(key, val string) error {
+		switch key {
+		case "max-age":
+			d, err := parseDuration(key, val)
+			if err != nil {
+				return err
+			}
+			policy.MaxAge = d
+		case "max-size":
+			n, err := ParseSize(val)
+			if err != nil {
+				return fmt.Errorf("invalid max-size %q", val)
+			}
+			policy.MaxSize = n
+		default:
+			return errUnknownKey
+		}
+		return nil
+	})
+	if err != nil {
+		return "", policy, err
+	}
+
+	dir = filepath.Clean(dir)
+	if !filepath.IsLocal(dir) {
+		return "", policy, fmt.Errorf("invalid dir %q: not relative to the shared directory", dir)
+	}
+	if top, _, _ := strings.Cut(filepath.ToSlash(dir), "/"); !slices.Contains(retentionDirs, top) {
+		return "", policy, fmt.Errorf("invalid dir %q: must be one of %s or a subdirectory", dir, strings.Join(retentionDirs, ", "))
+	}
+	if policy.MaxAge == 0 && policy.MaxSize == 0 {
+		return "", policy, fmt.Errorf("missing max-age or max-size")
+	}
+
+	return dir, policy, nil
+}
+
+// ParseSize parses a size in bytes with an optional unit of SI or IEC, e.g.
+// "500MB" or "10GiB".
+func ParseSize(value string) (int64, error) {
+	n, err := humanize.ParseBytes(value)
+	if err != nil || n > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+
+	return int64(n), nil
+}
+
+var errUnknownKey = errors.New("unknown key")
+
+// parseScriptPolicy parses the space-separated key-value pairs of a policy. It
+// returns the value of the script key, the rest are passed to set.
+func parseScriptPolicy(value string, set func(key, val string) error) (string, error) {
+	return parsePolicy(value, "script", set)
+}
+
+// parsePolicy is like parseScriptPolicy but the policy is identified by the
+// value of the given key.
+func parsePolicy(value, idKey string, set func(key, val string) error) (string, error) {
+	var id string
+
+	for _, field := range strings.Fields(value) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return "", fmt.Errorf("invalid field %q: missing value", field)
+		}
+		if key == idKey {
+			id = val
+			continue
+		}
+		if err := set(key, val); err == errUnknownKey {
+			return "", fmt.Errorf("invalid field %q: unknown key", field)
+		} else if err != nil {
+			return "", err
+		}
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("missing %s", idKey)
+	}
+
+	return id, nil
+}
+
+func parseDuration(key, val string) (time.Duration, error) {
+	d, err := time.ParseDuration(val)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s %q", key, val)
+	}
+
+	return d, nil
+}
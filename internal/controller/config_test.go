@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value      string
+		wantScript string
+		want       RetryPolicy
+		wantErr    string
+	}{
+		"Parses a policy": {
+			value:      "script=copy_v0.0 attempts=3 backoff=10s max-backoff=5m",
+			wantScript: "copy_v0.0",
+			want:       RetryPolicy{Attempts: 3, Backoff: 10 * time.Second, MaxBackoff: 5 * time.Minute},
+		},
+		"Parses a default policy": {
+			value:      "attempts=2 script=*",
+			wantScript: "*",
+			want:       RetryPolicy{Attempts: 2},
+		},
+		"Rejects missing scripts": {
+			value:   "attempts=2",
+			wantErr: "missing script",
+		},
+		"Rejects invalid attempts": {
+			value:   "script=copy_v0.0 attempts=0",
+			wantErr: `invalid attempts "0"`,
+		},
+		"Rejects invalid maximum backoffs": {
+			value:   "script=copy_v0.0 max-backoff=-1s",
+			wantErr: `invalid max-backoff "-1s"`,
+		},
+		"Rejects unknown keys": {
+			value:   "script=copy_v0.0 jitter=1s",
+			wantErr: `invalid field "jitter=1s": unknown key`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			script, policy, err := ParseRetryPolicy(tc.value)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, script, tc.wantScript)
+			assert.DeepEqual(t, policy, tc.want)
+		})
+	}
+}
+
+func TestRetryPolicies(t *testing.T) {
+	t.Parallel()
+
+	var policies RetryPolicies
+	assert.DeepEqual(t, policies.policy("copy_v0.0"), RetryPolicy{Attempts: 1, MaxBackoff: defaultMaxRetryBackoff})
+
+	policies = RetryPolicies{
+		"*":         {Attempts: 2},
+		"copy_v0.0": {Attempts: 5, Backoff: time.Second, MaxBackoff: time.Minute},
+	}
+	assert.DeepEqual(t, policies.policy("copy_v0.0"), RetryPolicy{Attempts: 5, Backoff: time.Second, MaxBackoff: time.Minute})
+	assert.DeepEqual(t, policies.policy("move_v0.0"), RetryPolicy{Attempts: 2, MaxBackoff: defaultMaxRetryBackoff})
+}
+
+func TestParseTimeoutPolicy(t *testing.T) {
+	t.Parallel()
+
+	script, policy, err := ParseTimeoutPolicy("script=normalize_v1.0 timeout=6h task-timeout=10m warning=1h")
+	assert.NilError(t, err)
+	assert.Equal(t, script, "normalize_v1.0")
+	assert.DeepEqual(t, policy, TimeoutPolicy{Timeout: 6 * time.Hour, TaskTimeout: 10 * time.Minute, Warning: time.Hour})
+
+	_, _, err = ParseTimeoutPolicy("script=normalize_v1.0 timeout=soon")
+	assert.Error(t, err, `invalid timeout "soon"`)
+}
+
+func TestTimeoutPolicyDeadline(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, TimeoutPolicy{}.deadline(10), time.Duration(0))
+	assert.Equal(t, TimeoutPolicy{Timeout: time.Hour}.deadline(10), time.Hour)
+	assert.Equal(t, TimeoutPolicy{TaskTimeout: time.Minute}.deadline(10), 10*time.Minute)
+	assert.Equal(t, TimeoutPolicy{Timeout: time.Hour, TaskTimeout: time.Minute}.deadline(10), 10*time.Minute)
+	assert.Equal(t, TimeoutPolicy{Timeout: time.Hour, TaskTimeout: time.Minute}.deadline(128), time.Hour)
+}
+
+func TestParseRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		wantDir string
+		want    RetentionPolicy
+		wantErr string
+	}{
+		"Parses a policy": {
+			value:   "dir=failed max-age=720h max-size=10GiB",
+			wantDir: "failed",
+			want:    RetentionPolicy{MaxAge: 720 * time.Hour, MaxSize: 10 << 30},
+		},
+		"Parses a policy of a nested directory": {
+			value:   "dir=completed/transfers/ max-size=500MB",
+			wantDir: "completed/transfers",
+			want:    RetentionPolicy{MaxSize: 500_000_000},
+		},
+		"Rejects missing directories": {
+			value:   "max-age=1h",
+			wantErr: "missing dir",
+		},
+		"Rejects directories outside the shared directory": {
+			value:   "dir=../failed max-age=1h",
+			wantErr: `invalid dir "../failed": not relative to the shared directory`,
+		},
+		"Rejects the shared directory": {
+			value:   "dir=failed/.. max-age=1h",
+			wantErr: `invalid dir ".": must be one of completed, currentlyProcessing, failed, rejected, tmp or a subdirectory`,
+		},
+		"Rejects directories not managed by retention": {
+			value:   "dir=watchedDirectories/activeTransfers max-age=1h",
+			wantErr: `invalid dir "watchedDirectories/activeTransfers": must be one of completed, currentlyProcessing, failed, rejected, tmp or a subdirectory`,
+		},
+		"Rejects policies without limits": {
+			value:   "dir=failed",
+			wantErr: "missing max-age or max-size",
+		},
+		"Rejects invalid sizes": {
+			value:   "dir=failed max-size=lots",
+			wantErr: `invalid max-size "lots"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir, policy, err := ParseRetentionPolicy(tc.value)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, dir, tc.wantDir)
+			assert.DeepEqual(t, policy, tc.want)
+		})
+	}
+}
@@ -5,15 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"path/filepath"
 	"strconv"
 	"sync"
-	"time"
+	"sync/atomic"
 
 	"connectrpc.com/authn"
-	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
@@ -23,11 +24,20 @@ import (
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
-const maxConcurrentPackages = 2
+// ErrNotQueued is returned when the package is not in the processing queue.
+var ErrNotQueued = errors.New("package is not queued")
+
+// ErrUnknownPackage is returned when the package is not queued, active or
+// awaiting a decision.
+var ErrUnknownPackage = errors.New("package is not known by the controller")
+
+// errCancelled is the cause given to the context of a cancelled package.
+var errCancelled = errors.New("package cancelled")
 
 // Controller manages concurrent processing of packages.
 //
-// There are three queues: queued, active and awaiting.
+// There are three queues: queued, active and awaiting. The scheduler decides
+// which of the queued packages become active.
 type Controller struct {
 	logger logr.Logger
 
@@ -37,12 +47,15 @@ type Controller struct {
 	// Application store.
 	store store.Store
 
-	// Embedded job server compatible with Gearman.
-	gearman *gearmin.Server
+	// executor dispatches the tasks to the workers.
+	executor Executor
 
 	// wf is the workflow document.
 	wf *workflow.Document
 
+	// config is the controller configuration.
+	config Config
+
 	// Archivematica shared directory.
 	sharedDir string
 
@@ -52,8 +65,8 @@ type Controller struct {
 	// activePackages is the list of active packages.
 	activePackages []*Package
 
-	// queuedPackages is the list of queued packages, FIFO style.
-	queuedPackages []*Package
+	// scheduler holds the queued packages sorted by priority.
+	scheduler *scheduler
 
 	// awaitingPackages is the list packages awaiting a decision indexed by the
 	// package identifier.
@@ -71,78 +84,134 @@ type Controller struct {
 	// groupCancel tells active goroutines in the errgroup to abandon.
 	groupCancel context.CancelFunc
 
+	// ingests copies the sources of the transfers submitted.
+	ingests *ingestPool
+
+	// events delivers package events to subscribers.
+	events *eventBus
+
+	// claims are the paths of the watched directories claimed by packages.
+	claims *claims
+
+	// freeSpace returns the free space of the filesystem of a path in bytes.
+	freeSpace func(path string) (int64, error)
+
+	// lastHeadroom is the headroom of the shared directory measured last,
+	// see headroom. It is math.MaxInt64 until it is measured.
+	lastHeadroom atomic.Int64
+
+	// draining is set once the controller starts draining, see Drain.
+	draining bool
+
+	// drainCh is closed when the controller starts draining.
+	drainCh chan struct{}
+
 	// closeOnce guarantees that the closing procedure runs only once.
 	closeOnce sync.Once
 }
 
-func New(logger logr.Logger, metrics *metrics.Metrics, store store.Store, gearman *gearmin.Server, wf *workflow.Document, sharedDir, watchedDir string) *Controller {
+func New(logger logr.Logger, metrics *metrics.Metrics, store store.Store, executor Executor, wf *workflow.Document, config Config, sharedDir, watchedDir string) *Controller {
+	if config.Runners {
+		executor = newRunnerExecutor(logger.WithName("runner"), store, executor, sharedDir)
+	}
+
 	c := &Controller{
 		logger:           logger,
 		metrics:          metrics,
 		store:            store,
-		gearman:          gearman,
+		executor:         executor,
 		wf:               wf,
+		config:           config,
 		sharedDir:        sharedDir,
 		watchedDir:       watchedDir,
 		activePackages:   []*Package{},
-		queuedPackages:   []*Package{},
+		scheduler:        newScheduler(config),
+		ingests:          newIngestPool(config),
 		awaitingPackages: map[uuid.UUID][]*decision{},
+		events:           newEventBus(),
+		claims:           newClaims(),
+		freeSpace:        diskFreeSpace,
+		drainCh:          make(chan struct{}),
 	}
 
+	c.lastHeadroom.Store(math.MaxInt64)
+
 	c.groupCtx, c.groupCancel = context.WithCancel(context.Background())
+	// The number of goroutines is not limited by the group, the scheduler
+	// decides when a package can be processed.
 	c.group, _ = errgroup.WithContext(c.groupCtx)
-	c.group.SetLimit(10)
 
 	return c
 }
 
-// Run tries to start processing queued transfers.
+// Run tries to start processing queued transfers. Queued packages are picked
+// again every time a package is queued or a processing slot becomes available.
+// The free space of the shared directory is measured periodically and the
+// retention policies are applied when configured.
 func (c *Controller) Run() error {
-	go func() {
-		ticker := time.NewTicker(time.Second / 4)
-		defer ticker.Stop()
+	if _, err := c.headroom(); err != nil {
+		c.logger.V(1).Info("Failed to measure free space.", "err", err)
+	}
+	c.pick()
 
-		for {
-			select {
-			case <-ticker.C:
-				c.pick()
-			case <-c.groupCtx.Done():
-				return
-			}
-		}
-	}()
+	c.group.Go(func() error {
+		c.monitorDiskSpace()
+		return nil
+	})
+
+	if c.config.RetentionInterval > 0 && len(c.config.Retention) > 0 {
+		c.group.Go(func() error {
+			c.runRetention()
+			return nil
+		})
+	}
 
 	return nil
 }
 
-// Submit a transfer request.
+// Submit a transfer request. It returns ErrDraining when the controller is
+// draining, ErrInvalidSource when a source of the transfer cannot be used and
+// ErrInsufficientSpace when the transfer would cross the low-water mark of the
+// shared directory.
 func (c *Controller) Submit(ctx context.Context, req *adminv1.CreatePackageRequest) (*Package, error) {
-	// TODO: have NewTransferPackage return a function we can schedule here.
-	var once sync.Once
-	queue := func(pkg *Package) {
-		once.Do(func() {
-			c.queue(pkg)
-			c.pick() // Start work right away, we don't want to wait for the next tick.
-		})
+	if c.isDraining() {
+		return nil, ErrDraining
+	}
+
+	// Sources are validated before the transfer is created.
+	sources, err := resolveSources(ctx, c.config.Locations, req.Path)
+	if err != nil {
+		return nil, err
 	}
 
-	pkg, err := NewTransferPackage( //nolint: contextcheck
-		// ctx is request-scoped, use the group context instead.
-		authn.SetInfo(c.groupCtx, authn.GetInfo(ctx)),
-		c.logger.WithName("package"),
-		c.store,
-		c.sharedDir,
-		req,
-		queue,
-	)
+	size, err := c.admitTransfer(ctx, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submit(ctx, req, sources, size)
+}
+
+// submit creates the transfer and starts its ingest. The size of the sources
+// is measured by the ingest unless it is given.
+func (c *Controller) submit(ctx context.Context, req *adminv1.CreatePackageRequest, sources []source, size *sourcesSize) (*Package, error) {
+	// ctx is request-scoped, use the group context instead.
+	ctx = authn.SetInfo(c.groupCtx, authn.GetInfo(ctx)) //nolint: contextcheck
+
+	pkg, err := NewTransferPackage(ctx, c.logger.WithName("package"), c.store, c.sharedDir, req)
 	if err != nil {
 		return nil, fmt.Errorf("create package: %v", err)
 	}
 
+	c.ingestTransfer(ctx, pkg, req, sources, size)
+
 	return pkg, nil
 }
 
-// Notify the controller of a new with a slice of filesystem events.
+// Notify the controller of a new with a slice of filesystem events. Paths
+// already claimed by a package are ignored until they are released, see
+// Release. The package is already in the shared directory, it is queued but
+// not picked while the shared directory is below its low-water mark.
 func (c *Controller) Notify(path string) (err error) {
 	defer func() {
 		if err != nil {
@@ -150,6 +219,17 @@ func (c *Controller) Notify(path string) (err error) {
 		}
 	}()
 
+	// A path reported more than once is ignored until it is released.
+	if !c.claims.claim(path) {
+		c.logger.V(2).Info("Ignoring path already claimed.", "path", path)
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			c.claims.release(path)
+		}
+	}()
+
 	rel, err := filepath.Rel(c.watchedDir, path)
 	if err != nil {
 		return err
@@ -174,60 +254,185 @@ func (c *Controller) Notify(path string) (err error) {
 	logger := c.logger.WithName("package").WithValues("wd", wd.Path, "path", path)
 	if pkg, err := NewPackage(c.groupCtx, logger, c.store, c.sharedDir, path, wd); err != nil {
 		return err
+	} else if c.isDraining() {
+		// The package is not queued but its initial state is persisted so it
+		// is resumed after a restart.
+		logger.Info("Package not queued while draining, it is resumed after a restart.", "id", pkg.id)
+		if err := pkg.saveIteratorState(c.groupCtx, newIteratorState(pkg, nil, uuid.Nil)); err != nil {
+			return fmt.Errorf("persist iterator state: %v", err)
+		}
+	} else if err := c.queue(pkg, 0); err != nil {
+		return err
 	} else {
-		c.queue(pkg)
-		c.pick() // Start work right away, we don't want to wait for the next tick.
+		c.pick()
 	}
 
 	return nil
 }
 
-func (c *Controller) queue(pkg *Package) {
+// queue persists the initial state of a new package and adds it to the
+// processing queue. It returns errKnownPackage when the package is already
+// queued, active or awaiting a decision, its persisted state is not modified.
+func (c *Controller) queue(pkg *Package, priority int32) error {
+	if c.known(pkg.id) {
+		return errKnownPackage
+	}
+
+	pkg.priority = priority
+	if err := pkg.saveIteratorState(c.groupCtx, newIteratorState(pkg, nil, uuid.Nil)); err != nil {
+		c.logger.Error(err, "Failed to persist the iterator state.", "id", pkg.id)
+	}
+
+	return c.enqueue(pkg, priority)
+}
+
+// enqueue adds a package to the processing queue. It returns errKnownPackage
+// when the package is already queued, active or awaiting a decision, which is
+// checked under the same lock so a package cannot be queued twice.
+func (c *Controller) enqueue(pkg *Package, priority int32) error {
 	c.mu.Lock()
-	c.queuedPackages = append(c.queuedPackages, pkg)
+	if c.knownLocked(pkg.id) {
+		c.mu.Unlock()
+		return errKnownPackage
+	}
+	c.scheduler.push(pkg, priority)
 	c.metrics.PackageQueueLengthGauge.WithLabelValues(pkg.packageType().String()).Inc()
 	c.mu.Unlock()
+
+	c.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_QUEUED, pkg))
+
+	return nil
 }
 
+// known reports whether the package is queued, active or awaiting a decision.
+func (c *Controller) known(id uuid.UUID) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.knownLocked(id)
+}
+
+// knownLocked is like known but it must be called with the lock held.
+func (c *Controller) knownLocked(id uuid.UUID) bool {
+	if c.scheduler.index(id) != -1 {
+		return true
+	}
+	for _, item := range c.activePackages {
+		if item.id == id {
+			return true
+		}
+	}
+	if _, ok := c.awaitingPackages[id]; ok {
+		return true
+	}
+
+	return false
+}
+
+// pick activates as many queued packages as the scheduler allows. Packages
+// are not picked while draining or while the shared directory is below its
+// low-water mark, see Config.MinFreeSpace.
 func (c *Controller) pick() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if len(c.activePackages) == maxConcurrentPackages {
-		c.logger.V(2).Info("Not accepting new packages at this time.", "active", len(c.activePackages), "max", maxConcurrentPackages)
+	if c.draining {
 		return
 	}
 
-	var pkg *Package
-	if len(c.queuedPackages) > 0 {
-		pkg = c.queuedPackages[0]
+	// Queued packages are held while the shared directory is low on space.
+	if queued := c.scheduler.len(); queued > 0 && c.lowOnSpace() {
+		c.logger.V(1).Info("Holding queued packages, the shared directory is below its low-water mark.", "headroom", formatSize(c.lastHeadroom.Load()), "queued", queued)
+		return
+	}
+
+	for {
+		pkg := c.scheduler.next(c.activePackages)
+		if pkg == nil {
+			if queued := c.scheduler.len(); queued > 0 {
+				c.logger.V(2).Info("Not accepting new packages at this time.", "active", len(c.activePackages), "queued", queued)
+			}
+			return
+		}
+
 		c.activePackages = append(c.activePackages, pkg)
-		c.queuedPackages = c.queuedPackages[1:]
 		c.metrics.ActivePackageGauge.Inc()
-	}
+		c.metrics.PackageQueueLengthGauge.WithLabelValues(pkg.packageType().String()).Dec()
+		c.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_ACTIVATED, pkg))
 
-	if pkg == nil {
-		return
+		// Every activation of the package is traced from the root, the jobs
+		// and batches of tasks are recorded as descendants.
+		ctx, _ := tracer.Start(c.groupCtx, "package", trace.WithNewRoot(), trace.WithAttributes(packageAttrs(pkg)...))
+		c.process(ctx, pkg)
 	}
+}
 
-	c.group.Go(func() error {
+// process runs the workflow of an active package in a new goroutine. It must
+// be called with the lock held. The span of the package in ctx is ended once
+// the processing ends.
+func (c *Controller) process(ctx context.Context, pkg *Package) {
+	span := trace.SpanFromContext(ctx)
+	ctx, cancel := context.WithCancelCause(ctx)
+	pkg.cancel = cancel
+	pkg.done = make(chan struct{})
+
+	c.group.Go(func() (err error) {
 		logger := c.logger.V(2).WithValues("package", pkg)
 		logger.Info("Processing started.")
-		defer c.deactivate(pkg)
+		defer func() {
+			if err != nil && errors.Is(context.Cause(ctx), errCancelled) {
+				logger.Info("Processing cancelled.")
+				span.AddEvent("cancelled")
+				pkg.cancelErr = c.abandon(c.groupCtx, pkg, pkg.rejectOnCancel)
+				err = nil
+			} else if errors.Is(err, errDrained) || (err != nil && errors.Is(context.Cause(ctx), errDrained)) {
+				// The state is kept so processing is resumed after a restart.
+				logger.Info("Processing suspended by drain.")
+				span.AddEvent("drained")
+				err = nil
+			} else if err != nil && ctx.Err() == nil {
+				// Every failure marks the package as failed, e.g. a job that
+				// cannot be built, so it is not resumed after a restart. It is
+				// not marked when the controller is closing.
+				if err := pkg.markAsFailed(c.groupCtx); err != nil {
+					logger.Error(err, "Failed to mark the package as failed.")
+				}
+				c.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_FAILED, pkg))
+			}
+			endSpan(span, err)
+			cancel(nil)
+			c.deactivate(pkg)
+			close(pkg.done)
+			c.pick() // The package left a processing slot available.
+		}()
+
+		iter := newJobIterator(ctx, logger, c.metrics, c.events, c.executor, c.wf, c.config, pkg)
+		if pkg.resumeState != nil {
+			iter.restore(pkg.resumeState)
+			pkg.resumeState = nil
+		}
 
-		iter := newJobIterator(c.groupCtx, logger, c.metrics, c.gearman, c.wf, pkg)
 		for {
 			err := iter.next() // Runs the next job.
 
-			if errors.Is(err, errEnd) || errors.Is(err, io.EOF) {
+			if err == nil && c.isDraining() {
+				return errDrained // Stop at the link boundary.
+			} else if errors.Is(err, errEnd) || errors.Is(err, io.EOF) {
+				iter.clearState()
 				return nil
 			} else if ew, ok := isErrWait(err); ok {
+				span.AddEvent("awaiting decision")
 				if err := c.await(iter, pkg, ew.decision); err != nil {
 					return err
 				} else {
 					continue
 				}
 			} else if err != nil {
+				if errors.Is(context.Cause(ctx), errCancelled) {
+					return err
+				}
+				// The state is kept so processing can be retried from the
+				// link that failed.
 				logger.Error(err, "Processing failed.")
 				return err
 			}
@@ -244,7 +449,6 @@ func (c *Controller) deactivate(pkg *Package) {
 		if item.id == pkg.id {
 			c.activePackages = append(c.activePackages[:i], c.activePackages[i+1:]...)
 			c.metrics.ActivePackageGauge.Dec()
-			c.metrics.PackageQueueLengthGauge.WithLabelValues(pkg.packageType().String()).Dec()
 			break
 		}
 	}
@@ -252,25 +456,40 @@ func (c *Controller) deactivate(pkg *Package) {
 
 // await blocks until the awaiting package is resolved.
 func (c *Controller) await(iter *jobIterator, pkg *Package, dec *decision) error {
-	_ = c.queueToAwait(pkg, dec)
+	if err := c.queueToAwait(pkg, dec); errors.Is(err, errDrained) {
+		return err
+	}
 	defer c.dequeueFromAwait(pkg, dec)
 
-	next, err := dec.await(c.groupCtx)
+	c.events.publish(newDecisionEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_DECISION_AWAITING, dec))
+
+	// The package is no longer active while it awaits.
+	c.pick()
+
+	next, err := dec.await(iter.ctx)
 	c.logger.Info("Resolution of awaiting package completed.", "next", next, "err", err)
 	if err != nil {
 		return err
 	}
 
+	c.events.publish(newDecisionEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_DECISION_RESOLVED, dec))
+
 	iter.nextLink = next
+	iter.saveState()
 
 	return nil
 }
 
-// queueToAwait moves an active package to the awaiting list.
+// queueToAwait moves an active package to the awaiting list. It returns
+// errDrained when the controller is draining, the package is suspended instead.
 func (c *Controller) queueToAwait(pkg *Package, dec *decision) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.draining {
+		return errDrained
+	}
+
 	// Confirm that the package is in the active queue.
 	var pos *int
 	for i, active := range c.activePackages {
@@ -333,6 +552,107 @@ func (c *Controller) dequeueFromAwait(pkg *Package, dec *decision) {
 	c.activePackages = append(c.activePackages, pkg)
 }
 
+// QueuedPackages lists the packages waiting to be processed in the order they
+// are going to be picked.
+func (c *Controller) QueuedPackages() []*adminv1.QueuedPackage {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.scheduler.list()
+}
+
+// PromotePackage changes the position of a queued package. The priority of the
+// package is updated when given, otherwise the package is moved to the front of
+// the queue. It returns the new position of the package. The change is not
+// persisted, packages resumed after a restart keep their original priority.
+func (c *Controller) PromotePackage(id uuid.UUID, priority *int32) (_ int, err error) {
+	defer derrors.Wrap(&err, "PromotePackage(%s)", id)
+
+	c.mu.Lock()
+	var pos int
+	if priority != nil {
+		pos = c.scheduler.reprioritize(id, *priority)
+	} else {
+		pos = c.scheduler.promote(id)
+	}
+	c.mu.Unlock()
+
+	if pos == -1 {
+		return -1, ErrNotQueued
+	}
+
+	c.pick()
+
+	return pos, nil
+}
+
+// CancelPackage stops the processing of a package and marks it as failed. The
+// package is moved to the rejected directory when reject is true.
+//
+// Queued packages are removed from the queue right away. Active and awaiting
+// packages have their context cancelled, CancelPackage waits until the
+// processing goroutine abandons the package. Gearman batches that are in
+// flight are not recalled, their results are discarded.
+func (c *Controller) CancelPackage(ctx context.Context, id uuid.UUID, reject bool) (err error) {
+	defer derrors.Wrap(&err, "CancelPackage(%s)", id)
+
+	c.mu.Lock()
+
+	if item, ok := c.scheduler.remove(id); ok {
+		c.metrics.PackageQueueLengthGauge.WithLabelValues(item.pkg.packageType().String()).Dec()
+		c.mu.Unlock()
+		return c.abandon(ctx, item.pkg, reject)
+	}
+
+	var pkg *Package
+	for _, item := range c.activePackages {
+		if item.id == id {
+			pkg = item
+			break
+		}
+	}
+	if decisions, ok := c.awaitingPackages[id]; ok && pkg == nil && len(decisions) > 0 {
+		pkg = decisions[0].pkg
+	}
+	if pkg == nil || pkg.cancel == nil {
+		c.mu.Unlock()
+		return ErrUnknownPackage
+	}
+
+	pkg.rejectOnCancel = reject
+	pkg.cancel(errCancelled)
+	done := pkg.done
+
+	c.mu.Unlock()
+
+	select {
+	case <-done:
+		return pkg.cancelErr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// abandon marks a cancelled package as failed so it is not resumed.
+func (c *Controller) abandon(ctx context.Context, pkg *Package, reject bool) error {
+	if err := pkg.markAsFailed(ctx); err != nil {
+		return fmt.Errorf("mark as failed: %v", err)
+	}
+	if err := pkg.clearIteratorState(ctx); err != nil {
+		return err
+	}
+	if reject {
+		if err := pkg.moveToRejected(ctx); err != nil {
+			return err
+		}
+	}
+
+	c.logger.Info("Package cancelled.", "id", pkg.id, "rejected", reject)
+	c.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_FAILED, pkg))
+
+	return nil
+}
+
 func (c *Controller) Active(id uuid.UUID) bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -460,6 +780,7 @@ func (c *Controller) Close() error {
 		if waitErr := c.group.Wait(); errors.Is(waitErr, context.Canceled) {
 			err = waitErr
 		}
+		c.events.close()
 	})
 
 	return err
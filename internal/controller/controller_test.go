@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerCancelPackage(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	t.Run("Cancels and rejects a queued package", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "ccp",
+			fs.WithDir("sharedDir",
+				fs.WithDir("rejected"),
+				fs.WithDir("currentlyProcessing/Images-e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4"),
+			),
+		)
+		sharedDir := tmpDir.Join("sharedDir")
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActivePackages: 1}, sharedDir, "")
+
+		pkg := newPackage(logr.Discard(), s, sharedDir)
+		pkg.id = uuid.MustParse("e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4")
+		pkg.unit = &Transfer{pkg: pkg}
+		pkg.UpdatePath("%sharedPath%currentlyProcessing/Images-e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4/")
+		assert.NilError(t, c.enqueue(pkg, 0))
+
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil)
+		s.EXPECT().DeleteUnitVar(gomock.Any(), pkg.id, enums.PackageTypeTransfer, iteratorStateVar).Return(nil)
+		s.EXPECT().UpdateTransferLocation(gomock.Any(), pkg.id, "%sharedPath%rejected/Images-e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4/").Return(nil)
+
+		err := c.CancelPackage(context.Background(), pkg.id, true)
+		assert.NilError(t, err)
+		assert.Equal(t, c.scheduler.len(), 0)
+		assert.Equal(t, pkg.Path(), tmpDir.Join("sharedDir/rejected/Images-e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4")+"/")
+		_, err = os.Stat(pkg.Path())
+		assert.NilError(t, err)
+	})
+
+	t.Run("Cancels and rejects a queued SIP", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "ccp",
+			fs.WithDir("sharedDir",
+				fs.WithDir("rejected"),
+				fs.WithDir("watchedDirectories/workFlowDecisions/selectFormatIDToolIngest/Images-3f1cd3a6-1f53-4dd5-9b6e-0b5c3d33e7a9"),
+			),
+		)
+		sharedDir := tmpDir.Join("sharedDir")
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActivePackages: 1}, sharedDir, "")
+
+		pkg := newPackage(logr.Discard(), s, sharedDir)
+		pkg.id = uuid.MustParse("3f1cd3a6-1f53-4dd5-9b6e-0b5c3d33e7a9")
+		pkg.unit = &SIP{pkg: pkg}
+		pkg.UpdatePath("%sharedPath%watchedDirectories/workFlowDecisions/selectFormatIDToolIngest/Images-3f1cd3a6-1f53-4dd5-9b6e-0b5c3d33e7a9/")
+		assert.NilError(t, c.enqueue(pkg, 0))
+
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeSIP, enums.PackageStatusFailed).Return(nil)
+		s.EXPECT().DeleteUnitVar(gomock.Any(), pkg.id, enums.PackageTypeSIP, iteratorStateVar).Return(nil)
+		s.EXPECT().UpsertSIP(gomock.Any(), pkg.id, "%sharedPath%rejected/Images-3f1cd3a6-1f53-4dd5-9b6e-0b5c3d33e7a9/").Return(false, nil)
+
+		err := c.CancelPackage(context.Background(), pkg.id, true)
+		assert.NilError(t, err)
+		assert.Equal(t, c.scheduler.len(), 0)
+		assert.Equal(t, pkg.Path(), tmpDir.Join("sharedDir/rejected/Images-3f1cd3a6-1f53-4dd5-9b6e-0b5c3d33e7a9")+"/")
+		_, err = os.Stat(pkg.Path())
+		assert.NilError(t, err)
+	})
+
+	t.Run("Rejects unknown packages", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{}, t.TempDir(), "")
+
+		err := c.CancelPackage(context.Background(), uuid.New(), false)
+		assert.ErrorIs(t, err, ErrUnknownPackage)
+	})
+}
+
+func TestControllerProcess(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	t.Run("Marks the package as failed when the chain cannot be started", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActivePackages: 1}, t.TempDir(), "")
+
+		pkg := newPackage(logr.Discard(), s, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+		pkg.startAtChainID = uuid.New() // Not in the workflow.
+
+		gomock.InOrder(
+			s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusProcessing).Return(nil),
+			s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil),
+		)
+
+		assert.NilError(t, c.enqueue(pkg, 0))
+		c.pick()
+		<-pkg.done
+
+		assert.Equal(t, len(c.ActivePackages()), 0)
+	})
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+// ErrInsufficientSpace is returned when the shared directory does not have
+// enough free space to accept a new package, see Config.MinFreeSpace.
+var ErrInsufficientSpace = errors.New("insufficient free space in the shared directory")
+
+// diskSpaceInterval is the time between the measurements of the free space of
+// the shared directory.
+const diskSpaceInterval = 30 * time.Second
+
+// headroom returns the free space of the shared directory above the low-water
+// mark, it is negative when the mark is crossed. It updates the headroom
+// gauge and the headroom used by lowOnSpace, which is unknown when the free
+// space cannot be measured.
+func (c *Controller) headroom() (int64, error) {
+	free, err := c.freeSpace(c.sharedDir)
+	if err != nil {
+		c.lastHeadroom.Store(math.MaxInt64)
+		return 0, err
+	}
+
+	headroom := free - c.config.MinFreeSpace
+	c.metrics.SharedDirectoryHeadroomGauge.Set(float64(headroom))
+	c.lastHeadroom.Store(headroom)
+
+	return headroom, nil
+}
+
+// lowOnSpace reports whether the shared directory was below its low-water
+// mark when the free space was measured last, see monitorDiskSpace. It does
+// not measure the free space so it can be called with the lock held.
+func (c *Controller) lowOnSpace() bool {
+	return c.config.MinFreeSpace > 0 && c.lastHeadroom.Load() < 0
+}
+
+// admit returns ErrInsufficientSpace when a package of the given size would
+// cross the low-water mark. Packages are admitted when the free space cannot
+// be measured.
+func (c *Controller) admit(size int64) error {
+	if c.config.MinFreeSpace <= 0 {
+		return nil
+	}
+
+	headroom, err := c.headroom()
+	if err != nil {
+		c.logger.V(1).Info("Failed to measure free space.", "err", err)
+		return nil
+	}
+	if headroom < size {
+		free := headroom + c.config.MinFreeSpace
+		return fmt.Errorf("%w: %s free, %s required above the low-water mark of %s", ErrInsufficientSpace, formatSize(free), formatSize(size), formatSize(c.config.MinFreeSpace))
+	}
+
+	return nil
+}
+
+// admitTransfer is like admit but it estimates the size of the transfer from
+// its sources when Config.EstimateTransferSize is enabled. It returns the size
+// measured, if any, so the sources are not measured again by the ingest. The
+// transfer is admitted with no size when the sources cannot be measured.
+func (c *Controller) admitTransfer(ctx context.Context, sources []source) (*sourcesSize, error) {
+	if c.config.MinFreeSpace <= 0 {
+		return nil, nil
+	}
+
+	var size *sourcesSize
+	if c.config.EstimateTransferSize {
+		var err error
+		if size, err = measureSources(ctx, sources); err != nil {
+			c.logger.V(1).Info("Failed to measure transfer sources.", "err", err)
+			size = nil
+		}
+	}
+
+	var bytes int64
+	if size != nil {
+		bytes = size.bytes
+	}
+	if err := c.admit(bytes); err != nil {
+		return nil, err
+	}
+
+	return size, nil
+}
+
+// monitorDiskSpace measures the free space of the shared directory
+// periodically until the controller is closed or starts draining. The queued
+// packages held by the low-water mark are picked once the space is recovered.
+func (c *Controller) monitorDiskSpace() {
+	ticker := time.NewTicker(diskSpaceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-c.drainCh:
+			return
+		case <-c.groupCtx.Done():
+			return
+		}
+
+		if _, err := c.headroom(); err != nil {
+			c.logger.V(1).Info("Failed to measure free space.", "err", err)
+			continue
+		}
+		c.pick()
+	}
+}
+
+// formatSize formats a size in bytes with IEC units, e.g. "10 GiB".
+func formatSize(n int64) string {
+	if n < 0 {
+		return "-" + humanize.IBytes(uint64(-n))
+	}
+
+	return humanize.IBytes(uint64(n))
+}
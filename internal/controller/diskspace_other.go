@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package controller
+
+import "errors"
+
+// diskFreeSpace is not supported on this platform, the low-water mark is not
+// enforced.
+func diskFreeSpace(path string) (int64, error) {
+	return 0, errors.ErrUnsupported
+}
@@ -0,0 +1,16 @@
+//go:build linux || darwin
+
+package controller
+
+import "syscall"
+
+// diskFreeSpace returns the space available to unprivileged users in the
+// filesystem of the given path in bytes.
+func diskFreeSpace(path string) (int64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+
+	return int64(st.Bavail) * int64(st.Bsize), nil //nolint:gosec // (G115) no risk of overflow
+}
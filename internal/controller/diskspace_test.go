@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerDiskSpace(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	newController := func(t *testing.T, config Config) (*Controller, *atomic.Int64) {
+		t.Helper()
+
+		sharedDir := fs.NewDir(t, "ccp")
+		c := New(logr.Discard(), metrics.NewMetrics(nil), nil, nil, wf, config, sharedDir.Path(), sharedDir.Join("watchedDirectories"))
+
+		free := &atomic.Int64{}
+		c.freeSpace = func(string) (int64, error) {
+			return free.Load(), nil
+		}
+
+		return c, free
+	}
+
+	t.Run("Refuses transfers below the low-water mark", func(t *testing.T) {
+		t.Parallel()
+
+		c, free := newController(t, Config{MinFreeSpace: 100})
+		free.Store(50)
+
+		_, err := c.Submit(context.Background(), &adminv1.CreatePackageRequest{Name: "Images", Path: []string{"/tmp"}})
+		assert.ErrorIs(t, err, ErrInsufficientSpace)
+		assert.Error(t, err, "insufficient free space in the shared directory: 50 B free, 0 B required above the low-water mark of 100 B")
+		assert.Equal(t, testutil.ToFloat64(c.metrics.SharedDirectoryHeadroomGauge), float64(-50))
+	})
+
+	t.Run("Refuses transfers larger than the headroom", func(t *testing.T) {
+		t.Parallel()
+
+		src := fs.NewDir(t, "ccp", fs.WithFile("image.jpg", "12345678901234567890"))
+		sources, err := resolveSources(context.Background(), nil, []string{src.Path()})
+		assert.NilError(t, err)
+
+		c, free := newController(t, Config{MinFreeSpace: 100, EstimateTransferSize: true})
+		free.Store(110)
+		_, err = c.admitTransfer(context.Background(), sources)
+		assert.ErrorIs(t, err, ErrInsufficientSpace)
+
+		free.Store(120)
+		size, err := c.admitTransfer(context.Background(), sources)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, size, &sourcesSize{bytes: 20, files: 1}, cmp.AllowUnexported(sourcesSize{}))
+		assert.Equal(t, testutil.ToFloat64(c.metrics.SharedDirectoryHeadroomGauge), float64(20))
+	})
+
+	t.Run("Admits transfers whose sources cannot be measured", func(t *testing.T) {
+		t.Parallel()
+
+		c, free := newController(t, Config{MinFreeSpace: 100, EstimateTransferSize: true})
+		free.Store(110)
+
+		size, err := c.admitTransfer(context.Background(), localSources("/non-existent"))
+		assert.NilError(t, err)
+		assert.Assert(t, size == nil)
+	})
+
+	t.Run("Holds queued packages below the low-water mark", func(t *testing.T) {
+		t.Parallel()
+
+		c, free := newController(t, Config{MinFreeSpace: 100})
+		free.Store(50)
+		_, err := c.headroom()
+		assert.NilError(t, err)
+
+		pkg := newPackage(logr.Discard(), nil, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+		assert.NilError(t, c.enqueue(pkg, 0))
+
+		// The free space measured last is used, it is not measured again.
+		c.freeSpace = func(string) (int64, error) {
+			t.Error("Free space measured while picking packages.")
+			return 0, nil
+		}
+		c.pick()
+		assert.Equal(t, len(c.ActivePackages()), 0)
+		assert.Equal(t, c.scheduler.len(), 1)
+	})
+
+	t.Run("Admits everything without a low-water mark", func(t *testing.T) {
+		t.Parallel()
+
+		c, free := newController(t, Config{})
+		free.Store(0)
+
+		assert.NilError(t, c.admit(1<<30))
+	})
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrDraining is returned when new packages are submitted while the controller
+// is draining.
+var ErrDraining = errors.New("controller is draining")
+
+// errDrained is the cause given to the context of the packages suspended by
+// the drain, their processing is resumed after a restart.
+var errDrained = errors.New("package suspended by drain")
+
+// Drain stops the processing of packages gracefully, e.g. before a deploy.
+// Queued packages are no longer picked, active packages stop at their next
+// link boundary or decision point and packages awaiting a decision are
+// suspended right away. Their state is persisted so they are resumed once the
+// application is started again. Use WaitDrained to wait until the processing
+// stops.
+//
+// Draining cannot be undone, it is safe to call Drain more than once.
+func (c *Controller) Drain() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.draining {
+		return
+	}
+	c.draining = true
+	close(c.drainCh)
+
+	c.logger.Info("Draining.", "active", len(c.activePackages), "awaiting", len(c.awaitingPackages), "queued", c.scheduler.len())
+
+	// Packages awaiting a decision are already at a boundary.
+	for _, decisions := range c.awaitingPackages {
+		if len(decisions) > 0 && decisions[0].pkg.cancel != nil {
+			decisions[0].pkg.cancel(errDrained)
+		}
+	}
+}
+
+// Draining returns a channel that is closed once the controller starts
+// draining.
+func (c *Controller) Draining() <-chan struct{} {
+	return c.drainCh
+}
+
+// isDraining reports whether the controller is draining.
+func (c *Controller) isDraining() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.draining
+}
+
+// WaitDrained blocks until the processing of all packages stops after Drain is
+// called or until the context is done, e.g. when the drain timeout expires.
+// Close abandons the jobs that are still running, the packages are resumed
+// from their last completed link.
+func (c *Controller) WaitDrained(ctx context.Context) error {
+	select {
+	case <-c.drainCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = c.group.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.logger.Info("Drained.")
+		return nil
+	case <-ctx.Done():
+		c.logger.Info("Drain timed out.", "active", len(c.ActivePackages()))
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerDrain(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	newController := func(t *testing.T) *Controller {
+		t.Helper()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		return New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActivePackages: 1}, t.TempDir(), "")
+	}
+
+	newTransfer := func(c *Controller) *Package {
+		pkg := newPackage(logr.Discard(), c.store, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+		return pkg
+	}
+
+	t.Run("Stops picking queued packages", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t)
+		assert.NilError(t, c.enqueue(newTransfer(c), 0))
+
+		c.Drain()
+		c.Drain() // Draining twice is not an error.
+		assert.NilError(t, c.Run())
+		assert.Equal(t, len(c.ActivePackages()), 0)
+		assert.Equal(t, c.scheduler.len(), 1)
+
+		select {
+		case <-c.Draining():
+		default:
+			t.Fatal("Draining channel is not closed")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.NilError(t, c.WaitDrained(ctx))
+	})
+
+	t.Run("Rejects new packages", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t)
+		c.Drain()
+
+		_, err := c.Submit(context.Background(), &adminv1.CreatePackageRequest{Name: "Images"})
+		assert.ErrorIs(t, err, ErrDraining)
+
+		_, err = c.RetryPackage(context.Background(), uuid.New(), uuid.Nil, 0)
+		assert.ErrorIs(t, err, ErrDraining)
+	})
+
+	t.Run("Suspends packages awaiting a decision", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t)
+		pkg := newTransfer(c)
+		ctx, cancel := context.WithCancelCause(context.Background())
+		pkg.cancel = cancel
+		c.awaitingPackages[pkg.id] = []*decision{{id: uuid.New(), pkg: pkg}}
+		c.group.Go(func() error {
+			<-ctx.Done()
+			return nil
+		})
+
+		c.Drain()
+
+		waitCtx, waitCancel := context.WithTimeout(context.Background(), time.Second)
+		defer waitCancel()
+		assert.NilError(t, c.WaitDrained(waitCtx))
+		assert.ErrorIs(t, context.Cause(ctx), errDrained)
+		assert.ErrorIs(t, c.queueToAwait(pkg, nil), errDrained)
+	})
+
+	t.Run("Gives up when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		c := newController(t)
+		release := make(chan struct{})
+		c.group.Go(func() error {
+			<-release
+			return nil
+		})
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, c.WaitDrained(ctx), context.DeadlineExceeded) // Not draining.
+
+		c.Drain()
+
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, c.WaitDrained(ctx), context.DeadlineExceeded)
+	})
+}
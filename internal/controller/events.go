@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+)
+
+// subscriptionBufferSize is the number of events that a subscriber can fall
+// behind before it is dropped.
+const subscriptionBufferSize = 256
+
+// ErrSlowSubscriber is the reason given to a subscription that was dropped
+// because it was not able to keep up with the events published.
+var ErrSlowSubscriber = errors.New("subscriber is not keeping up with events")
+
+// Subscription receives the package events published by the controller.
+type Subscription struct {
+	// pkgID filters the events by package, all events are received when nil.
+	pkgID uuid.UUID
+
+	// overflow receives the events that do not fit in the buffer, the
+	// subscription is dropped when nil.
+	overflow func(*adminv1.PackageEvent)
+
+	ch  chan *adminv1.PackageEvent
+	err error
+}
+
+// Events returns the channel where the events are delivered. The channel is
+// closed when the subscription ends, see Err.
+func (s *Subscription) Events() <-chan *adminv1.PackageEvent {
+	return s.ch
+}
+
+// Err reports why the events channel was closed. It returns nil when the
+// subscription ended because the controller was closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// eventBus delivers package events to subscribers. Publishing never blocks,
+// subscribers that fall behind are dropped.
+type eventBus struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		subs: map[*Subscription]struct{}{},
+	}
+}
+
+func (b *eventBus) subscribe(pkgID uuid.UUID, overflow func(*adminv1.PackageEvent)) *Subscription {
+	sub := &Subscription{
+		pkgID:    pkgID,
+		overflow: overflow,
+		ch:       make(chan *adminv1.PackageEvent, subscriptionBufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(sub.ch)
+	} else {
+		b.subs[sub] = struct{}{}
+	}
+
+	return sub
+}
+
+func (b *eventBus) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+func (b *eventBus) publish(event *adminv1.PackageEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		if sub.pkgID != uuid.Nil && sub.pkgID.String() != event.PackageId {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			if sub.overflow != nil {
+				sub.overflow(event)
+				continue
+			}
+			sub.err = ErrSlowSubscriber
+			delete(b.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// close ends all the subscriptions.
+func (b *eventBus) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+	b.closed = true
+}
+
+// newPackageEvent returns an event of the given type for a package.
+func newPackageEvent(kind adminv1.PackageEventType, pkg *Package) *adminv1.PackageEvent {
+	return &adminv1.PackageEvent{
+		Type:        kind,
+		PackageId:   pkg.id.String(),
+		PackageType: packageTypeProto(pkg.packageType()),
+		PackageName: pkg.Name(),
+		CreatedAt:   timestamppb.New(time.Now()),
+	}
+}
+
+// newJobEvent returns an event of the given type for a job.
+func newJobEvent(kind adminv1.PackageEventType, j *job, status adminv1.JobStatus) *adminv1.PackageEvent {
+	event := newPackageEvent(kind, j.pkg)
+	event.JobId = j.id.String()
+	event.LinkId = j.wl.ID.String()
+	event.JobStatus = status
+
+	return event
+}
+
+// newDecisionEvent returns an event of the given type for a decision.
+func newDecisionEvent(kind adminv1.PackageEventType, dec *decision) *adminv1.PackageEvent {
+	event := newPackageEvent(kind, dec.pkg)
+	event.JobId = dec.jobID.String()
+	event.DecisionId = dec.id.String()
+
+	return event
+}
+
+// Subscribe returns a subscription to the package events published by the
+// controller. Events of all packages are received when pkgID is nil. The
+// subscription must be cancelled with Unsubscribe.
+func (c *Controller) Subscribe(pkgID uuid.UUID) *Subscription {
+	return c.events.subscribe(pkgID, nil)
+}
+
+// SubscribeWithOverflow is like Subscribe but the subscription is not dropped
+// when it falls behind, the events that do not fit in its buffer are passed to
+// overflow instead. overflow is called while the event is published, it must
+// not block.
+func (c *Controller) SubscribeWithOverflow(pkgID uuid.UUID, overflow func(*adminv1.PackageEvent)) *Subscription {
+	return c.events.subscribe(pkgID, overflow)
+}
+
+// Unsubscribe cancels a subscription.
+func (c *Controller) Unsubscribe(sub *Subscription) {
+	c.events.unsubscribe(sub)
+}
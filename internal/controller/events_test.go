@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+func TestEventBus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Delivers events to subscribers", func(t *testing.T) {
+		t.Parallel()
+
+		b := newEventBus()
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeSIP)
+
+		all := b.subscribe(uuid.Nil, nil)
+		one := b.subscribe(p2.id, nil)
+
+		b.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_QUEUED, p1))
+		b.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_ACTIVATED, p2))
+
+		event := <-all.Events()
+		assert.Equal(t, event.Type, adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_QUEUED)
+		assert.Equal(t, event.PackageId, p1.id.String())
+		assert.Equal(t, event.PackageType, adminv1.PackageType_PACKAGE_TYPE_TRANSFER)
+		event = <-all.Events()
+		assert.Equal(t, event.Type, adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_ACTIVATED)
+
+		event = <-one.Events()
+		assert.Equal(t, event.PackageId, p2.id.String())
+		assert.Equal(t, len(one.Events()), 0)
+
+		b.unsubscribe(one)
+		_, ok := <-one.Events()
+		assert.Equal(t, ok, false)
+		assert.NilError(t, one.Err())
+	})
+
+	t.Run("Drops slow subscribers", func(t *testing.T) {
+		t.Parallel()
+
+		b := newEventBus()
+		pkg := testPackage(t, enums.PackageTypeTransfer)
+		sub := b.subscribe(uuid.Nil, nil)
+
+		for range subscriptionBufferSize + 1 {
+			b.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_JOB_STARTED, pkg))
+		}
+
+		var received int
+		for range sub.Events() {
+			received++
+		}
+		assert.Equal(t, received, subscriptionBufferSize)
+		assert.ErrorIs(t, sub.Err(), ErrSlowSubscriber)
+
+		// Unsubscribing after being dropped is harmless.
+		b.unsubscribe(sub)
+	})
+
+	t.Run("Passes the overflow to the subscriber", func(t *testing.T) {
+		t.Parallel()
+
+		b := newEventBus()
+		pkg := testPackage(t, enums.PackageTypeTransfer)
+		var overflow []*adminv1.PackageEvent
+		sub := b.subscribe(uuid.Nil, func(event *adminv1.PackageEvent) {
+			overflow = append(overflow, event)
+		})
+
+		for range subscriptionBufferSize + 2 {
+			b.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_JOB_STARTED, pkg))
+		}
+		assert.Equal(t, len(sub.Events()), subscriptionBufferSize)
+		assert.Equal(t, len(overflow), 2)
+
+		b.unsubscribe(sub)
+		assert.NilError(t, sub.Err())
+	})
+
+	t.Run("Ends subscriptions when closed", func(t *testing.T) {
+		t.Parallel()
+
+		b := newEventBus()
+		sub := b.subscribe(uuid.Nil, nil)
+		b.close()
+
+		_, ok := <-sub.Events()
+		assert.Equal(t, ok, false)
+		assert.NilError(t, sub.Err())
+
+		late := b.subscribe(uuid.Nil, nil)
+		_, ok = <-late.Events()
+		assert.Equal(t, ok, false)
+	})
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Executor runs the batches of tasks of client scripts on behalf of the task
+// backend, e.g. by submitting them to MCPClient via Gearman.
+type Executor interface {
+	// Execute submits a batch for processing. The channel returned receives
+	// the outcome of the batch once it is processed. The batch is withdrawn
+	// when ctx is cancelled if the executor supports it, the channel may never
+	// receive a value then.
+	Execute(ctx context.Context, batch *Batch) <-chan *BatchResult
+
+	// CanExecute reports whether any of the workers known by the executor has
+	// registered the function.
+	CanExecute(funcName string) bool
+}
+
+// Batch is a group of tasks of the same client script processed by a worker.
+type Batch struct {
+	// ID of the batch, every attempt to process the tasks is a new batch.
+	ID uuid.UUID
+
+	// FuncName is the name of the function that runs the client script.
+	FuncName string
+
+	// Tasks of the batch.
+	Tasks []*BatchTask
+}
+
+// BatchTask is a single invocation of a client script.
+type BatchTask struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	Args        string
+	WantsOutput bool
+}
+
+// BatchResult is the outcome of a batch.
+type BatchResult struct {
+	// Results of the tasks indexed by task identifier.
+	Results map[uuid.UUID]*TaskResult
+
+	// Err describes why the worker failed to process the batch, in which case
+	// the results are ignored.
+	Err error
+
+	// RecordErr describes why the results could not be recorded in the store
+	// by the executor. The tasks have run, the results are still used.
+	RecordErr error
+}
+
+// TaskResult is the outcome of a task.
+type TaskResult struct {
+	ExitCode   int
+	FinishedAt time.Time
+	Stdout     string
+	Stderr     string
+}
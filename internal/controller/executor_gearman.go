@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/artefactual-labs/gearmin"
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/ccp/internal/workers"
+)
+
+// gearmanExecutor submits batches to MCPClient via the embedded Gearman job
+// server. Batches are encoded as the JSON payloads described by the tasks and
+// taskResults types.
+type gearmanExecutor struct {
+	gearman *gearmin.Server
+
+	// workers keeps track of the workers connected to the job server, it is
+	// optional.
+	workers *workers.Registry
+}
+
+var _ Executor = (*gearmanExecutor)(nil)
+
+// NewGearmanExecutor returns an executor that submits batches to the given job
+// server. The registry is used to find out whether a worker can run a batch,
+// it is optional.
+func NewGearmanExecutor(gearman *gearmin.Server, workers *workers.Registry) Executor {
+	return &gearmanExecutor{gearman: gearman, workers: workers}
+}
+
+// Execute submits the batch to the job server. Gearman cannot recall jobs so
+// the batch is not withdrawn when ctx is cancelled.
+func (e *gearmanExecutor) Execute(ctx context.Context, batch *Batch) <-chan *BatchResult {
+	done := make(chan *BatchResult, 1)
+
+	// The payload is shaped as a dictionary.
+	payload := tasks{Tasks: make(map[uuid.UUID]*task, len(batch.Tasks))}
+	for _, item := range batch.Tasks {
+		payload.Tasks[item.ID] = &task{
+			ID:          item.ID,
+			CreatedAt:   item.CreatedAt,
+			Args:        item.Args,
+			WantsOutput: item.WantsOutput,
+		}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		done <- &BatchResult{Err: fmt.Errorf("marshal tasks: %v", err)}
+		return done
+	}
+
+	e.gearman.Submit(
+		&gearmin.JobRequest{
+			ID:         batch.ID.String(), // Ensure uniqueness.
+			FuncName:   batch.FuncName,
+			Data:       data,
+			Background: false,
+			Callback: func(update gearmin.JobUpdate) {
+				// Workers can send more than one final update, e.g. an
+				// exception followed by a failure, only the first counts.
+				if update.Succeeded() || update.Failed() {
+					select {
+					case done <- batchResult(&update):
+					default:
+					}
+				}
+			},
+		},
+	)
+
+	return done
+}
+
+func (e *gearmanExecutor) CanExecute(funcName string) bool {
+	if e.workers == nil {
+		return true
+	}
+
+	return e.workers.CanDo(funcName)
+}
+
+// batchResult decodes the final update of a job.
+func batchResult(update *gearmin.JobUpdate) *BatchResult {
+	if !update.Succeeded() {
+		return &BatchResult{Err: errors.New(batchFailure(update))}
+	}
+
+	res := &taskResults{}
+	if err := json.Unmarshal(update.Data, res); err != nil {
+		return &BatchResult{Err: fmt.Errorf("decode results: %v", err)}
+	}
+
+	ret := &BatchResult{Results: make(map[uuid.UUID]*TaskResult, len(res.Results))}
+	for id, r := range res.Results {
+		ret.Results[id] = &TaskResult{
+			ExitCode:   r.ExitCode,
+			FinishedAt: r.FinishedAt,
+			Stdout:     r.Stdout,
+			Stderr:     r.Stderr,
+		}
+	}
+
+	return ret
+}
+
+// batchFailure describes why the worker failed to process a batch. Exceptions
+// carry the error raised by the worker.
+func batchFailure(update *gearmin.JobUpdate) string {
+	if update.Type == gearmin.JobUpdateTypeException {
+		if reason := strings.TrimSpace(string(update.Data)); reason != "" {
+			return reason
+		}
+		return "worker raised an exception"
+	}
+
+	return "worker failed to process the batch"
+}
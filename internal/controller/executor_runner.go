@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+)
+
+// defaultSharedDir is the location of the shared directory expected by
+// MCPClient, see replacementMapping.replaceValues.
+const defaultSharedDir = "/var/archivematica/sharedDirectory"
+
+// runnerExecutor runs the batches of the scripts that have a runner
+// in-process, the rest are passed to the next executor. It records the
+// outcome of the tasks in the store like MCPClient does.
+type runnerExecutor struct {
+	logger    logr.Logger
+	store     store.Store
+	next      Executor
+	sharedDir string
+}
+
+var _ Executor = (*runnerExecutor)(nil)
+
+func newRunnerExecutor(logger logr.Logger, store store.Store, next Executor, sharedDir string) *runnerExecutor {
+	return &runnerExecutor{
+		logger:    logger,
+		store:     store,
+		next:      next,
+		sharedDir: sharedDir,
+	}
+}
+
+func (e *runnerExecutor) Execute(ctx context.Context, batch *Batch) <-chan *BatchResult {
+	run, ok := runners[batch.FuncName]
+	if !ok || !e.supports(batch) {
+		return e.next.Execute(ctx, batch)
+	}
+
+	done := make(chan *BatchResult, 1)
+	go func() {
+		done <- e.execute(ctx, batch, run)
+	}()
+
+	return done
+}
+
+func (e *runnerExecutor) CanExecute(funcName string) bool {
+	if _, ok := runners[funcName]; ok {
+		return true
+	}
+
+	return e.next.CanExecute(funcName)
+}
+
+// supports reports whether the tasks of the batch can run in-process. Client
+// assets are only available to MCPClient.
+func (e *runnerExecutor) supports(batch *Batch) bool {
+	for _, task := range batch.Tasks {
+		if strings.Contains(task.Args, "%clientAssetsDirectory%") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// execute runs the tasks of the batch sequentially and records their outcome.
+// It stops when ctx is cancelled, the tasks left are not run. The results are
+// returned even if they cannot be recorded, the tasks must not run again.
+func (e *runnerExecutor) execute(ctx context.Context, batch *Batch, run runner) *BatchResult {
+	var (
+		ret = &BatchResult{Results: make(map[uuid.UUID]*TaskResult, len(batch.Tasks))}
+		tt  = make([]*store.Task, 0, len(batch.Tasks))
+	)
+
+	for _, task := range batch.Tasks {
+		if err := ctx.Err(); err != nil {
+			return &BatchResult{Err: err}
+		}
+
+		var stdout, stderr bytes.Buffer
+		exitCode := 1
+		if args, err := splitArgs(e.replaceValues(task)); err != nil {
+			fmt.Fprintf(&stderr, "Invalid arguments: %v\n", err)
+		} else {
+			exitCode = run(ctx, args, &stdout, &stderr)
+		}
+
+		result := &TaskResult{
+			ExitCode:   exitCode,
+			FinishedAt: time.Now().UTC(),
+			Stdout:     stdout.String(),
+			Stderr:     stderr.String(),
+		}
+		ret.Results[task.ID] = result
+		tt = append(tt, &store.Task{
+			ID:       task.ID,
+			Stdout:   result.Stdout,
+			Stderr:   result.Stderr,
+			ExitCode: sql.NullInt16{Int16: int16(exitCode), Valid: true},
+			EndedAt:  sql.NullTime{Time: result.FinishedAt, Valid: true},
+		})
+	}
+
+	if err := e.store.UpdateTasks(ctx, tt); err != nil {
+		e.logger.Error(err, "Failed to record the results of a batch run in-process.", "function", batch.FuncName, "size", len(batch.Tasks))
+		ret.RecordErr = fmt.Errorf("record results: %v", err)
+	}
+
+	e.logger.V(3).Info("Batch run in-process.", "function", batch.FuncName, "size", len(batch.Tasks))
+
+	return ret
+}
+
+// replaceValues replaces the values that MCPClient replaces in the arguments
+// of a task. The paths under the shared directory of MCPClient are mapped back
+// to our shared directory.
+func (e *runnerExecutor) replaceValues(task *BatchTask) string {
+	args := strings.NewReplacer(
+		"%sharedPath%", joinPath(e.sharedDir, ""),
+		"%date%", time.Now().UTC().Format(time.RFC3339Nano),
+		"%taskUUID%", task.ID.String(),
+		"%jobCreatedDate%", task.CreatedAt.Format(time.RFC3339Nano),
+	).Replace(task.Args)
+
+	if e.sharedDir != "" && joinPath(e.sharedDir, "") != joinPath(defaultSharedDir, "") {
+		args = strings.ReplaceAll(args, joinPath(defaultSharedDir, ""), joinPath(e.sharedDir, ""))
+	}
+
+	return args
+}
@@ -0,0 +1,416 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/bagit"
+	"github.com/artefactual-labs/ccp/internal/storage"
+)
+
+// failedIngestTTL is how long the progress of a failed ingest is kept. The
+// package is recorded as failed in the store, the progress only adds the
+// reason of the failure.
+const failedIngestTTL = 24 * time.Hour
+
+// ingestPool caps the number of transfers copied concurrently into the
+// processing directory and keeps track of their progress.
+type ingestPool struct {
+	// sem holds a token for every copy in progress.
+	sem chan struct{}
+
+	// ingests is the progress of the copies queued, in progress or failed,
+	// indexed by package identifier. Failed copies are removed once they
+	// are older than ttl.
+	ingests map[uuid.UUID]*ingestProgress
+	ttl     time.Duration
+
+	// mu protects ingests.
+	mu sync.RWMutex
+}
+
+func newIngestPool(config Config) *ingestPool {
+	limit := config.MaxActiveIngests
+	if limit < 1 {
+		limit = defaultMaxActiveIngests
+	}
+
+	return &ingestPool{
+		sem:     make(chan struct{}, limit),
+		ingests: map[uuid.UUID]*ingestProgress{},
+		ttl:     failedIngestTTL,
+	}
+}
+
+// add starts tracking the ingest of a package. The failed ingests that
+// expired are removed.
+func (p *ingestPool) add(id uuid.UUID) *ingestProgress {
+	progress := &ingestProgress{status: adminv1.IngestStatus_INGEST_STATUS_QUEUED}
+	now := time.Now()
+
+	p.mu.Lock()
+	for key, item := range p.ingests {
+		if item.expired(now, p.ttl) {
+			delete(p.ingests, key)
+		}
+	}
+	p.ingests[id] = progress
+	p.mu.Unlock()
+
+	return progress
+}
+
+// remove stops tracking the ingest of a package.
+func (p *ingestPool) remove(id uuid.UUID) {
+	p.mu.Lock()
+	delete(p.ingests, id)
+	p.mu.Unlock()
+}
+
+// uses reports whether an ingest in progress uses the given path or a path
+// under it, see ingestProgress.use.
+func (p *ingestPool) uses(path string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, progress := range p.ingests {
+		if progress.uses(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// get returns the progress of the ingest of a package.
+func (p *ingestPool) get(id uuid.UUID) (*ingestProgress, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	progress, ok := p.ingests[id]
+	if ok && progress.expired(time.Now(), p.ttl) {
+		return nil, false
+	}
+
+	return progress, ok
+}
+
+// run calls fn once a slot becomes available in the pool or returns the
+// context error when the context is done first.
+func (p *ingestPool) run(ctx context.Context, progress *ingestProgress, fn func() error) error {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.sem }()
+
+	progress.setStatus(adminv1.IngestStatus_INGEST_STATUS_COPYING, nil)
+
+	return fn()
+}
+
+// ingestProgress tracks the copy of the sources of a transfer.
+type ingestProgress struct {
+	bytesCopied atomic.Int64
+	bytesTotal  atomic.Int64
+	filesCopied atomic.Int64
+	filesTotal  atomic.Int64
+
+	// status, err and failedAt are protected by mu.
+	status   adminv1.IngestStatus
+	err      error
+	failedAt time.Time
+
+	// paths are the directories written by the ingest, e.g. the temporary
+	// directory of the copy and its destination. They are protected from the
+	// retention policies. Protected by mu.
+	paths []string
+
+	mu sync.Mutex
+}
+
+// use records a directory written by the ingest. It must be called before
+// the directory is created.
+func (p *ingestProgress) use(path string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	p.paths = append(p.paths, filepath.Clean(path))
+	p.mu.Unlock()
+}
+
+// uses reports whether the ingest uses the given path or a path under it.
+func (p *ingestProgress) uses(path string) bool {
+	path = filepath.Clean(path)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, item := range p.paths {
+		if item == path || strings.HasPrefix(item, path+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *ingestProgress) setStatus(status adminv1.IngestStatus, err error) {
+	p.mu.Lock()
+	p.status, p.err = status, err
+	p.mu.Unlock()
+}
+
+// fail sets the final status of an ingest that did not complete, its paths
+// are no longer in use.
+func (p *ingestProgress) fail(status adminv1.IngestStatus, err error) {
+	p.mu.Lock()
+	p.status, p.err, p.failedAt = status, err, time.Now()
+	p.paths = nil
+	p.mu.Unlock()
+}
+
+// expired reports whether the ingest failed longer than ttl ago.
+func (p *ingestProgress) expired(now time.Time, ttl time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return !p.failedAt.IsZero() && now.Sub(p.failedAt) > ttl
+}
+
+// sourcesSize is the size and the number of regular files of the sources of
+// a package.
+type sourcesSize struct {
+	bytes int64
+	files int64
+}
+
+// measureSources returns the size and the number of regular files of the
+// sources. Local symbolic links are not followed, like in the copy.
+func measureSources(ctx context.Context, sources []source) (*sourcesSize, error) {
+	size := &sourcesSize{}
+	for _, src := range sources {
+		var err error
+		if src.location == nil {
+			err = filepath.WalkDir(src.path, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if !d.Type().IsRegular() {
+					return nil
+				}
+				info, err := d.Info()
+				if err != nil {
+					return err
+				}
+				size.bytes += info.Size()
+				size.files++
+				return nil
+			})
+		} else {
+			err = storage.Walk(ctx, src.location, src.path, func(entry storage.Entry) error {
+				if !entry.IsDir {
+					size.bytes += entry.Size
+					size.files++
+				}
+				return nil
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return size, nil
+}
+
+// measure sets the totals to the size of the sources, they are measured
+// unless the size is given, e.g. when measured to admit the transfer.
+func (p *ingestProgress) measure(ctx context.Context, sources []source, size *sourcesSize) error {
+	if size == nil {
+		var err error
+		if size, err = measureSources(ctx, sources); err != nil {
+			return err
+		}
+	}
+	p.bytesTotal.Store(size.bytes)
+	p.filesTotal.Store(size.files)
+
+	return nil
+}
+
+// newIngestDir creates a temporary directory for the copy of an ingest. The
+// directory is recorded as in use by the ingest before it is created.
+func newIngestDir(sharedDir string, progress *ingestProgress) (string, error) {
+	path := filepath.Join(sharedDir, "tmp", uuid.NewString())
+	progress.use(path)
+	if err := os.Mkdir(path, os.FileMode(0o770)); err != nil {
+		return "", err
+	}
+	_ = os.Chmod(path, os.FileMode(0o770))
+
+	return path, nil
+}
+
+// wrapReader returns a reader that counts the bytes and the files copied.
+func (p *ingestProgress) wrapReader(r io.Reader) io.Reader {
+	return &progressReader{r: r, progress: p}
+}
+
+// proto returns the progress in the format used by the API.
+func (p *ingestProgress) proto() *adminv1.Ingest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ingest := &adminv1.Ingest{
+		Status:      p.status,
+		BytesCopied: p.bytesCopied.Load(),
+		BytesTotal:  p.bytesTotal.Load(),
+		FilesCopied: p.filesCopied.Load(),
+		FilesTotal:  p.filesTotal.Load(),
+	}
+	if p.err != nil {
+		ingest.Error = p.err.Error()
+	}
+	var verr *bagit.ValidationError
+	if errors.As(p.err, &verr) {
+		ingest.ValidationProblem = make([]*adminv1.ValidationProblem, 0, len(verr.Problems))
+		for _, problem := range verr.Problems {
+			ingest.ValidationProblem = append(ingest.ValidationProblem, &adminv1.ValidationProblem{
+				Path:    problem.Path,
+				Message: problem.Message,
+			})
+		}
+	}
+
+	return ingest
+}
+
+// progressReader counts the bytes read, a file is counted once it is read to
+// the end.
+type progressReader struct {
+	r        io.Reader
+	progress *ingestProgress
+	eof      bool
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.progress.bytesCopied.Add(int64(n))
+	if err == io.EOF && !r.eof {
+		r.eof = true
+		r.progress.filesCopied.Add(1)
+	}
+
+	return n, err
+}
+
+// ingestTransfer copies the sources of a new transfer into the processing
+// directory using the ingest pool, see ingest. Bag transfers are validated
+// after the copy, invalid bags are moved to the rejected directory.
+func (c *Controller) ingestTransfer(ctx context.Context, pkg *Package, req *adminv1.CreatePackageRequest, sources []source, size *sourcesSize) {
+	c.ingest(ctx, pkg, req.Priority, func(progress *ingestProgress) error {
+		if err := pkg.ingestTransfer(ctx, req.Name, sources, size, progress); err != nil {
+			return err
+		}
+		return validateBag(ctx, req.Type, pkg.Path(), progress)
+	})
+}
+
+// ingest runs fn using the ingest pool to copy the contents of a new package
+// and queues the package once fn completes. A failed copy or validation marks
+// the package as failed and the error is reported by PackageIngest for
+// failedIngestTTL.
+func (c *Controller) ingest(ctx context.Context, pkg *Package, priority int32, fn func(progress *ingestProgress) error) {
+	progress := c.ingests.add(pkg.id)
+
+	c.group.Go(func() error {
+		logger := c.logger.WithValues("package", pkg.id)
+
+		err := c.ingests.run(ctx, progress, func() error {
+			return fn(progress)
+		})
+		if err == nil {
+			logger.V(2).Info("Package ready for processing.", "path", pkg.Path())
+			// The claim protects the package from the retention policies
+			// until it is moved, see claims.prune.
+			c.claims.claim(pkg.Path())
+			c.ingests.remove(pkg.id)
+			if err := c.queue(pkg, priority); err != nil {
+				logger.Error(err, "Failed to queue package.")
+				return nil
+			}
+			c.pick()
+			return nil
+		}
+
+		// The controller is closing.
+		if ctx.Err() != nil {
+			logger.Info("Ingest abandoned.", "err", err)
+			c.ingests.remove(pkg.id)
+			return nil
+		}
+
+		var verr *bagit.ValidationError
+		if errors.As(err, &verr) {
+			logger.Info("Transfer rejected, the bag is not valid.", "err", err)
+			progress.fail(adminv1.IngestStatus_INGEST_STATUS_INVALID, err)
+			if err := pkg.moveToRejected(ctx); err != nil {
+				logger.Error(err, "Failed to move the package to the rejected directory.")
+			}
+		} else {
+			logger.Error(err, "Failed to ingest package.")
+			progress.fail(adminv1.IngestStatus_INGEST_STATUS_FAILED, err)
+		}
+		if err := pkg.markAsFailed(ctx); err != nil {
+			logger.Error(err, "Failed to mark the package as failed.")
+		}
+		c.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_FAILED, pkg))
+
+		return nil
+	})
+}
+
+// validateBag validates the transfer at the given path when its type is a bag,
+// other types are not validated. Bags in archive formats not supported by the
+// validator are left to the workflow.
+func validateBag(ctx context.Context, tt adminv1.TransferType, path string, progress *ingestProgress) error {
+	if tt != adminv1.TransferType_TRANSFER_TYPE_UNZIPPED_BAG && tt != adminv1.TransferType_TRANSFER_TYPE_ZIPPED_BAG {
+		return nil
+	}
+
+	progress.setStatus(adminv1.IngestStatus_INGEST_STATUS_VALIDATING, nil)
+
+	err := bagit.Validate(ctx, path)
+	if errors.Is(err, bagit.ErrUnsupportedArchive) {
+		return nil
+	}
+
+	return err
+}
+
+// PackageIngest returns the progress of the copy of the contents of a new
+// package, e.g. the sources of a transfer. It is only known while the copy is
+// queued or in progress, or for failedIngestTTL after the copy failed.
+func (c *Controller) PackageIngest(id uuid.UUID) (*adminv1.Ingest, bool) {
+	progress, ok := c.ingests.get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return progress.proto(), true
+}
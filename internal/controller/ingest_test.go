@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestIngestProgress(t *testing.T) {
+	t.Parallel()
+
+	newSources := func(t *testing.T) *fs.Dir {
+		t.Helper()
+
+		return fs.NewDir(t, "ccp",
+			fs.WithDir("objects",
+				fs.WithFile("image.jpg", "12345"),
+				fs.WithFile("empty.txt", ""),
+				fs.WithDir("docs", fs.WithFile("notes.txt", "123")),
+			),
+		)
+	}
+
+	t.Run("Reports the bytes and files copied", func(t *testing.T) {
+		t.Parallel()
+
+		src := newSources(t)
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("currentlyProcessing"))
+		sources := localSources(src.Join("objects"))
+
+		progress := &ingestProgress{}
+		assert.NilError(t, progress.measure(context.Background(), sources, nil))
+		_, err := copyTransfer(context.Background(), sharedDir.Path(), t.TempDir(), "Images", sources, progress)
+		assert.NilError(t, err)
+
+		ingest := progress.proto()
+		assert.Equal(t, ingest.BytesCopied, int64(8))
+		assert.Equal(t, ingest.BytesTotal, int64(8))
+		assert.Equal(t, ingest.FilesCopied, int64(3))
+		assert.Equal(t, ingest.FilesTotal, int64(3))
+	})
+
+	t.Run("Interrupts the copy when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		src := newSources(t)
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("currentlyProcessing"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := copyTransfer(ctx, sharedDir.Path(), t.TempDir(), "Images", localSources(src.Join("objects")), nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestControllerIngest(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	newController := func(t *testing.T) (*Controller, *storemock.MockStore) {
+		t.Helper()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("tmp"), fs.WithDir("currentlyProcessing"), fs.WithDir("rejected"))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActiveIngests: 1}, sharedDir.Path(), "")
+
+		return c, s
+	}
+
+	newTransfer := func(c *Controller) *Package {
+		pkg := newPackage(logr.Discard(), c.store, c.sharedDir)
+		pkg.id = uuid.New()
+		pkg.unit = &Transfer{pkg: pkg}
+		return pkg
+	}
+
+	t.Run("Queues the transfer once copied", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		c.Drain() // Keeps the package in the queue.
+		pkg := newTransfer(c)
+		src := fs.NewDir(t, "ccp", fs.WithFile("image.jpg", "12345"))
+
+		s.EXPECT().UpdateTransferLocation(gomock.Any(), pkg.id, c.sharedDir+"/currentlyProcessing/Images").Return(nil)
+		s.EXPECT().CreateUnitVar(gomock.Any(), pkg.id, enums.PackageTypeTransfer, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+
+		c.ingestTransfer(context.Background(), pkg, &adminv1.CreatePackageRequest{Name: "Images"}, localSources(src.Path()), nil)
+		assert.NilError(t, c.group.Wait())
+
+		_, ok := c.PackageIngest(pkg.id)
+		assert.Assert(t, !ok)
+		assert.Equal(t, c.scheduler.len(), 1)
+		assert.Assert(t, c.claims.claimed(pkg.Path()))
+		assertEmptyDir(t, filepath.Join(c.sharedDir, "tmp"))
+	})
+
+	t.Run("Marks the transfer as failed when the copy fails", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		pkg := newTransfer(c)
+
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil)
+
+		c.ingestTransfer(context.Background(), pkg, &adminv1.CreatePackageRequest{Name: "Images"}, localSources("/non-existent"), nil)
+		assert.NilError(t, c.group.Wait())
+
+		ingest, ok := c.PackageIngest(pkg.id)
+		assert.Assert(t, ok)
+		assert.Equal(t, ingest.Status, adminv1.IngestStatus_INGEST_STATUS_FAILED)
+		assert.Assert(t, strings.HasPrefix(ingest.Error, "measure sources"), ingest.Error)
+		assert.Equal(t, c.scheduler.len(), 0)
+		assertEmptyDir(t, filepath.Join(c.sharedDir, "tmp"))
+	})
+
+	t.Run("Forgets failed ingests once they expire", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		c.ingests.ttl = 0
+		pkg := newTransfer(c)
+
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil)
+
+		c.ingestTransfer(context.Background(), pkg, &adminv1.CreatePackageRequest{Name: "Images"}, localSources("/non-existent"), nil)
+		assert.NilError(t, c.group.Wait())
+
+		_, ok := c.PackageIngest(pkg.id)
+		assert.Assert(t, !ok)
+
+		c.ingests.add(uuid.New())
+		_, ok = c.ingests.ingests[pkg.id]
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("Rejects bag transfers that are not valid", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		pkg := newTransfer(c)
+		src := fs.NewDir(t, "ccp",
+			fs.WithFile("bagit.txt", "BagIt-Version: 1.0\nTag-File-Character-Encoding: UTF-8\n"),
+			fs.WithDir("data", fs.WithFile("image.jpg", "12345")),
+		)
+
+		s.EXPECT().UpdateTransferLocation(gomock.Any(), pkg.id, c.sharedDir+"/currentlyProcessing/Images").Return(nil)
+		s.EXPECT().UpdateTransferLocation(gomock.Any(), pkg.id, "%sharedPath%rejected/Images/").Return(nil)
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), pkg.id, enums.PackageTypeTransfer, enums.PackageStatusFailed).Return(nil)
+
+		req := &adminv1.CreatePackageRequest{Name: "Images", Type: adminv1.TransferType_TRANSFER_TYPE_UNZIPPED_BAG}
+		c.ingestTransfer(context.Background(), pkg, req, localSources(src.Path()), nil)
+		assert.NilError(t, c.group.Wait())
+
+		ingest, ok := c.PackageIngest(pkg.id)
+		assert.Assert(t, ok)
+		assert.Equal(t, ingest.Status, adminv1.IngestStatus_INGEST_STATUS_INVALID)
+		assert.Equal(t, ingest.Error, "invalid bag: no payload manifest found")
+		assert.Equal(t, len(ingest.ValidationProblem), 1)
+		assert.Equal(t, c.scheduler.len(), 0)
+		assert.Equal(t, pkg.Path(), c.sharedDir+"/rejected/Images/")
+	})
+}
+
+func assertEmptyDir(t *testing.T, path string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(path)
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 0)
+}
@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
@@ -20,26 +21,59 @@ type jobIterator struct {
 	ctx      context.Context
 	logger   logr.Logger
 	metrics  *metrics.Metrics
-	gearman  *gearmin.Server
+	events   *eventBus
+	executor Executor
 	wf       *workflow.Document
+	config   Config
 	pkg      *Package
 	nextLink uuid.UUID // Next workflow link or workflow chain link.
 	chain    *chain    // Current workflow chain
 }
 
-func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Metrics, gearman *gearmin.Server, wf *workflow.Document, pkg *Package) *jobIterator {
+func newJobIterator(ctx context.Context, logger logr.Logger, metrics *metrics.Metrics, events *eventBus, executor Executor, wf *workflow.Document, config Config, pkg *Package) *jobIterator {
 	iter := &jobIterator{
-		ctx:     ctx,
-		logger:  logger,
-		metrics: metrics,
-		gearman: gearman,
-		wf:      wf,
-		pkg:     pkg,
+		ctx:      ctx,
+		logger:   logger,
+		metrics:  metrics,
+		events:   events,
+		executor: executor,
+		wf:       wf,
+		config:   config,
+		pkg:      pkg,
 	}
 
 	return iter
 }
 
+// restore prepares the iterator to continue processing from a persisted state.
+// The state is expected to be validated by the caller.
+func (i *jobIterator) restore(state *iteratorState) {
+	i.nextLink = state.NextLinkID
+
+	if wc, ok := i.wf.Chains[state.ChainID]; ok {
+		i.chain = newChain(wc)
+		for _, kv := range state.Context {
+			i.chain.context.Set(kv[0], kv[1])
+		}
+	}
+}
+
+// saveState persists the state of the iterator so processing can be resumed
+// from the last completed link after a restart.
+func (i *jobIterator) saveState() {
+	state := newIteratorState(i.pkg, i.chain, i.nextLink)
+	if err := i.pkg.saveIteratorState(i.ctx, state); err != nil {
+		i.logger.Error(err, "Failed to persist the iterator state.")
+	}
+}
+
+// clearState removes the persisted state once processing ends.
+func (i *jobIterator) clearState() {
+	if err := i.pkg.clearIteratorState(i.ctx); err != nil {
+		i.logger.Error(err, "Failed to clear the iterator state.")
+	}
+}
+
 func (i *jobIterator) init() error {
 	i.logger.Info("Init iterator.")
 
@@ -74,6 +108,7 @@ func (i *jobIterator) next() error {
 
 	if wc, ok := i.wf.Chains[i.nextLink]; ok {
 		i.logger.Info("Starting new chain.", "id", wc.ID, "desc", wc.Description)
+		trace.SpanFromContext(i.ctx).AddEvent("chain", trace.WithAttributes(chainAttrs(wc)...))
 		i.chain = newChain(wc)
 		if err := i.chain.load(i.ctx, i.pkg); err != nil {
 			return fmt.Errorf("load context: %v", err)
@@ -84,6 +119,7 @@ func (i *jobIterator) next() error {
 		} else {
 			i.nextLink = wc.LinkID // Normal flow.
 		}
+		i.saveState()
 		return nil
 	}
 
@@ -101,14 +137,36 @@ func (i *jobIterator) next() error {
 		return fmt.Errorf("build job for link %s: %v", wl.ID, err)
 	}
 
-	next, err := j.exec(i.ctx)
+	i.events.publish(newJobEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_JOB_STARTED, j, adminv1.JobStatus_JOB_STATUS_EXECUTING_COMMANDS))
+
+	ctx, span := tracer.Start(i.ctx, wl.Description.String(), trace.WithAttributes(
+		attrJobID.String(j.id.String()),
+		attrLinkID.String(wl.ID.String()),
+		attrLinkManager.String(wl.Manager),
+		attrChainID.String(i.chain.wc.ID.String()),
+	))
+	next, err := j.exec(ctx)
 	j.logger.Info("Job executed.", "name", j.wl.Description, "err", err)
+	if _, ok := isErrWait(err); ok || errors.Is(err, io.EOF) {
+		endSpan(span, nil)
+	} else {
+		endSpan(span, err)
+	}
+
+	if _, ok := isErrWait(err); !ok {
+		status := adminv1.JobStatus_JOB_STATUS_COMPLETED_SUCCESSFULLY
+		if err != nil && !errors.Is(err, io.EOF) {
+			status = adminv1.JobStatus_JOB_STATUS_FAILED
+		}
+		i.events.publish(newJobEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_JOB_COMPLETED, j, status))
+	}
 
 	if errors.Is(err, io.EOF) {
 		if wl.End {
 			if err := j.pkg.markAsDone(i.ctx); err != nil {
 				j.logger.Error(err, "Failed to mark the package as done.")
 			}
+			i.events.publish(newPackageEvent(adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_DONE, j.pkg))
 			return errEnd
 		} else {
 			// Signal end of this iterator.
@@ -120,13 +178,12 @@ func (i *jobIterator) next() error {
 	} else if _, ok := isErrWait(err); ok {
 		return err
 	} else if err != nil {
-		if err := j.pkg.markAsFailed(i.ctx); err != nil {
-			j.logger.Error(err, "Failed to mark the package as failed.")
-		}
+		// The package is marked as failed by the controller.
 		return fmt.Errorf("exec job for link %s with manager %s (%s) : %v", wl.ID, wl.Manager, wl.Description, err)
 	}
 
 	i.nextLink = next
+	i.saveState()
 
 	return nil
 }
@@ -141,7 +198,7 @@ func (i *jobIterator) buildJob(wl *workflow.Link, logger logr.Logger) (*job, err
 		"terminator", wl.End,
 	)
 
-	j, err := newJob(logger, i.metrics, i.chain, i.pkg, i.gearman, wl, i.wf)
+	j, err := newJob(logger, i.metrics, i.chain, i.pkg, i.executor, wl, i.wf, i.config)
 	if err != nil {
 		return nil, fmt.Errorf("build job: %v", err)
 	}
@@ -5,13 +5,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
 	"github.com/artefactual-labs/ccp/internal/derrors"
-	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
+	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/workflow"
 )
 
@@ -19,8 +18,8 @@ type job struct {
 	logger  logr.Logger
 	metrics *metrics.Metrics
 
-	// gearman is used to dispatch jobs to MCPClient.
-	gearman *gearmin.Server
+	// executor is used to dispatch the tasks of client scripts to the workers.
+	executor Executor
 
 	// id of the job.
 	id uuid.UUID
@@ -40,6 +39,9 @@ type job struct {
 	// wf is used to validate preconfigured choices.
 	wf *workflow.Document
 
+	// config provides the retry and timeout policies of the scripts.
+	config Config
+
 	// jobRunner is what makes a job executable.
 	jobRunner
 
@@ -52,17 +54,18 @@ type jobRunner interface {
 	exec(context.Context) (uuid.UUID, error)
 }
 
-func newJob(logger logr.Logger, metrics *metrics.Metrics, chain *chain, pkg *Package, gearman *gearmin.Server, wl *workflow.Link, wf *workflow.Document) (*job, error) {
+func newJob(logger logr.Logger, metrics *metrics.Metrics, chain *chain, pkg *Package, executor Executor, wl *workflow.Link, wf *workflow.Document, config Config) (*job, error) {
 	j := &job{
 		logger:    logger,
 		metrics:   metrics,
-		gearman:   gearman,
+		executor:  executor,
 		id:        uuid.New(),
 		createdAt: time.Now().UTC(),
 		chain:     chain,
 		pkg:       pkg,
 		wl:        wl,
 		wf:        wf,
+		config:    config,
 	}
 
 	var err error
@@ -130,7 +133,7 @@ func (j *job) save(ctx context.Context) (err error) {
 		return fmt.Errorf("reload package: %v", err)
 	}
 
-	return j.pkg.store.CreateJob(ctx, &sqlcmysql.CreateJobParams{
+	return j.pkg.store.CreateJob(ctx, &store.CreateJobParams{
 		ID:                j.id,
 		Type:              j.wl.Description.String(),
 		CreatedAt:         j.createdAt,
@@ -67,7 +67,7 @@ func (l *directoryClientScriptJob) submitTasks(ctx context.Context) (*taskResult
 	stdout := rm.replaceValues(l.config.StdoutFile)
 	stderr := rm.replaceValues(l.config.StderrFile)
 
-	taskBackend := newTaskBackend(l.j.logger, l.j.metrics, l.j, l.j.pkg.store, l.j.gearman, l.config)
+	taskBackend := newTaskBackend(l.j.logger, l.j.metrics, l.j, l.j.pkg.store, l.j.executor, l.config)
 	if err := taskBackend.submit(ctx, rm, args, false, stdout, stderr); err != nil {
 		return nil, err
 	}
@@ -135,7 +135,7 @@ func (l *filesClientScriptJob) exec(ctx context.Context) (uuid.UUID, error) {
 
 func (l *filesClientScriptJob) submitTasks(ctx context.Context, filterSubDir string) (*taskResults, error) {
 	rm := l.j.pkg.unit.replacements(filterSubDir).update(l.j.chain)
-	taskBackend := newTaskBackend(l.j.logger, l.j.metrics, l.j, l.j.pkg.store, l.j.gearman, l.config)
+	taskBackend := newTaskBackend(l.j.logger, l.j.metrics, l.j, l.j.pkg.store, l.j.executor, l.config)
 
 	files, err := l.j.pkg.Files(ctx, l.config.FilterFileEnd, filterSubDir)
 	if err != nil {
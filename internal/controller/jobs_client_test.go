@@ -3,7 +3,9 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,9 +14,12 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/mikespook/gearman-go/worker"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.artefactual.dev/tools/mockutil"
 	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/store"
 )
 
 func TestDirectoryClientScriptJob(t *testing.T) {
@@ -60,6 +65,104 @@ func TestDirectoryClientScriptJob(t *testing.T) {
 		assert.ErrorIs(t, err, io.EOF) // End of chain.
 		assert.Equal(t, jobs, 1)
 	})
+
+	t.Run("Retries batches that the worker fails to process", func(t *testing.T) {
+		t.Parallel()
+
+		var jobs atomic.Int32
+		jobHandler := func(job worker.Job) ([]byte, error) {
+			if jobs.Add(1) == 1 {
+				return []byte("Lost connection to the database."), errors.New("exception")
+			}
+			results := map[uuid.UUID]*taskResult{}
+			for _, task := range decodeTasks(t, job) {
+				results[task.ID] = &taskResult{FinishedAt: time.Now()}
+			}
+			return encodeTaskResults(t, results), nil
+		}
+
+		job, store := createJobWithHandlers(t,
+			"002716a1-ae29-4f36-98ab-0d97192669c4", // Move to compressionAIPDecisions directory.
+			map[string]gearmintest.Handler{"movesip_v0.0": jobHandler},
+		)
+		job.config.Retries = RetryPolicies{"*": {Attempts: 2, Backoff: time.Millisecond}}
+		createAutomatedProcessingConfig(t, job.pkg.path)
+
+		store.EXPECT().CreateJob(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+		store.EXPECT().UpdateJobStatus(mockutil.Context(), gomock.Any(), "Completed successfully").Return(nil).AnyTimes()
+		store.EXPECT().CreateTasks(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+
+		_, err := job.exec(context.Background())
+		assert.ErrorIs(t, err, io.EOF) // End of chain.
+		assert.Equal(t, jobs.Load(), int32(2))
+	})
+
+	t.Run("Fails the tasks once the retries are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		var jobs atomic.Int32
+		jobHandler := func(job worker.Job) ([]byte, error) {
+			jobs.Add(1)
+			return []byte("Lost connection to the database."), errors.New("exception")
+		}
+
+		job, s := createJobWithHandlers(t,
+			"002716a1-ae29-4f36-98ab-0d97192669c4", // Move to compressionAIPDecisions directory.
+			map[string]gearmintest.Handler{"movesip_v0.0": jobHandler},
+		)
+		job.config.Retries = RetryPolicies{"moveSIP_v0.0": {Attempts: 3, Backoff: time.Millisecond}}
+		createAutomatedProcessingConfig(t, job.pkg.path)
+
+		s.EXPECT().CreateJob(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+		s.EXPECT().CreateTasks(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tasks []*store.Task) error {
+				assert.Equal(t, len(tasks), 1)
+				assert.Equal(t, tasks[0].ExitCode.Int16, int16(1))
+				assert.Equal(t, tasks[0].Stderr, "Worker failed to process the task after 3 attempt(s): Lost connection to the database.\n")
+				return nil
+			},
+		).Times(1)
+		s.EXPECT().UpdateJobStatus(mockutil.Context(), gomock.Any(), "Failed").Return(nil).AnyTimes()
+
+		_, err := job.exec(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, jobs.Load(), int32(3))
+	})
+
+	t.Run("Times out batches that exceed their deadline", func(t *testing.T) {
+		t.Parallel()
+
+		jobHandler := func(job worker.Job) ([]byte, error) {
+			time.Sleep(time.Second / 2)
+			return nil, errors.New("too late")
+		}
+
+		job, s := createJobWithHandlers(t,
+			"002716a1-ae29-4f36-98ab-0d97192669c4", // Move to compressionAIPDecisions directory.
+			map[string]gearmintest.Handler{"movesip_v0.0": jobHandler},
+		)
+		job.config.Retries = RetryPolicies{"*": {Attempts: 3}}
+		job.config.Timeouts = TimeoutPolicies{"*": {TaskTimeout: time.Millisecond * 50, Warning: time.Millisecond * 10}}
+		createAutomatedProcessingConfig(t, job.pkg.path)
+
+		s.EXPECT().CreateJob(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+		s.EXPECT().CreateTasks(mockutil.Context(), gomock.Any()).Return(nil).Times(1)
+		s.EXPECT().UpdateTasks(mockutil.Context(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tasks []*store.Task) error {
+				assert.Equal(t, len(tasks), 1)
+				assert.Equal(t, tasks[0].ExitCode.Int16, int16(timedOutTaskExitCode))
+				assert.Equal(t, tasks[0].Stderr, "Task timed out: the batch did not complete within 50ms.\n")
+				return nil
+			},
+		).Times(1)
+		s.EXPECT().UpdateJobStatus(mockutil.Context(), gomock.Any(), "Failed").Return(nil).AnyTimes()
+
+		_, err := job.exec(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, testutil.ToFloat64(job.metrics.GearmanSlowJobsCounter.WithLabelValues("moveSIP_v0.0")), float64(1))
+		assert.Equal(t, testutil.ToFloat64(job.metrics.GearmanTimedOutJobsCounter.WithLabelValues("moveSIP_v0.0")), float64(1))
+	})
 }
 
 func TestFilesClientScriptJob(t *testing.T) {
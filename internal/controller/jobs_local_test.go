@@ -9,8 +9,8 @@ import (
 	"go.uber.org/mock/gomock"
 	"gotest.tools/v3/assert"
 
+	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/store/enums"
-	"github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
 )
 
 func TestSetUnitVarLinkJob(t *testing.T) {
@@ -21,7 +21,7 @@ func TestSetUnitVarLinkJob(t *testing.T) {
 
 		job, st := createJob(t, "b33c9544-145c-4525-8a80-d686b4d1c3fa")
 
-		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&sqlcmysql.CreateJobParams{})).Return(nil).Times(1)
+		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&store.CreateJobParams{})).Return(nil).Times(1)
 		st.EXPECT().CreateUnitVar(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, "normalizationThumbnailProcessing", "", uuid.MustParse("180ae3d0-aa6c-4ed4-ab94-d0a2121e7f21"), true).Times(1)
 		st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, "STATUS_COMPLETED_SUCCESSFULLY").Return(nil).Times(1)
 
@@ -40,7 +40,7 @@ func TestGetUnitVarLinkJob(t *testing.T) {
 		nextLinkID := uuid.MustParse("0ce7ab48-fd48-4abe-9150-f682499e7cf0")
 		job, st := createJob(t, "6e5126be-76ac-4c8f-9754-fc25a234a751")
 
-		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&sqlcmysql.CreateJobParams{})).Return(nil).Times(1)
+		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&store.CreateJobParams{})).Return(nil).Times(1)
 		st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, "STATUS_COMPLETED_SUCCESSFULLY").Return(nil).Times(1)
 		st.EXPECT().ReadUnitLinkID(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, "normalizationThumbnailProcessing").Return(nextLinkID, nil).Times(1)
 
@@ -54,7 +54,7 @@ func TestGetUnitVarLinkJob(t *testing.T) {
 
 		job, st := createJob(t, "b04e9232-2aea-49fc-9560-27349c8eba4e")
 
-		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&sqlcmysql.CreateJobParams{})).Return(nil).Times(1)
+		st.EXPECT().CreateJob(mockutil.Context(), gomock.AssignableToTypeOf(&store.CreateJobParams{})).Return(nil).Times(1)
 		st.EXPECT().UpdateJobStatus(mockutil.Context(), job.id, "STATUS_COMPLETED_SUCCESSFULLY").Return(nil).Times(1)
 		st.EXPECT().ReadUnitLinkID(mockutil.Context(), job.pkg.id, enums.PackageTypeTransfer, "loadOptionsToCreateSIP").Return(uuid.MustParse("bb194013-597c-4e4a-8493-b36d190f8717"), nil).Times(1)
 
@@ -47,7 +47,7 @@ func createJobWithHandlers(t *testing.T, linkID string, handlers map[string]gear
 	pkg.unit = &noUnit{}
 	pkg.path = tmpDir.Join("sharedDir/tmp/pkg")
 
-	job, err := newJob(logr.Discard(), metrics.NewMetrics(nil), chain, pkg, gearmin, ln, wf)
+	job, err := newJob(logr.Discard(), metrics.NewMetrics(nil), chain, pkg, NewGearmanExecutor(gearmin, nil), ln, wf, Config{})
 	assert.NilError(t, err)
 
 	return job, store
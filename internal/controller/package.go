@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"maps"
 	"os"
@@ -18,6 +19,7 @@ import (
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	"github.com/artefactual-labs/ccp/internal/derrors"
+	"github.com/artefactual-labs/ccp/internal/storage"
 	"github.com/artefactual-labs/ccp/internal/store"
 	"github.com/artefactual-labs/ccp/internal/store/enums"
 	"github.com/artefactual-labs/ccp/internal/workflow"
@@ -47,6 +49,28 @@ type Package struct {
 
 	// Identifier of the link where the iterator must start processing.
 	startAtLinkID uuid.UUID
+
+	// Priority of the package in the processing queue.
+	priority int32
+
+	// Persisted iterator state used to resume processing after a restart, it
+	// is nil for new packages.
+	resumeState *iteratorState
+
+	// cancel stops the processing of the package, it is set when the package
+	// becomes active. Protected by the controller.
+	cancel context.CancelCauseFunc
+
+	// done is closed when the processing of the package ends.
+	done chan struct{}
+
+	// rejectOnCancel moves the package to the rejected directory once the
+	// processing is cancelled.
+	rejectOnCancel bool
+
+	// cancelErr is the outcome of the cancellation, it can be read once done
+	// is closed.
+	cancelErr error
 }
 
 func newPackage(logger logr.Logger, store store.Store, sharedDir string) *Package {
@@ -87,7 +111,8 @@ func NewPackage(ctx context.Context, logger logr.Logger, store store.Store, shar
 	return pkg, nil
 }
 
-// NewTransferPackage creates a new package after an API request.
+// NewTransferPackage creates a new package after an API request. The sources
+// of the transfer are copied later by the controller, see ingestTransfer.
 //
 //  1. Create Package (Transfer).
 //     transfer = models.Transfer.objects.create(**kwargs)
@@ -113,7 +138,6 @@ func NewTransferPackage(
 	store store.Store,
 	sharedDir string,
 	req *adminv1.CreatePackageRequest,
-	queue func(pkg *Package),
 ) (*Package, error) {
 	pkg := newPackage(logger, store, sharedDir)
 	pkg.id = uuid.New()
@@ -144,50 +168,35 @@ func NewTransferPackage(
 		return nil, err
 	}
 
-	// TODO: copy of transfer needs to happen asynchronously because we can't
-	// block the user request. This should however be done within workflow for
-	// better tracking.
-	//
-	// Perhaps for now we can have a pool to cap the total amount of work that
-	// we are willing to do at any given time, i.e. to be nice with Storage.
-	go func() (err error) {
-		logger := logger
-
-		defer func() {
-			if err != nil {
-				logger.Info("Oopsie!", "err", err)
-			} else {
-				logger.Info("Done!")
-			}
-		}()
-
-		// Create temporary directory.
-		tmpDir, err := os.MkdirTemp(filepath.Join(sharedDir, "tmp"), "")
-		if err != nil {
-			return err
-		}
-		_ = os.Chmod(tmpDir, os.FileMode(0o770))
-		logger = logger.WithValues("tmpDir", tmpDir)
-
-		// Copy into the processing directory.
-		path, err := copyTransfer(sharedDir, tmpDir, req.Name, req.Path[0])
-		if err != nil {
-			return fmt.Errorf("copy transfer: %v", err)
-		}
-		pkg.UpdatePath(path)
-		logger = logger.WithValues("path", path)
-		if err := store.UpdateTransferLocation(ctx, pkg.id, path); err != nil {
-			logger.Info("Unable to update the transfer location.", "id", pkg.id, "path", path, "err", err)
-		}
+	return pkg, nil
+}
 
-		logger.V(2).Info("Transfer ready for processing.")
+// ingestTransfer copies the sources of a new transfer into the processing
+// directory and updates the location of the transfer, see resolveSources. The
+// sources are measured unless their size is given.
+func (p *Package) ingestTransfer(ctx context.Context, name string, sources []source, size *sourcesSize, progress *ingestProgress) error {
+	// Create temporary directory.
+	tmpDir, err := newIngestDir(p.sharedDir, progress)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
 
-		queue(pkg) // Start work.
+	if err := progress.measure(ctx, sources, size); err != nil {
+		return fmt.Errorf("measure sources: %v", err)
+	}
 
-		return nil
-	}() // nolint:errcheck
+	// Copy into the processing directory.
+	path, err := copyTransfer(ctx, p.sharedDir, tmpDir, name, sources, progress)
+	if err != nil {
+		return fmt.Errorf("copy transfer: %v", err)
+	}
+	p.UpdatePath(path)
+	if err := p.store.UpdateTransferLocation(ctx, p.id, path); err != nil {
+		p.logger.Info("Unable to update the transfer location.", "id", p.id, "path", path, "err", err)
+	}
 
-	return pkg, nil
+	return nil
 }
 
 // ID returns the identifier of the package.
@@ -360,6 +369,40 @@ func (p *Package) markAsFailed(ctx context.Context) error {
 	return p.store.UpdatePackageStatus(ctx, p.id, p.packageType(), enums.PackageStatusFailed)
 }
 
+// moveToRejected moves the package into the rejected directory.
+func (p *Package) moveToRejected(ctx context.Context) error {
+	src := filepath.Clean(p.Path())
+	fi, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("move to rejected: %v", err)
+	}
+
+	dest := filepath.Join(p.sharedDir, "rejected", filepath.Base(src))
+	if err := os.Rename(src, dest); err != nil {
+		return fmt.Errorf("move to rejected: %v", err)
+	}
+
+	if fi.IsDir() {
+		p.UpdatePath(joinPath(dest, ""))
+	} else {
+		p.UpdatePath(dest)
+	}
+
+	switch p.packageType() {
+	case enums.PackageTypeTransfer:
+		err = p.store.UpdateTransferLocation(ctx, p.id, p.PathForDB())
+	case enums.PackageTypeSIP:
+		_, err = p.store.UpsertSIP(ctx, p.id, p.PathForDB())
+	case enums.PackageTypeDIP:
+		_, err = p.store.UpsertDIP(ctx, p.id, p.PathForDB())
+	}
+	if err != nil {
+		return fmt.Errorf("move to rejected: %v", err)
+	}
+
+	return nil
+}
+
 // updateActiveAgent saves the activeAgent variable using the user information
 // that is provided in the context.
 func (p *Package) updateActiveAgent(ctx context.Context) error {
@@ -724,7 +767,6 @@ func (rm replacementMapping) with(input replacementMapping) replacementMapping {
 }
 
 func (rm replacementMapping) replaceValues(input string) string {
-	const defaultSharedDir = "/var/archivematica/sharedDirectory"
 	if input == "" {
 		return ""
 	}
@@ -752,25 +794,75 @@ func (rm replacementMapping) replaceValues(input string) string {
 	return input
 }
 
-// TODO: stop assuming we have a dir.
-func copyTransfer(sharedDir, tmpDir, name, path string) (string, error) {
-	base := filepath.Base(path)
-	dest := filepath.Join(tmpDir, base)
-
-	if err := copy.Copy(path, dest, copy.Options{
-		Sync: true,
-	}); err != nil {
+// copyTransfer copies the sources of a transfer into the processing directory
+// and returns the path of the transfer.
+//
+// A single source becomes the transfer itself. Multiple sources are merged
+// into the transfer directory under their names, colliding names are given a
+// numeric suffix in the order of the sources, e.g. "objects" and "objects-1".
+//
+// The copy is interrupted when the context is done. The progress is updated
+// unless it is nil.
+func copyTransfer(ctx context.Context, sharedDir, tmpDir, name string, sources []source, progress *ingestProgress) (string, error) {
+	dest, err := copySources(ctx, tmpDir, sources, progress)
+	if err != nil {
 		return "", err
 	}
 
 	return move(
 		dest,
 		filepath.Join(sharedDir, "currentlyProcessing", name),
+		progress.use,
 	)
 }
 
+// copySources copies the sources into tmpDir and returns the path of the
+// copy: the copy of the source when there is only one, or a directory with
+// the copies of all the sources otherwise.
+func copySources(ctx context.Context, tmpDir string, sources []source, progress *ingestProgress) (string, error) {
+	opts := copy.Options{
+		Sync: true,
+		Skip: func(os.FileInfo, string, string) (bool, error) {
+			return false, ctx.Err()
+		},
+	}
+	var wrap func(io.Reader) io.Reader
+	if progress != nil {
+		wrap = progress.wrapReader
+		opts.WrapReader = wrap
+	}
+	copySource := func(src source, dest string) error {
+		if src.location == nil {
+			return copy.Copy(src.path, dest, opts)
+		}
+		return storage.Download(ctx, src.location, src.path, dest, wrap)
+	}
+
+	if len(sources) == 1 {
+		dest := filepath.Join(tmpDir, sources[0].name)
+		if err := copySource(sources[0], dest); err != nil {
+			return "", err
+		}
+		return dest, nil
+	}
+
+	dest := filepath.Join(tmpDir, "transfer")
+	if err := os.Mkdir(dest, os.FileMode(0o770)); err != nil {
+		return "", err
+	}
+	taken := make(map[string]struct{}, len(sources))
+	for _, src := range sources {
+		base := uniqueName(taken, src.name)
+		if err := copySource(src, filepath.Join(dest, base)); err != nil {
+			return "", err
+		}
+	}
+
+	return dest, nil
+}
+
 // move a directory.
-func move(src, dst string) (_ string, err error) {
+func move(src, dst string, reserve func(path string)) (_ string, err error) {
 	defer derrors.Add(&err, "move(%s, %s)", src, dst)
 
 	if _, err := os.Stat(src); os.IsNotExist(err) {
@@ -783,6 +875,9 @@ func move(src, dst string) (_ string, err error) {
 	)
 	for {
 		if _, err := os.Stat(newPath); os.IsNotExist(err) {
+			if reserve != nil {
+				reserve(newPath)
+			}
 			if err := os.Rename(src, newPath); os.IsExist(err) {
 				goto incr // Retry with incremented path
 			} else if err != nil {
@@ -1,9 +1,13 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
 )
 
 func TestReplacements(t *testing.T) {
@@ -30,4 +34,79 @@ func TestReplacements(t *testing.T) {
 
 func TestCopyTransfer(t *testing.T) {
 	t.Parallel()
+
+	newSources := func(t *testing.T) *fs.Dir {
+		t.Helper()
+
+		return fs.NewDir(t, "ccp",
+			fs.WithDir("a",
+				fs.WithDir("objects", fs.WithFile("image.jpg", "a")),
+			),
+			fs.WithDir("b",
+				fs.WithDir("objects", fs.WithFile("image.jpg", "b")),
+				fs.WithFile("image.jpg", "c"),
+			),
+		)
+	}
+
+	t.Run("Copies a single source", func(t *testing.T) {
+		t.Parallel()
+
+		src := newSources(t)
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("currentlyProcessing"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), t.TempDir(), "Images", localSources(src.Join("a")), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Images"))
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithDir("objects", fs.WithFile("image.jpg", "a")),
+		)))
+	})
+
+	t.Run("Downloads sources from remote locations", func(t *testing.T) {
+		t.Parallel()
+
+		src := newSources(t)
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("currentlyProcessing"))
+		loc := remoteLocation{storage.NewLocal(src.Path())}
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), t.TempDir(), "Images", []source{
+			{location: loc, path: "b", name: "b"},
+			{location: loc, path: "a/objects/image.jpg", name: "image.jpg"},
+		}, nil)
+		assert.NilError(t, err)
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithDir("b",
+				fs.MatchAnyFileMode,
+				fs.WithDir("objects", fs.MatchAnyFileMode, fs.WithFile("image.jpg", "b", fs.MatchAnyFileMode)),
+				fs.WithFile("image.jpg", "c", fs.MatchAnyFileMode),
+			),
+			fs.WithFile("image.jpg", "a", fs.MatchAnyFileMode),
+		)))
+	})
+
+	t.Run("Merges multiple sources", func(t *testing.T) {
+		t.Parallel()
+
+		src := newSources(t)
+		sharedDir := fs.NewDir(t, "ccp", fs.WithDir("currentlyProcessing"))
+
+		path, err := copyTransfer(context.Background(), sharedDir.Path(), t.TempDir(), "Images", localSources(
+			src.Join("a", "objects"),
+			src.Join("b", "objects"),
+			src.Join("b", "image.jpg"),
+			src.Join("a", "objects", "image.jpg"),
+		), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, path, sharedDir.Join("currentlyProcessing", "Images"))
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.MatchAnyFileMode,
+			fs.WithDir("objects", fs.WithFile("image.jpg", "a")),
+			fs.WithDir("objects-1", fs.WithFile("image.jpg", "b")),
+			fs.WithFile("image.jpg", "c"),
+			fs.WithFile("image-1.jpg", "a"),
+		)))
+	})
 }
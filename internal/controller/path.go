@@ -1,13 +1,23 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
 )
 
+// ErrInvalidSource is returned when a transfer source cannot be used, e.g.
+// because it does not exist.
+var ErrInvalidSource = errors.New("invalid transfer source")
+
 // uuidFromPath returns the UUID if it's the suffix of the path.
 func uuidFromPath(path string) uuid.UUID {
 	path = strings.TrimRight(path, string(os.PathSeparator))
@@ -57,6 +67,103 @@ func locationPath(locationPath string) (id uuid.UUID, path string) {
 	return id, path
 }
 
+// source is a transfer source resolved by resolveSources.
+type source struct {
+	// location holding the source, it is nil for local paths.
+	location storage.Location
+
+	// path of the source: absolute for local paths or relative to the
+	// location.
+	path string
+
+	// name given to the source within the transfer.
+	name string
+}
+
+// resolveSources returns the sources of a transfer. Sources are given as
+// <location-uuid>:<path>, where the path is relative to the storage location,
+// or as absolute paths. Sources of local locations are resolved to local
+// paths. All sources must exist.
+func resolveSources(ctx context.Context, locations *storage.Registry, sources []string) ([]source, error) {
+	ret := make([]source, 0, len(sources))
+	seen := make(map[string]struct{}, len(sources))
+
+	for _, item := range sources {
+		var (
+			src source
+			key string
+		)
+
+		id, rest := locationPath(item)
+		if id == uuid.Nil {
+			if !filepath.IsAbs(item) { // Colons are allowed in local paths.
+				return nil, fmt.Errorf("%w %q: path is not absolute", ErrInvalidSource, item)
+			}
+			src.path = filepath.Clean(item)
+		} else {
+			loc, ok := locations.Location(id)
+			if !ok {
+				return nil, fmt.Errorf("%w %q: unknown location %s", ErrInvalidSource, item, id)
+			}
+			rel, err := storage.CleanPath(rest)
+			if err != nil {
+				return nil, fmt.Errorf("%w %q: path is outside of the location", ErrInvalidSource, item)
+			}
+			if local, ok := loc.(*storage.Local); ok {
+				if src.path, err = local.LocalPath(rel); err != nil {
+					return nil, fmt.Errorf("%w %q: %v", ErrInvalidSource, item, err)
+				}
+			} else {
+				src.location, src.path = loc, rel
+				src.name = path.Base(rel)
+				if rel == "" {
+					src.name = locations.Name(id)
+				}
+				key = id.String() + ":" + rel
+			}
+		}
+
+		if src.location == nil {
+			if _, err := os.Stat(src.path); err != nil {
+				return nil, fmt.Errorf("%w %q: %v", ErrInvalidSource, item, err)
+			}
+			src.name = filepath.Base(src.path)
+			key = src.path
+		} else if _, err := src.location.Stat(ctx, src.path); err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidSource, item, err)
+		}
+
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w %q: path is listed more than once", ErrInvalidSource, item)
+		}
+		seen[key] = struct{}{}
+
+		ret = append(ret, src)
+	}
+
+	return ret, nil
+}
+
+// uniqueName returns the name, or the name with the lowest numeric suffix that
+// is not taken yet, e.g. "image-1.jpg" when "image.jpg" is taken. The name
+// returned is added to taken.
+func uniqueName(taken map[string]struct{}, name string) string {
+	ret := name
+	ext := filepath.Ext(name)
+	if ext == name {
+		ext = "" // Dotfiles, e.g. ".metadata".
+	}
+	for i := 1; ; i++ {
+		if _, ok := taken[ret]; !ok {
+			break
+		}
+		ret = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), i, ext)
+	}
+	taken[ret] = struct{}{}
+
+	return ret
+}
+
 func isDir(path string) bool { //nolint: unused
 	info, err := os.Stat(path)
 	if err != nil {
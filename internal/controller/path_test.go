@@ -1,12 +1,33 @@
 package controller
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/storage"
 )
 
+// remoteLocation hides the local implementation of the location so it is
+// accessed like a remote location.
+type remoteLocation struct {
+	storage.Location
+}
+
+// localSources returns the sources of the given local paths.
+func localSources(paths ...string) []source {
+	sources := make([]source, 0, len(paths))
+	for _, p := range paths {
+		sources = append(sources, source{path: p, name: filepath.Base(p)})
+	}
+
+	return sources
+}
+
 func TestUUIDFromPath(t *testing.T) {
 	t.Parallel()
 
@@ -119,3 +140,111 @@ func TestLocationPath(t *testing.T) {
 		assert.Equal(t, path, tc.path)
 	}
 }
+
+func TestResolveSources(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := fs.NewDir(t, "ccp",
+		fs.WithDir("home",
+			fs.WithDir("images"),
+			fs.WithFile("notes.txt", ""),
+		),
+	)
+	home := tmpDir.Join("home")
+	locationID := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+	remoteID := uuid.MustParse("7d1f0d45-0bb5-4ab5-8e5b-8bd0b8c5ad5e")
+	locations, err := storage.NewRegistry(storage.Config{})
+	assert.NilError(t, err)
+	assert.NilError(t, locations.Register(locationID, "", storage.NewLocal(home)))
+	assert.NilError(t, locations.Register(remoteID, "home", remoteLocation{storage.NewLocal(home)}))
+
+	tests := map[string]struct {
+		sources []string
+		want    []string
+		wantErr string
+	}{
+		"Resolves remote location paths": {
+			sources: []string{
+				remoteID.String() + ":/images/",
+				remoteID.String() + ":",
+			},
+			want: []string{"remote:images", "remote:"},
+		},
+		"Rejects missing remote paths": {
+			sources: []string{remoteID.String() + ":/audio"},
+			wantErr: `invalid transfer source "7d1f0d45-0bb5-4ab5-8e5b-8bd0b8c5ad5e:/audio"`,
+		},
+		"Resolves location paths": {
+			sources: []string{
+				locationID.String() + ":/images",
+				locationID.String() + ":notes.txt",
+			},
+			want: []string{
+				filepath.Join(home, "images"),
+				filepath.Join(home, "notes.txt"),
+			},
+		},
+		"Resolves the root of a location": {
+			sources: []string{locationID.String() + ":/"},
+			want:    []string{home},
+		},
+		"Resolves local paths": {
+			sources: []string{filepath.Join(home, "images") + "/"},
+			want:    []string{filepath.Join(home, "images")},
+		},
+		"Rejects unknown locations": {
+			sources: []string{"fb3c2a8a-4ff4-4a4c-a5a0-9a0c8d4c4f51:/images"},
+			wantErr: `invalid transfer source "fb3c2a8a-4ff4-4a4c-a5a0-9a0c8d4c4f51:/images": unknown location fb3c2a8a-4ff4-4a4c-a5a0-9a0c8d4c4f51`,
+		},
+		"Rejects paths outside of the location": {
+			sources: []string{locationID.String() + ":../../etc"},
+			wantErr: `invalid transfer source "c059a454-dafa-418e-a126-74d0c7219ce6:../../etc": path is outside of the location`,
+		},
+		"Rejects relative paths": {
+			sources: []string{"images"},
+			wantErr: `invalid transfer source "images": path is not absolute`,
+		},
+		"Rejects missing paths": {
+			sources: []string{locationID.String() + ":/audio"},
+			wantErr: `invalid transfer source "c059a454-dafa-418e-a126-74d0c7219ce6:/audio"`,
+		},
+		"Rejects duplicated paths": {
+			sources: []string{locationID.String() + ":/images", filepath.Join(home, "images")},
+			wantErr: "path is listed more than once",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveSources(context.Background(), locations, tc.sources)
+			if tc.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidSource)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			paths := make([]string, 0, len(got))
+			for _, src := range got {
+				if src.location != nil {
+					paths = append(paths, "remote:"+src.path)
+				} else {
+					paths = append(paths, src.path)
+				}
+			}
+			assert.DeepEqual(t, paths, tc.want)
+		})
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	t.Parallel()
+
+	taken := map[string]struct{}{}
+	got := []string{}
+	for _, name := range []string{"image.jpg", "image.jpg", "objects", "image.jpg", "objects", ".metadata", ".metadata"} {
+		got = append(got, uniqueName(taken, name))
+	}
+
+	assert.DeepEqual(t, got, []string{"image.jpg", "image-1.jpg", "objects", "image-2.jpg", "objects-1", ".metadata", ".metadata-1"})
+}
@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/authn"
+	"github.com/google/uuid"
+	"github.com/otiai10/copy"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+)
+
+// ApproveAIPReingestChainID is the chain that follows the approval of the
+// partial reingest of an AIP placed in the reingestAIP watched directory.
+var ApproveAIPReingestChainID = uuid.MustParse("260ef4ea-f87d-4acf-830d-d0de41e6d2af")
+
+// ErrUnknownProcessingConfig is returned when the processing configuration
+// requested does not exist.
+var ErrUnknownProcessingConfig = errors.New("unknown processing configuration")
+
+// Reingest starts the reingest of the AIP found at the given path of a
+// storage location, see resolveSources. The name of the AIP must end with its
+// identifier.
+//
+// Full reingests submit a new standard transfer with the contents of the AIP
+// and return the identifier of the transfer. Partial and metadata-only
+// reingests copy the AIP into the reingestAIP watched directory and process it
+// as a SIP with the identifier of the AIP, starting at the chain that follows
+// the approval of the reingest.
+//
+// It returns ErrDraining when the controller is draining, ErrInvalidSource
+// when the AIP cannot be used, ErrInsufficientSpace when the AIP would cross
+// the low-water mark of the shared directory and ErrUnknownProcessingConfig
+// when the processing configuration does not exist.
+func (c *Controller) Reingest(ctx context.Context, req *adminv1.StartReingestRequest) (uuid.UUID, error) {
+	if c.isDraining() {
+		return uuid.Nil, ErrDraining
+	}
+
+	sources, err := resolveSources(ctx, c.config.Locations, []string{req.Path})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	src := sources[0]
+
+	aipID := uuidFromPath(src.name)
+	if aipID == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("%w %q: the name of the AIP does not end with its identifier", ErrInvalidSource, req.Path)
+	}
+
+	size, err := c.admitTransfer(ctx, sources)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	config := req.ProcessingConfig
+	if config == "" {
+		config = "default"
+	}
+	configPath := filepath.Join(c.sharedDir, "sharedMicroServiceTasksConfigs", "processingMCPConfigs", config+"ProcessingMCP.xml")
+	if _, err := os.Stat(configPath); err != nil {
+		return uuid.Nil, fmt.Errorf("%w %q", ErrUnknownProcessingConfig, config)
+	}
+
+	if req.Mode == adminv1.ReingestMode_REINGEST_MODE_FULL {
+		pkg, err := c.submit(ctx, &adminv1.CreatePackageRequest{
+			Name:             strings.TrimSuffix(strings.TrimSuffix(src.name, aipID.String()), "-"),
+			Type:             adminv1.TransferType_TRANSFER_TYPE_STANDARD,
+			ProcessingConfig: config,
+		}, sources, size)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return pkg.id, nil
+	}
+
+	// ctx is request-scoped, use the group context instead.
+	ctx = authn.SetInfo(c.groupCtx, authn.GetInfo(ctx)) //nolint: contextcheck
+
+	pkg, err := c.newReingestPackage(ctx, aipID, src.name)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create package: %v", err)
+	}
+
+	c.ingest(ctx, pkg, 0, func(progress *ingestProgress) error {
+		return pkg.ingestAIP(ctx, src, req.Mode, configPath, size, progress)
+	})
+
+	return aipID, nil
+}
+
+// newReingestPackage creates the SIP of a partial or metadata-only reingest.
+// The path of the SIP in the reingestAIP watched directory is claimed so the
+// watchers do not identify it again.
+func (c *Controller) newReingestPackage(ctx context.Context, id uuid.UUID, name string) (*Package, error) {
+	pkg := newPackage(c.logger.WithName("package").WithValues("id", id, "reingest", true), c.store, c.sharedDir)
+	pkg.id = id
+	pkg.unit = &SIP{pkg: pkg}
+	pkg.startAtChainID = ApproveAIPReingestChainID
+
+	path := filepath.Join(c.watchedDir, "system", "reingestAIP", name)
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%w: %s is already being reingested", ErrInvalidSource, name)
+	}
+	c.claims.claim(path)
+	pkg.UpdatePath(joinPath(path, ""))
+
+	if _, err := c.store.UpsertSIP(ctx, id, pkg.PathForDB()); err != nil {
+		c.claims.release(path)
+		return nil, err
+	}
+	if err := pkg.saveValue(ctx, "isPartialReingest", "true"); err != nil {
+		c.claims.release(path)
+		return nil, err
+	}
+	if err := pkg.updateActiveAgent(ctx); err != nil {
+		c.claims.release(path)
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// ingestAIP copies the AIP of a partial or metadata-only reingest into the
+// reingestAIP watched directory with the processing configuration requested.
+// Metadata-only reingests leave out the original objects of the AIP.
+func (p *Package) ingestAIP(ctx context.Context, src source, mode adminv1.ReingestMode, configPath string, size *sourcesSize, progress *ingestProgress) error {
+	tmpDir, err := newIngestDir(p.sharedDir, progress)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := progress.measure(ctx, []source{src}, size); err != nil {
+		return fmt.Errorf("measure AIP: %v", err)
+	}
+
+	path, err := copySources(ctx, tmpDir, []source{src}, progress)
+	if err != nil {
+		return fmt.Errorf("copy AIP: %v", err)
+	}
+	if mode == adminv1.ReingestMode_REINGEST_MODE_METADATA_ONLY {
+		if err := removeObjects(path); err != nil {
+			return fmt.Errorf("remove objects: %v", err)
+		}
+	}
+	if err := copy.Copy(configPath, filepath.Join(path, "processingMCP.xml")); err != nil {
+		return fmt.Errorf("copy processing configuration: %v", err)
+	}
+
+	if err := os.Rename(path, strings.TrimSuffix(p.Path(), "/")); err != nil {
+		return fmt.Errorf("move AIP: %v", err)
+	}
+
+	return nil
+}
+
+// removeObjects removes the original objects of the AIP at the given path,
+// keeping the metadata and the submission documentation.
+func removeObjects(path string) error {
+	dir := filepath.Join(path, "data", "objects")
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if name := entry.Name(); name == "metadata" || name == "submissionDocumentation" {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
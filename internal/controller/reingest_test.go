@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/storage"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerReingest(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	aipID := uuid.MustParse("4f6a1e5e-1a41-4a5b-9d2e-2c3f7c3ad5b0")
+	aipName := "images-" + aipID.String()
+	locationID := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+
+	newController := func(t *testing.T) (*Controller, *storemock.MockStore) {
+		t.Helper()
+
+		aips := fs.NewDir(t, "ccp",
+			fs.WithDir(aipName,
+				fs.WithFile("bagit.txt", ""),
+				fs.WithDir("data",
+					fs.WithFile("METS."+aipID.String()+".xml", "<mets/>"),
+					fs.WithDir("objects",
+						fs.WithFile("image.jpg", "12345"),
+						fs.WithDir("metadata", fs.WithFile("metadata.csv", "")),
+					),
+				),
+			),
+			fs.WithDir("images"),
+		)
+		locations, err := storage.NewRegistry(storage.Config{})
+		assert.NilError(t, err)
+		assert.NilError(t, locations.Register(locationID, "", storage.NewLocal(aips.Path())))
+
+		sharedDir := fs.NewDir(t, "ccp",
+			fs.WithDir("tmp"),
+			fs.WithDir("currentlyProcessing"),
+			fs.WithDir("watchedDirectories", fs.WithDir("system", fs.WithDir("reingestAIP"))),
+			fs.WithDir("sharedMicroServiceTasksConfigs", fs.WithDir("processingMCPConfigs",
+				fs.WithFile("defaultProcessingMCP.xml", "<processingMCP/>"),
+			)),
+		)
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{Locations: locations}, sharedDir.Path(), sharedDir.Join("watchedDirectories"))
+
+		return c, s
+	}
+
+	t.Run("Rejects invalid requests", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newController(t)
+
+		_, err := c.Reingest(context.Background(), &adminv1.StartReingestRequest{
+			Path: locationID.String() + ":images",
+			Mode: adminv1.ReingestMode_REINGEST_MODE_PARTIAL,
+		})
+		assert.ErrorIs(t, err, ErrInvalidSource)
+		assert.ErrorContains(t, err, "the name of the AIP does not end with its identifier")
+
+		_, err = c.Reingest(context.Background(), &adminv1.StartReingestRequest{
+			Path:             locationID.String() + ":" + aipName,
+			Mode:             adminv1.ReingestMode_REINGEST_MODE_PARTIAL,
+			ProcessingConfig: "automated",
+		})
+		assert.ErrorIs(t, err, ErrUnknownProcessingConfig)
+
+		c.Drain()
+		_, err = c.Reingest(context.Background(), &adminv1.StartReingestRequest{
+			Path: locationID.String() + ":" + aipName,
+			Mode: adminv1.ReingestMode_REINGEST_MODE_FULL,
+		})
+		assert.ErrorIs(t, err, ErrDraining)
+	})
+
+	t.Run("Places metadata-only reingests in the watched directory", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		path := filepath.Join(c.watchedDir, "system", "reingestAIP", aipName)
+
+		s.EXPECT().UpsertSIP(gomock.Any(), aipID, "%sharedPath%watchedDirectories/system/reingestAIP/"+aipName+"/").Return(true, nil)
+		s.EXPECT().CreateUnitVar(gomock.Any(), aipID, enums.PackageTypeSIP, "isPartialReingest", "true", uuid.Nil, true).Return(nil)
+
+		pkg, err := c.newReingestPackage(context.Background(), aipID, aipName)
+		assert.NilError(t, err)
+		assert.Equal(t, pkg.startAtChainID, ApproveAIPReingestChainID)
+		assert.Equal(t, pkg.Path(), path+"/")
+		assert.Assert(t, c.claims.claimed(path))
+
+		sources, err := resolveSources(context.Background(), c.config.Locations, []string{locationID.String() + ":" + aipName})
+		assert.NilError(t, err)
+		err = pkg.ingestAIP(context.Background(), sources[0], adminv1.ReingestMode_REINGEST_MODE_METADATA_ONLY, filepath.Join(c.sharedDir, "sharedMicroServiceTasksConfigs", "processingMCPConfigs", "defaultProcessingMCP.xml"), nil, &ingestProgress{})
+		assert.NilError(t, err)
+
+		assert.Assert(t, fs.Equal(path, fs.Expected(t,
+			fs.WithFile("bagit.txt", ""),
+			fs.WithFile("processingMCP.xml", "<processingMCP/>"),
+			fs.WithDir("data",
+				fs.WithFile("METS."+aipID.String()+".xml", "<mets/>"),
+				fs.WithDir("objects",
+					fs.WithDir("metadata", fs.WithFile("metadata.csv", "")),
+				),
+			),
+			fs.MatchAnyFileMode,
+		)))
+
+		// The AIP is already being reingested.
+		_, err = c.newReingestPackage(context.Background(), aipID, aipName)
+		assert.ErrorIs(t, err, ErrInvalidSource)
+	})
+}
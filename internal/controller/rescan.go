@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// claims tracks the paths found in the watched directories that are already
+// claimed by a package, so a path reported more than once, e.g. by both the
+// filesystem watcher and a rescan, is processed only once.
+type claims struct {
+	paths map[string]struct{}
+	mu    sync.Mutex
+}
+
+func newClaims() *claims {
+	return &claims{paths: map[string]struct{}{}}
+}
+
+// claim claims the given path. It returns false when the path was already
+// claimed.
+func (c *claims) claim(path string) bool {
+	path = filepath.Clean(path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.paths[path]; ok {
+		return false
+	}
+	c.paths[path] = struct{}{}
+
+	return true
+}
+
+func (c *claims) claimed(path string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.paths[filepath.Clean(path)]
+	return ok
+}
+
+func (c *claims) release(path string) {
+	c.mu.Lock()
+	delete(c.paths, filepath.Clean(path))
+	c.mu.Unlock()
+}
+
+// prune releases the claimed paths that no longer exist, e.g. because the
+// packages were moved out of the watched directories while the filesystem
+// events were missed.
+func (c *claims) prune() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for path := range c.paths {
+		if _, err := os.Lstat(path); errors.Is(err, fs.ErrNotExist) {
+			delete(c.paths, path)
+		}
+	}
+}
+
+// Release releases the claim on a path that was moved or removed from a
+// watched directory, so a package that is placed again under the same path is
+// processed again.
+func (c *Controller) Release(path string) {
+	c.claims.release(path)
+}
+
+// Claimed reports whether the given path of a watched directory is claimed by
+// a package.
+func (c *Controller) Claimed(path string) bool {
+	return c.claims.claimed(path)
+}
+
+// Rescan notifies the controller of every entry found in the watched
+// directories that is not claimed by a package yet, e.g. the packages placed
+// while the application was not running or the filesystem events that were
+// missed. It returns the number of packages identified.
+func (c *Controller) Rescan() (int, error) {
+	c.claims.prune()
+
+	var (
+		n    int
+		errs error
+	)
+	for _, wd := range c.wf.WatchedDirectories {
+		entries, err := os.ReadDir(filepath.Join(c.watchedDir, wd.Path))
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for _, entry := range entries {
+			if wd.OnlyDirs && !entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(c.watchedDir, wd.Path, entry.Name())
+			if c.claims.claimed(path) {
+				continue
+			}
+			c.logger.V(1).Info("Found unclaimed package.", "path", path)
+			if err := c.Notify(path); err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			n++
+		}
+	}
+
+	return n, errs
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestClaims(t *testing.T) {
+	t.Parallel()
+
+	dir := fs.NewDir(t, "ccp", fs.WithDir("Images"))
+	c := newClaims()
+
+	assert.Assert(t, c.claim(dir.Join("Images")))
+	assert.Assert(t, !c.claim(dir.Join("Images")+"/"))
+	assert.Assert(t, c.claimed(dir.Join("Images")))
+
+	c.release(dir.Join("Images"))
+	assert.Assert(t, !c.claimed(dir.Join("Images")))
+
+	// Missing paths are pruned.
+	assert.Assert(t, c.claim(dir.Join("Images")))
+	assert.Assert(t, c.claim(dir.Join("Audio")))
+	c.prune()
+	assert.Assert(t, c.claimed(dir.Join("Images")))
+	assert.Assert(t, !c.claimed(dir.Join("Audio")))
+}
+
+func TestControllerRescan(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	sharedDir := fs.NewDir(t, "ccp", fs.WithDir("watchedDirectories"))
+	watchedDir := sharedDir.Join("watchedDirectories")
+	for _, wd := range wf.WatchedDirectories {
+		assert.NilError(t, os.MkdirAll(filepath.Join(watchedDir, wd.Path), os.FileMode(0o700)))
+	}
+	transfersDir := filepath.Join(watchedDir, "activeTransfers", "standardTransfer")
+	assert.NilError(t, os.Mkdir(filepath.Join(transfersDir, "Images"), os.FileMode(0o700)))
+	assert.NilError(t, os.Mkdir(filepath.Join(transfersDir, "Audio"), os.FileMode(0o700)))
+
+	s := storemock.NewMockStore(gomock.NewController(t))
+	c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{}, sharedDir.Path(), watchedDir)
+	c.Drain() // Keeps the packages out of the queue.
+
+	// Audio was reported by the filesystem watcher already.
+	audioID := uuid.New()
+	s.EXPECT().EnsureTransfer(gomock.Any(), "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/Audio/").Return(audioID, true, nil)
+	s.EXPECT().CreateUnitVar(gomock.Any(), audioID, enums.PackageTypeTransfer, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+	assert.NilError(t, c.Notify(filepath.Join(transfersDir, "Audio")))
+
+	imagesID := uuid.New()
+	s.EXPECT().EnsureTransfer(gomock.Any(), "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/Images/").Return(imagesID, true, nil)
+	s.EXPECT().CreateUnitVar(gomock.Any(), imagesID, enums.PackageTypeTransfer, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+
+	n, err := c.Rescan()
+	assert.NilError(t, err)
+	assert.Equal(t, n, 1)
+
+	// Nothing is identified twice.
+	n, err = c.Rescan()
+	assert.NilError(t, err)
+	assert.Equal(t, n, 0)
+	assert.NilError(t, c.Notify(filepath.Join(transfersDir, "Images")))
+
+	// Released paths are identified again.
+	c.Release(filepath.Join(transfersDir, "Images"))
+	s.EXPECT().EnsureTransfer(gomock.Any(), "%sharedPath%watchedDirectories/activeTransfers/standardTransfer/Images/").Return(imagesID, false, nil)
+	s.EXPECT().CreateUnitVar(gomock.Any(), imagesID, enums.PackageTypeTransfer, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+
+	n, err = c.Rescan()
+	assert.NilError(t, err)
+	assert.Equal(t, n, 1)
+}
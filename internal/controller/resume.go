@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/artefactual-labs/ccp/internal/derrors"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+// iteratorStateVar is the name of the package variable where the state of the
+// iterator is persisted.
+const iteratorStateVar = "iteratorState"
+
+// iteratorState is the state of a jobIterator that we persist as a package
+// variable so the processing of the package can be resumed from its last
+// completed link after the application is restarted.
+//
+// Packages awaiting a decision are resumed at the link that created the
+// decision, which means that the decision is created again.
+type iteratorState struct {
+	Path           string    `json:"path"`
+	Priority       int32     `json:"priority"`
+	StartAtChainID uuid.UUID `json:"start_at_chain_id"`
+	StartAtLinkID  uuid.UUID `json:"start_at_link_id"`
+
+	// ChainID is the current chain, it is nil when processing has not started.
+	ChainID uuid.UUID `json:"chain_id"`
+
+	// NextLinkID is the next link or chain to be processed, it is nil when
+	// processing has not started.
+	NextLinkID uuid.UUID `json:"next_link_id"`
+
+	// Context is the list of key-value pairs of the chain context.
+	Context [][2]string `json:"context"`
+}
+
+// validate reports why the state cannot be used to resume processing with the
+// given workflow. It returns an empty string when the state is valid.
+func (s *iteratorState) validate(wf *workflow.Document) string {
+	if s.NextLinkID == uuid.Nil {
+		// Processing has not started, the iterator starts at the beginning.
+		if _, ok := wf.Chains[s.StartAtChainID]; !ok {
+			return fmt.Sprintf("chain %s not found in workflow", s.StartAtChainID)
+		}
+		return ""
+	}
+
+	if _, ok := wf.Chains[s.NextLinkID]; ok {
+		return ""
+	}
+
+	if _, ok := wf.Links[s.NextLinkID]; !ok {
+		return fmt.Sprintf("link %s not found in workflow", s.NextLinkID)
+	}
+	if _, ok := wf.Chains[s.ChainID]; !ok {
+		return fmt.Sprintf("chain %s not found in workflow", s.ChainID)
+	}
+
+	return ""
+}
+
+func newIteratorState(pkg *Package, c *chain, nextLink uuid.UUID) *iteratorState {
+	state := &iteratorState{
+		Path:           pkg.PathForDB(),
+		Priority:       pkg.priority,
+		StartAtChainID: pkg.startAtChainID,
+		StartAtLinkID:  pkg.startAtLinkID,
+		NextLinkID:     nextLink,
+	}
+
+	if c != nil {
+		state.ChainID = c.wc.ID
+		state.Context = make([][2]string, 0, c.context.Len())
+		for el := c.context.Front(); el != nil; el = el.Next() {
+			state.Context = append(state.Context, [2]string{el.Key, el.Value})
+		}
+	}
+
+	return state
+}
+
+// saveIteratorState persists the state of the iterator.
+func (p *Package) saveIteratorState(ctx context.Context, state *iteratorState) error {
+	blob, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode iterator state: %v", err)
+	}
+
+	return p.saveValue(ctx, iteratorStateVar, string(blob))
+}
+
+// clearIteratorState removes the persisted iterator state, the package can no
+// longer be resumed.
+func (p *Package) clearIteratorState(ctx context.Context) error {
+	if err := p.store.DeleteUnitVar(ctx, p.id, p.packageType(), iteratorStateVar); err != nil {
+		return fmt.Errorf("clear iterator state: %v", err)
+	}
+	return nil
+}
+
+// readIteratorState reads the persisted iterator state of a package. It returns
+// a nil state when the package has no state.
+func readIteratorState(ctx context.Context, s store.Store, id uuid.UUID) (*iteratorState, error) {
+	vars, err := s.ReadUnitVars(ctx, id, "", iteratorStateVar)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range vars {
+		if item.Value == nil || *item.Value == "" {
+			continue
+		}
+		state := &iteratorState{}
+		if err := json.Unmarshal([]byte(*item.Value), state); err != nil {
+			return nil, fmt.Errorf("decode iterator state: %v", err)
+		}
+		return state, nil
+	}
+
+	return nil, nil
+}
+
+// ResumeResult describes the outcome of resuming a package that was left in
+// processing status by a previous run of the application.
+type ResumeResult struct {
+	ID      uuid.UUID
+	Type    enums.PackageType
+	Path    string
+	Resumed bool
+
+	// Reason explains why the package could not be resumed.
+	Reason string
+}
+
+// Resume looks up the packages left in processing status by a previous run of
+// the application and queues the ones that can be resumed from their last
+// completed link. Packages that cannot be resumed are marked as failed and
+// reported in the results.
+//
+// It must be called before the store removes its transient data, otherwise
+// the packages without a persisted state would not be reported, and before Run
+// so the resumed packages are picked by the scheduler.
+func (c *Controller) Resume(ctx context.Context) (_ []ResumeResult, err error) {
+	defer derrors.Add(&err, "Resume()")
+
+	pkgs, err := c.store.ListProcessingPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]ResumeResult, 0, len(pkgs))
+	for _, item := range pkgs {
+		res := ResumeResult{ID: item.ID, Type: item.Type, Path: item.Path}
+
+		pkg, reason, err := c.resumePackage(ctx, item)
+		if err != nil {
+			return nil, fmt.Errorf("resume package %s: %v", item.ID, err)
+		}
+
+		if pkg != nil {
+			res.Resumed = true
+			c.logger.Info("Package resumed.", "id", item.ID, "type", item.Type, "path", item.Path)
+		} else {
+			res.Reason = reason
+			c.logger.Info("Package cannot be resumed.", "id", item.ID, "type", item.Type, "path", item.Path, "reason", reason)
+		}
+
+		ret = append(ret, res)
+	}
+
+	return ret, nil
+}
+
+// resumePackage rebuilds and queues a package given its persisted state. It
+// returns a reason when the package cannot be resumed.
+func (c *Controller) resumePackage(ctx context.Context, item store.ProcessingPackage) (*Package, string, error) {
+	state, err := readIteratorState(ctx, c.store, item.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Without state, the package is left to RemoveTransientData.
+	if state == nil {
+		return nil, "processing state not found", nil
+	}
+
+	logger := c.logger.WithName("package").WithValues("id", item.ID, "resumed", true)
+	pkg := newPackage(logger, c.store, c.sharedDir)
+	pkg.id = item.ID
+	pkg.startAtChainID = state.StartAtChainID
+	pkg.startAtLinkID = state.StartAtLinkID
+	switch item.Type {
+	case enums.PackageTypeTransfer:
+		pkg.unit = &Transfer{pkg: pkg}
+	case enums.PackageTypeSIP:
+		pkg.unit = &SIP{pkg: pkg}
+	case enums.PackageTypeDIP:
+		pkg.unit = &DIP{pkg: pkg}
+	default:
+		return nil, "", fmt.Errorf("unknown package type %q", item.Type)
+	}
+
+	pkg.resumeState = state
+
+	path := state.Path
+	if path == "" {
+		path = item.Path
+	}
+	pkg.UpdatePath(path)
+
+	reason := state.validate(c.wf)
+	if reason == "" && pkg.path == "" {
+		reason = "package location is unknown"
+	}
+	if reason == "" {
+		if _, err := os.Stat(pkg.Path()); err != nil {
+			reason = fmt.Sprintf("package location is not available: %v", err)
+		}
+	}
+
+	if reason != "" {
+		if err := pkg.markAsFailed(ctx); err != nil {
+			return nil, "", err
+		}
+		if err := pkg.clearIteratorState(ctx); err != nil {
+			return nil, "", err
+		}
+		return nil, reason, nil
+	}
+
+	// Claimed so it is not identified again if it is in a watched directory.
+	c.claims.claim(pkg.Path())
+	if err := c.enqueue(pkg, state.Priority); err != nil {
+		return nil, "", err
+	}
+
+	return pkg, "", nil
+}
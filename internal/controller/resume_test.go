@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	var chainID uuid.UUID
+	for id := range wf.Chains {
+		chainID = id
+		break
+	}
+
+	encode := func(t *testing.T, state *iteratorState) *string {
+		t.Helper()
+		blob, err := json.Marshal(state)
+		assert.NilError(t, err)
+		value := string(blob)
+		return &value
+	}
+
+	t.Run("Queues the packages that can be resumed", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := fs.NewDir(t, "ccp", fs.WithDir("sharedDir/currentlyProcessing/transfer"))
+		sharedDir := tmpDir.Join("sharedDir")
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{}, sharedDir, "")
+
+		resumableID := uuid.New()
+		unknownID := uuid.New()
+		brokenID := uuid.New()
+
+		s.EXPECT().ListProcessingPackages(gomock.Any()).Return([]store.ProcessingPackage{
+			{ID: resumableID, Type: enums.PackageTypeTransfer, Path: "%sharedPath%currentlyProcessing/transfer/"},
+			{ID: unknownID, Type: enums.PackageTypeSIP, Path: "%sharedPath%currentlyProcessing/sip/"},
+			{ID: brokenID, Type: enums.PackageTypeDIP, Path: "%sharedPath%currentlyProcessing/dip/"},
+		}, nil)
+
+		s.EXPECT().ReadUnitVars(gomock.Any(), resumableID, enums.PackageType(""), iteratorStateVar).Return([]store.UnitVar{
+			{Name: iteratorStateVar, Value: encode(t, &iteratorState{
+				Path:           "%sharedPath%currentlyProcessing/transfer/",
+				Priority:       5,
+				StartAtChainID: chainID,
+			})},
+		}, nil)
+
+		s.EXPECT().ReadUnitVars(gomock.Any(), unknownID, enums.PackageType(""), iteratorStateVar).Return(nil, store.ErrNotFound)
+
+		s.EXPECT().ReadUnitVars(gomock.Any(), brokenID, enums.PackageType(""), iteratorStateVar).Return([]store.UnitVar{
+			{Name: iteratorStateVar, Value: encode(t, &iteratorState{
+				Path:           "%sharedPath%currentlyProcessing/dip/",
+				StartAtChainID: chainID,
+				ChainID:        chainID,
+				NextLinkID:     uuid.New(),
+			})},
+		}, nil)
+		s.EXPECT().UpdatePackageStatus(gomock.Any(), brokenID, enums.PackageTypeDIP, enums.PackageStatusFailed).Return(nil)
+		s.EXPECT().DeleteUnitVar(gomock.Any(), brokenID, enums.PackageTypeDIP, iteratorStateVar).Return(nil)
+
+		results, err := c.Resume(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, len(results), 3)
+
+		assert.Equal(t, results[0].ID, resumableID)
+		assert.Equal(t, results[0].Resumed, true)
+
+		assert.Equal(t, results[1].ID, unknownID)
+		assert.Equal(t, results[1].Resumed, false)
+		assert.Equal(t, results[1].Reason, "processing state not found")
+
+		assert.Equal(t, results[2].ID, brokenID)
+		assert.Equal(t, results[2].Resumed, false)
+		assert.Assert(t, cmp.Contains(results[2].Reason, "not found in workflow"))
+
+		assert.DeepEqual(t, queuedIDs(c.scheduler), []uuid.UUID{resumableID})
+		pkg := c.scheduler.queue[0].pkg
+		assert.Equal(t, pkg.priority, int32(5))
+		assert.Equal(t, pkg.Path(), tmpDir.Join("sharedDir/currentlyProcessing/transfer")+"/")
+		assert.Assert(t, pkg.resumeState != nil)
+	})
+}
+
+func TestIteratorState(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	var (
+		chainID uuid.UUID
+		linkID  uuid.UUID
+	)
+	for id, wc := range wf.Chains {
+		chainID, linkID = id, wc.LinkID
+		break
+	}
+
+	type test struct {
+		name   string
+		state  iteratorState
+		reason string
+	}
+	for _, tc := range []test{
+		{
+			name:  "Accepts a state that has not started",
+			state: iteratorState{StartAtChainID: chainID},
+		},
+		{
+			name:  "Accepts a state pointing to a link",
+			state: iteratorState{StartAtChainID: chainID, ChainID: chainID, NextLinkID: linkID},
+		},
+		{
+			name:  "Accepts a state pointing to a chain",
+			state: iteratorState{StartAtChainID: chainID, NextLinkID: chainID},
+		},
+		{
+			name:   "Rejects an unknown starting chain",
+			state:  iteratorState{StartAtChainID: uuid.Nil},
+			reason: "chain 00000000-0000-0000-0000-000000000000 not found in workflow",
+		},
+		{
+			name:   "Rejects an unknown link",
+			state:  iteratorState{StartAtChainID: chainID, ChainID: chainID, NextLinkID: uuid.MustParse("0bce5a4c-3a66-4a2b-b6f6-2a8f3f5ad6b1")},
+			reason: "link 0bce5a4c-3a66-4a2b-b6f6-2a8f3f5ad6b1 not found in workflow",
+		},
+		{
+			name:   "Rejects a link without chain",
+			state:  iteratorState{StartAtChainID: chainID, NextLinkID: linkID},
+			reason: "chain 00000000-0000-0000-0000-000000000000 not found in workflow",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.state.validate(wf), tc.reason)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reasons given for the selection of a retention candidate.
+const (
+	retentionReasonMaxAge  = "max-age"
+	retentionReasonMaxSize = "max-size"
+)
+
+// RetentionCandidate is an entry of the shared directory selected by a
+// retention policy.
+type RetentionCandidate struct {
+	// Path of the entry relative to the shared directory.
+	Path string
+
+	// Size of the entry in bytes, including its contents.
+	Size int64
+
+	// ModTime is the most recent modification time of the entry or its
+	// contents.
+	ModTime time.Time
+
+	// PackageID is the identifier found at the end of the name of the entry,
+	// uuid.Nil when there is none.
+	PackageID uuid.UUID
+
+	// Reason is the rule of the policy that selected the entry.
+	Reason string
+}
+
+// RetentionCandidates returns the entries of the shared directory that the
+// retention policies would remove now. It does not remove anything.
+func (c *Controller) RetentionCandidates() ([]RetentionCandidate, error) {
+	return c.retentionCandidates(time.Now())
+}
+
+func (c *Controller) retentionCandidates(now time.Time) ([]RetentionCandidate, error) {
+	dirs := make([]string, 0, len(c.config.Retention))
+	for dir := range c.config.Retention {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	var (
+		candidates []RetentionCandidate
+		errs       error
+	)
+	for _, dir := range dirs {
+		selected, err := c.selectEntries(dir, c.config.Retention[dir], now)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		candidates = append(candidates, selected...)
+	}
+
+	return candidates, errs
+}
+
+// selectEntries applies a retention policy to the entries of a directory.
+// Entries in use by the controller are never selected, but they count towards
+// the size of the directory.
+func (c *Controller) selectEntries(dir string, policy RetentionPolicy, now time.Time) ([]RetentionCandidate, error) {
+	entries, err := os.ReadDir(filepath.Join(c.sharedDir, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		items []RetentionCandidate
+		total int64
+	)
+	for _, entry := range entries {
+		size, modTime, err := measureEntry(filepath.Join(c.sharedDir, dir, entry.Name()))
+		if err != nil {
+			// The entry may have been removed meanwhile.
+			c.logger.V(2).Info("Error while measuring entry.", "err", err, "dir", dir, "name", entry.Name())
+			continue
+		}
+		total += size
+		items = append(items, RetentionCandidate{
+			Path:      filepath.Join(dir, entry.Name()),
+			Size:      size,
+			ModTime:   modTime,
+			PackageID: uuidFromPath(entry.Name()),
+		})
+	}
+
+	// Oldest entries first.
+	slices.SortStableFunc(items, func(a, b RetentionCandidate) int {
+		return a.ModTime.Compare(b.ModTime)
+	})
+
+	var selected []RetentionCandidate
+	for _, item := range items {
+		if c.inUse(item) {
+			continue
+		}
+		switch {
+		case policy.MaxAge > 0 && now.Sub(item.ModTime) > policy.MaxAge:
+			item.Reason = retentionReasonMaxAge
+		case policy.MaxSize > 0 && total > policy.MaxSize:
+			item.Reason = retentionReasonMaxSize
+		default:
+			continue
+		}
+		total -= item.Size
+		selected = append(selected, item)
+	}
+
+	return selected, nil
+}
+
+// inUse reports whether the entry is claimed, used by an ingest or belongs to
+// a package that is queued, active or awaiting a decision.
+func (c *Controller) inUse(item RetentionCandidate) bool {
+	path := filepath.Join(c.sharedDir, item.Path)
+	if c.claims.claimed(path) || c.ingests.uses(path) {
+		return true
+	}
+	if item.PackageID == uuid.Nil {
+		return false
+	}
+
+	return c.known(item.PackageID)
+}
+
+// removeCandidate removes the entry unless it is in use, it reports whether
+// the entry was removed. The path is claimed and the lock is held from the
+// check to the removal, so the entry cannot be claimed or its package queued
+// meanwhile.
+func (c *Controller) removeCandidate(item RetentionCandidate) (bool, error) {
+	path := filepath.Join(c.sharedDir, item.Path)
+	if !c.claims.claim(path) {
+		return false, nil
+	}
+	defer c.claims.release(path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if item.PackageID != uuid.Nil && c.knownLocked(item.PackageID) {
+		return false, nil
+	}
+	if c.ingests.uses(path) {
+		return false, nil
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// applyRetention removes the entries selected by the retention policies and
+// hides the packages found at the end of their names. The entries are only
+// logged when the controller is configured in dry-run mode.
+func (c *Controller) applyRetention(ctx context.Context) {
+	candidates, err := c.RetentionCandidates()
+	if err != nil {
+		c.logger.Error(err, "Failed to list retention candidates.")
+	}
+
+	for _, item := range candidates {
+		logger := c.logger.WithValues("path", item.Path, "size", item.Size, "reason", item.Reason)
+		if c.config.RetentionDryRun {
+			logger.Info("Retention candidate found (dry run).")
+			continue
+		}
+
+		// The entry may have been claimed since it was selected.
+		if removed, err := c.removeCandidate(item); err != nil {
+			logger.Error(err, "Failed to remove retention candidate.")
+			continue
+		} else if !removed {
+			continue
+		}
+		if item.PackageID != uuid.Nil {
+			if err := c.store.HidePackage(ctx, item.PackageID); err != nil {
+				logger.Error(err, "Failed to hide package.", "id", item.PackageID)
+			}
+		}
+		logger.Info("Retention candidate removed.")
+	}
+}
+
+// runRetention applies the retention policies periodically until the
+// controller is closed or starts draining.
+func (c *Controller) runRetention() {
+	ticker := time.NewTicker(c.config.RetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		c.applyRetention(c.groupCtx)
+
+		select {
+		case <-ticker.C:
+		case <-c.drainCh:
+			return
+		case <-c.groupCtx.Done():
+			return
+		}
+	}
+}
+
+// measureEntry returns the size of an entry, including its contents, and its
+// most recent modification time.
+func measureEntry(path string) (size int64, modTime time.Time, err error) {
+	err = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		return nil
+	})
+
+	return size, modTime, err
+}
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerRetention(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)
+	oldID := uuid.MustParse("4f6a1e5e-1a41-4a5b-9d2e-2c3f7c3ad5b0")
+	newID := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+
+	// touch sets the modification time of an entry and its contents.
+	touch := func(t *testing.T, path string, modTime time.Time) {
+		t.Helper()
+
+		err := filepath.WalkDir(path, func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Chtimes(path, modTime, modTime)
+		})
+		assert.NilError(t, err)
+	}
+
+	newController := func(t *testing.T, config Config) (*Controller, *storemock.MockStore, *tfs.Dir) {
+		t.Helper()
+
+		sharedDir := tfs.NewDir(t, "ccp",
+			tfs.WithDir("failed",
+				tfs.WithDir("images-"+oldID.String(), tfs.WithFile("image.jpg", "1234567890")),
+				tfs.WithDir("audio-"+newID.String(), tfs.WithFile("audio.mp3", "12345")),
+				tfs.WithFile("notes.txt", "123"),
+			),
+		)
+		touch(t, sharedDir.Join("failed", "images-"+oldID.String()), now.Add(-48*time.Hour))
+		touch(t, sharedDir.Join("failed", "notes.txt"), now.Add(-36*time.Hour))
+		touch(t, sharedDir.Join("failed", "audio-"+newID.String()), now.Add(-time.Hour))
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, config, sharedDir.Path(), sharedDir.Join("watchedDirectories"))
+
+		return c, s, sharedDir
+	}
+
+	t.Run("Selects entries older than the maximum age", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, _ := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxAge: 24 * time.Hour}}})
+
+		candidates, err := c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, candidates, []RetentionCandidate{
+			{Path: "failed/images-" + oldID.String(), Size: 10, ModTime: now.Add(-48 * time.Hour), PackageID: oldID, Reason: "max-age"},
+			{Path: "failed/notes.txt", Size: 3, ModTime: now.Add(-36 * time.Hour), Reason: "max-age"},
+		})
+	})
+
+	t.Run("Selects the oldest entries until the directory fits", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, _ := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxSize: 8}}})
+
+		candidates, err := c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, candidates, []RetentionCandidate{
+			{Path: "failed/images-" + oldID.String(), Size: 10, ModTime: now.Add(-48 * time.Hour), PackageID: oldID, Reason: "max-size"},
+		})
+	})
+
+	t.Run("Skips entries in use", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, sharedDir := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxAge: 24 * time.Hour}}})
+		c.claims.claim(sharedDir.Join("failed", "notes.txt"))
+		c.activePackages = append(c.activePackages, &Package{id: oldID})
+
+		candidates, err := c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.Equal(t, len(candidates), 0)
+	})
+
+	t.Run("Skips entries used by ingests", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, sharedDir := newController(t, Config{Retention: RetentionPolicies{
+			"tmp":                 {MaxAge: 24 * time.Hour},
+			"currentlyProcessing": {MaxAge: 24 * time.Hour},
+		}})
+		tmpDir := sharedDir.Join("tmp", "copy")
+		destDir := sharedDir.Join("currentlyProcessing", "Images")
+		for _, path := range []string{tmpDir, destDir} {
+			assert.NilError(t, os.MkdirAll(path, 0o750))
+			touch(t, path, now.Add(-48*time.Hour))
+		}
+
+		progress := c.ingests.add(uuid.New())
+		progress.use(tmpDir)
+		progress.use(destDir)
+
+		candidates, err := c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.Equal(t, len(candidates), 0)
+
+		progress.fail(adminv1.IngestStatus_INGEST_STATUS_FAILED, errors.New("copy failed"))
+		candidates, err = c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.Equal(t, len(candidates), 2)
+	})
+
+	t.Run("Removes entries and hides their packages", func(t *testing.T) {
+		t.Parallel()
+
+		// Every entry is older than a second.
+		c, s, sharedDir := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxAge: time.Second}}})
+		s.EXPECT().HidePackage(gomock.Any(), oldID).Return(nil)
+		s.EXPECT().HidePackage(gomock.Any(), newID).Return(nil)
+
+		c.applyRetention(context.Background())
+
+		entries, err := os.ReadDir(sharedDir.Join("failed"))
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 0)
+	})
+
+	t.Run("Keeps entries that are in use once selected", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, sharedDir := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxAge: 24 * time.Hour}}})
+		candidates, err := c.retentionCandidates(now)
+		assert.NilError(t, err)
+		assert.Equal(t, len(candidates), 2)
+
+		c.activePackages = append(c.activePackages, &Package{id: oldID})
+		c.claims.claim(sharedDir.Join("failed", "notes.txt"))
+
+		for _, item := range candidates {
+			removed, err := c.removeCandidate(item)
+			assert.NilError(t, err)
+			assert.Assert(t, !removed, item.Path)
+		}
+		assert.Assert(t, c.claims.claimed(sharedDir.Join("failed", "notes.txt")))
+
+		entries, err := os.ReadDir(sharedDir.Join("failed"))
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 3)
+	})
+
+	t.Run("Keeps entries in dry-run mode", func(t *testing.T) {
+		t.Parallel()
+
+		c, _, sharedDir := newController(t, Config{Retention: RetentionPolicies{"failed": {MaxAge: time.Second}}, RetentionDryRun: true})
+
+		c.applyRetention(context.Background())
+
+		entries, err := os.ReadDir(sharedDir.Join("failed"))
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 3)
+	})
+}
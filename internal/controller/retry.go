@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/derrors"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+// ErrNotFailed is returned when retrying a package that has not failed or
+// that is still known by the controller.
+var ErrNotFailed = errors.New("package has not failed")
+
+// ErrUnknownLink is returned when the link where processing should restart
+// cannot be determined or it is not part of the workflow.
+var ErrUnknownLink = errors.New("link cannot be used to retry the package")
+
+// errKnownPackage is returned when queueing a package that is already queued,
+// active or awaiting a decision.
+var errKnownPackage = errors.New("package is already known")
+
+// RetryPackage restarts the processing of a failed package at the given link.
+// When linkID is nil, processing restarts at the link that failed, which is
+// taken from the persisted iterator state or from the most recent failed job.
+// It returns the link where processing restarts.
+//
+// The package is queued with the startAtChainID/startAtLinkID bypass so the
+// iterator starts the chain of the link, reloading the chain context from the
+// store, and jumps to the link right away. It returns ErrDraining when the
+// controller is draining.
+func (c *Controller) RetryPackage(ctx context.Context, id, linkID uuid.UUID, priority int32) (_ uuid.UUID, err error) {
+	defer derrors.Wrap(&err, "RetryPackage(%s, %s)", id, linkID)
+
+	if c.isDraining() {
+		return uuid.Nil, ErrDraining
+	}
+	if c.known(id) {
+		return uuid.Nil, ErrNotFailed
+	}
+
+	pkg, err := c.loadFailedPackage(ctx, id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	state, err := readIteratorState(ctx, c.store, id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var chainID uuid.UUID
+	if linkID == uuid.Nil && state != nil {
+		if _, ok := c.wf.Links[state.NextLinkID]; ok {
+			linkID, chainID = state.NextLinkID, state.ChainID
+		}
+	}
+	if linkID == uuid.Nil {
+		if linkID, err = c.failedLink(ctx, id); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	if _, ok := c.wf.Links[linkID]; !ok {
+		return uuid.Nil, ErrUnknownLink
+	}
+	// Without the chain of the persisted state, the chain is only known when
+	// the link belongs to a single chain.
+	if _, ok := c.wf.Chains[chainID]; !ok {
+		chains := c.wf.ChainsForLink(linkID)
+		switch len(chains) {
+		case 0:
+			return uuid.Nil, ErrUnknownLink
+		case 1:
+			chainID = chains[0].ID
+		default:
+			return uuid.Nil, fmt.Errorf("%w: link %s belongs to %d chains", ErrUnknownLink, linkID, len(chains))
+		}
+	}
+
+	pkg.startAtChainID = chainID
+	pkg.startAtLinkID = linkID
+
+	c.logger.Info("Retrying package.", "id", id, "chainID", chainID, "linkID", linkID)
+
+	// The package may have been queued meanwhile, e.g. by another retry.
+	if err := c.queue(pkg, priority); errors.Is(err, errKnownPackage) {
+		return uuid.Nil, ErrNotFailed
+	} else if err != nil {
+		return uuid.Nil, err
+	}
+	c.pick()
+
+	return linkID, nil
+}
+
+// loadFailedPackage builds a failed package from the store.
+func (c *Controller) loadFailedPackage(ctx context.Context, id uuid.UUID) (*Package, error) {
+	pkg := newPackage(c.logger.WithName("package").WithValues("id", id, "retried", true), c.store, c.sharedDir)
+	pkg.id = id
+
+	var (
+		path   string
+		failed bool
+	)
+	if transfer, err := c.store.ReadTransfer(ctx, id); err == nil {
+		pkg.unit = &Transfer{pkg: pkg}
+		path = transfer.CurrentPath
+		failed = transfer.Status == adminv1.PackageStatus_PACKAGE_STATUS_FAILED
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	} else if sip, err := c.store.ReadSIP(ctx, id); err == nil {
+		if sip.Type == "DIP" {
+			pkg.unit = &DIP{pkg: pkg}
+		} else {
+			pkg.unit = &SIP{pkg: pkg}
+		}
+		path = sip.CurrentPath
+		failed = sip.Status == int(enums.PackageStatusFailed)
+	} else {
+		return nil, err
+	}
+
+	if !failed {
+		return nil, ErrNotFailed
+	}
+	if path == "" {
+		return nil, fmt.Errorf("package location is unknown")
+	}
+	pkg.UpdatePath(path)
+
+	return pkg, nil
+}
+
+// failedLink returns the link of the most recent failed job of the package,
+// or the link of the most recent job if none of them is marked as failed, e.g.
+// when the job could not record its final status.
+func (c *Controller) failedLink(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	jobs, err := c.store.ListJobs(ctx, id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(jobs) == 0 {
+		return uuid.Nil, ErrUnknownLink
+	}
+
+	last := jobs[0]
+	for _, item := range jobs {
+		if item.Status == adminv1.JobStatus_JOB_STATUS_FAILED {
+			last = item
+			break
+		}
+	}
+
+	linkID, err := uuid.Parse(last.LinkId)
+	if err != nil {
+		return uuid.Nil, ErrUnknownLink
+	}
+
+	return linkID, nil
+}
@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestControllerRetryPackage(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	var (
+		chainID = uuid.MustParse("65273f18-5b4e-4944-af4f-09be175a88e8")
+		linkID  = uuid.MustParse("002716a1-ae29-4f36-98ab-0d97192669c4") // Belongs to two chains.
+
+		singleChainLinkID = uuid.MustParse("8bc92801-4308-4e3b-885b-1a89fdcd3014")
+	)
+
+	// newController returns a controller that does not start processing the
+	// queued packages because its only processing slot is taken.
+	newController := func(t *testing.T) (*Controller, *storemock.MockStore) {
+		t.Helper()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		c := New(logr.Discard(), metrics.NewMetrics(nil), s, nil, wf, Config{MaxActivePackages: 1}, t.TempDir(), "")
+		c.activePackages = append(c.activePackages, testPackage(t, enums.PackageTypeTransfer))
+
+		return c, s
+	}
+
+	t.Run("Retries the link recorded in the iterator state", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		id := uuid.New()
+
+		s.EXPECT().ReadTransfer(gomock.Any(), id).Return(store.Transfer{
+			ID:          id,
+			CurrentPath: "%sharedPath%currentlyProcessing/transfer/",
+			Status:      adminv1.PackageStatus_PACKAGE_STATUS_FAILED,
+		}, nil)
+		blob := `{"chain_id":"` + chainID.String() + `","next_link_id":"` + linkID.String() + `"}`
+		s.EXPECT().ReadUnitVars(gomock.Any(), id, enums.PackageType(""), iteratorStateVar).Return([]store.UnitVar{
+			{Name: iteratorStateVar, Value: &blob},
+		}, nil)
+		s.EXPECT().CreateUnitVar(gomock.Any(), id, enums.PackageTypeTransfer, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+
+		got, err := c.RetryPackage(context.Background(), id, uuid.Nil, 10)
+		assert.NilError(t, err)
+		assert.Equal(t, got, linkID)
+
+		assert.DeepEqual(t, queuedIDs(c.scheduler), []uuid.UUID{id})
+		pkg := c.scheduler.queue[0].pkg
+		assert.Equal(t, pkg.startAtChainID, chainID)
+		assert.Equal(t, pkg.startAtLinkID, linkID)
+		assert.Equal(t, pkg.priority, int32(10))
+		assert.Equal(t, pkg.packageType(), enums.PackageTypeTransfer)
+	})
+
+	t.Run("Retries the link of the most recent failed job", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		id := uuid.New()
+
+		s.EXPECT().ReadTransfer(gomock.Any(), id).Return(store.Transfer{}, store.ErrNotFound)
+		s.EXPECT().ReadSIP(gomock.Any(), id).Return(store.SIP{
+			ID:          id,
+			CurrentPath: "%sharedPath%currentlyProcessing/sip/",
+			Type:        "SIP",
+			Status:      int(enums.PackageStatusFailed),
+		}, nil)
+		s.EXPECT().ReadUnitVars(gomock.Any(), id, enums.PackageType(""), iteratorStateVar).Return(nil, store.ErrNotFound)
+		s.EXPECT().ListJobs(gomock.Any(), id).Return([]*adminv1.Job{
+			{LinkId: uuid.NewString(), Status: adminv1.JobStatus_JOB_STATUS_COMPLETED_SUCCESSFULLY},
+			{LinkId: singleChainLinkID.String(), Status: adminv1.JobStatus_JOB_STATUS_FAILED},
+		}, nil)
+		s.EXPECT().CreateUnitVar(gomock.Any(), id, enums.PackageTypeSIP, iteratorStateVar, gomock.Any(), uuid.Nil, true).Return(nil)
+
+		got, err := c.RetryPackage(context.Background(), id, uuid.Nil, 0)
+		assert.NilError(t, err)
+		assert.Equal(t, got, singleChainLinkID)
+
+		pkg := c.scheduler.queue[0].pkg
+		assert.Equal(t, pkg.startAtChainID, uuid.MustParse("6e431096-c403-4cbf-a59a-a26e86be54a8"))
+		assert.Equal(t, pkg.startAtLinkID, singleChainLinkID)
+		assert.Equal(t, pkg.packageType(), enums.PackageTypeSIP)
+	})
+
+	t.Run("Rejects a link that belongs to several chains", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		id := uuid.New()
+
+		s.EXPECT().ReadTransfer(gomock.Any(), id).Return(store.Transfer{
+			ID:          id,
+			CurrentPath: "%sharedPath%currentlyProcessing/transfer/",
+			Status:      adminv1.PackageStatus_PACKAGE_STATUS_FAILED,
+		}, nil)
+		s.EXPECT().ReadUnitVars(gomock.Any(), id, enums.PackageType(""), iteratorStateVar).Return(nil, store.ErrNotFound)
+
+		_, err := c.RetryPackage(context.Background(), id, linkID, 0)
+		assert.ErrorIs(t, err, ErrUnknownLink)
+		assert.ErrorContains(t, err, "belongs to 2 chains")
+		assert.Equal(t, c.scheduler.len(), 0)
+	})
+
+	t.Run("Rejects an unknown link", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		id := uuid.New()
+
+		s.EXPECT().ReadTransfer(gomock.Any(), id).Return(store.Transfer{
+			ID:          id,
+			CurrentPath: "%sharedPath%currentlyProcessing/transfer/",
+			Status:      adminv1.PackageStatus_PACKAGE_STATUS_FAILED,
+		}, nil)
+		s.EXPECT().ReadUnitVars(gomock.Any(), id, enums.PackageType(""), iteratorStateVar).Return(nil, store.ErrNotFound)
+
+		_, err := c.RetryPackage(context.Background(), id, uuid.New(), 0)
+		assert.ErrorIs(t, err, ErrUnknownLink)
+		assert.Equal(t, c.scheduler.len(), 0)
+	})
+
+	t.Run("Rejects packages that have not failed", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		id := uuid.New()
+
+		s.EXPECT().ReadTransfer(gomock.Any(), id).Return(store.Transfer{
+			ID:          id,
+			CurrentPath: "%sharedPath%currentlyProcessing/transfer/",
+			Status:      adminv1.PackageStatus_PACKAGE_STATUS_COMPLETED_SUCCESSFULLY,
+		}, nil)
+
+		_, err := c.RetryPackage(context.Background(), id, linkID, 0)
+		assert.ErrorIs(t, err, ErrNotFailed)
+	})
+
+	t.Run("Rejects packages known by the controller", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := newController(t)
+		pkg := testPackage(t, enums.PackageTypeTransfer)
+		assert.NilError(t, c.enqueue(pkg, 0))
+
+		_, err := c.RetryPackage(context.Background(), pkg.id, linkID, 0)
+		assert.ErrorIs(t, err, ErrNotFailed)
+	})
+
+	t.Run("Rejects packages queued while the retry is prepared", func(t *testing.T) {
+		t.Parallel()
+
+		c, s := newController(t)
+		pkg := testPackage(t, enums.PackageTypeTransfer)
+
+		// The package is queued by someone else, e.g. another retry, after
+		// the controller checked that the package was not known.
+		s.EXPECT().ReadTransfer(gomock.Any(), pkg.id).DoAndReturn(func(context.Context, uuid.UUID) (store.Transfer, error) {
+			assert.NilError(t, c.enqueue(pkg, 0))
+			return store.Transfer{
+				ID:          pkg.id,
+				CurrentPath: "%sharedPath%currentlyProcessing/transfer/",
+				Status:      adminv1.PackageStatus_PACKAGE_STATUS_FAILED,
+			}, nil
+		})
+		s.EXPECT().ReadUnitVars(gomock.Any(), pkg.id, enums.PackageType(""), iteratorStateVar).Return(nil, store.ErrNotFound)
+
+		_, err := c.RetryPackage(context.Background(), pkg.id, singleChainLinkID, 0)
+		assert.ErrorIs(t, err, ErrNotFailed)
+		assert.DeepEqual(t, queuedIDs(c.scheduler), []uuid.UUID{pkg.id})
+	})
+}
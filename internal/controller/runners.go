@@ -0,0 +1,361 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"unicode"
+
+	"github.com/otiai10/copy"
+)
+
+// runner runs a client script in-process instead of sending its tasks to the
+// workers. It is given the arguments of a task, writes the output of the
+// script to stdout and stderr, and returns the exit code of the script.
+//
+// Runners must behave like the client scripts of MCPClient they replace.
+type runner func(ctx context.Context, args []string, stdout, stderr io.Writer) int
+
+// runners are the client scripts run in-process, indexed by function name,
+// i.e. the lowercased Execute field of the link.
+var runners = map[string]runner{
+	"checktransferdirectoryforobjects_v0.0": runCheckTransferDirectoryForObjects,
+	"copy_v0.0":                             runCopy,
+	"createdirectory_v0.0":                  runCreateDirectory,
+	"move_v0.0":                             runMove,
+	"removedirectories_v0.0":                runRemoveDirectories,
+	"setfilepermission_v0.0":                runSetFilePermission,
+	"test_v0.0":                             runTest,
+}
+
+// runCheckTransferDirectoryForObjects succeeds if the directory or any of its
+// subdirectories contain files.
+//
+// Client script: check_transfer_directory_for_objects.py.
+func runCheckTransferDirectoryForObjects(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprintln(stderr, "missing directory")
+		return 1
+	}
+
+	found := errors.New("found")
+	err := filepath.WalkDir(args[0], func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // Unreadable entries are skipped like os.walk does.
+		}
+		if !d.IsDir() {
+			return found
+		}
+		return nil
+	})
+	if err == found {
+		return 0
+	}
+
+	return 1
+}
+
+// runCopy copies a file, options: -n (do not overwrite), -R (copy directories
+// recursively).
+//
+// Client script: cmd_cp.py (cp).
+func runCopy(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags, operands := parseFlags(args)
+	if len(operands) != 2 {
+		fmt.Fprintln(stderr, "cp: expected source and destination")
+		return 1
+	}
+	noClobber, recursive := false, false
+	for _, f := range flags {
+		switch f {
+		case "-n", "--no-clobber":
+			noClobber = true
+		case "-R", "-r", "--recursive":
+			recursive = true
+		default:
+			fmt.Fprintf(stderr, "cp: unsupported option %q\n", f)
+			return 1
+		}
+	}
+
+	src, dst := operands[0], operands[1]
+	if fi, err := os.Stat(src); err != nil {
+		fmt.Fprintf(stderr, "cp: cannot stat '%s': %v\n", src, unwrapPathError(err))
+		return 1
+	} else if fi.IsDir() && !recursive {
+		fmt.Fprintf(stderr, "cp: -r not specified; omitting directory '%s'\n", src)
+		return 1
+	}
+	if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(src))
+	}
+	if _, err := os.Lstat(dst); err == nil && noClobber {
+		return 0
+	}
+
+	if err := copy.Copy(src, dst); err != nil {
+		fmt.Fprintf(stderr, "cp: cannot copy '%s' to '%s': %v\n", src, dst, unwrapPathError(err))
+		return 1
+	}
+
+	return 0
+}
+
+// runCreateDirectory creates directories, options: -m MODE, -p.
+//
+// Client script: cmd_mkdir.py (mkdir).
+func runCreateDirectory(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	var (
+		mode     os.FileMode = 0o777
+		setMode  bool
+		parents  bool
+		operands []string
+	)
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; {
+		case arg == "-m" && i+1 < len(args):
+			m, err := strconv.ParseUint(args[i+1], 8, 32)
+			if err != nil {
+				fmt.Fprintf(stderr, "mkdir: invalid mode '%s'\n", args[i+1])
+				return 1
+			}
+			mode, setMode = os.FileMode(m), true
+			i++
+		case arg == "-p":
+			parents = true
+		case strings.HasPrefix(arg, "-"):
+			fmt.Fprintf(stderr, "mkdir: unsupported option %q\n", arg)
+			return 1
+		default:
+			operands = append(operands, arg)
+		}
+	}
+	if len(operands) == 0 {
+		fmt.Fprintln(stderr, "mkdir: missing operand")
+		return 1
+	}
+
+	code := 0
+	for _, dir := range operands {
+		var err error
+		if parents {
+			err = os.MkdirAll(dir, mode)
+		} else {
+			err = os.Mkdir(dir, mode)
+		}
+		if err == nil && setMode {
+			// Unlike os.Mkdir, mkdir -m is not affected by the umask.
+			err = os.Chmod(dir, mode)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "mkdir: cannot create directory '%s': %v\n", dir, unwrapPathError(err))
+			code = 1
+		}
+	}
+
+	return code
+}
+
+// runMove moves a file or directory. The source is moved inside the
+// destination when the destination is an existing directory.
+//
+// Client script: cmd_mv.py (mv).
+func runMove(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	_, operands := parseFlags(args)
+	if len(operands) != 2 {
+		fmt.Fprintln(stderr, "mv: expected source and destination")
+		return 1
+	}
+
+	src, dst := strings.TrimSuffix(operands[0], "/"), operands[1]
+	if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(src))
+	}
+
+	err := os.Rename(src, dst)
+	if errors.Is(err, syscall.EXDEV) {
+		// Fall back to copying when the paths are in different devices.
+		if err = copy.Copy(src, dst); err == nil {
+			err = os.RemoveAll(src)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "mv: cannot move '%s' to '%s': %v\n", src, dst, unwrapPathError(err))
+		return 1
+	}
+
+	return 0
+}
+
+// runRemoveDirectories removes the directories given if they exist.
+//
+// Client script: remove_directories.py.
+func runRemoveDirectories(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	for _, dir := range args {
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			fmt.Fprintln(stdout, "Directory does not exist:", dir)
+			continue
+		}
+		fmt.Fprintln(stdout, "Removing directory:", dir)
+		if err := os.RemoveAll(dir); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// runSetFilePermission changes the mode of files, only octal modes are
+// supported.
+//
+// Client script: cmd_chmod.py (chmod).
+func runSetFilePermission(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) < 2 {
+		fmt.Fprintln(stderr, "chmod: missing operand")
+		return 1
+	}
+
+	mode, err := strconv.ParseUint(args[0], 8, 32)
+	if err != nil {
+		fmt.Fprintf(stderr, "chmod: unsupported mode '%s'\n", args[0])
+		return 1
+	}
+
+	code := 0
+	for _, path := range args[1:] {
+		if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+			fmt.Fprintf(stderr, "chmod: cannot access '%s': %v\n", path, unwrapPathError(err))
+			code = 1
+		}
+	}
+
+	return code
+}
+
+// runTest evaluates a file test expression: -d, -e or -f followed by a path.
+//
+// Client script: cmd_test.py (test).
+func runTest(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) != 2 {
+		fmt.Fprintln(stderr, "test: unsupported expression")
+		return 2
+	}
+
+	fi, err := os.Stat(args[1])
+	var ok bool
+	switch args[0] {
+	case "-d":
+		ok = err == nil && fi.IsDir()
+	case "-e":
+		ok = err == nil
+	case "-f":
+		ok = err == nil && fi.Mode().IsRegular()
+	default:
+		fmt.Fprintf(stderr, "test: unsupported operator %q\n", args[0])
+		return 2
+	}
+	if !ok {
+		return 1
+	}
+
+	return 0
+}
+
+// parseFlags separates the options from the operands.
+func parseFlags(args []string) (flags, operands []string) {
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") && len(arg) > 1 {
+			flags = append(flags, arg)
+		} else {
+			operands = append(operands, arg)
+		}
+	}
+
+	return flags, operands
+}
+
+// unwrapPathError removes the operation and path from the error, they're
+// already described by the messages of the runners.
+func unwrapPathError(err error) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Err
+	}
+	var linkErr *os.LinkError
+	if errors.As(err, &linkErr) {
+		return linkErr.Err
+	}
+
+	return err
+}
+
+// splitArgs splits the arguments of a task like MCPClient does, i.e. using the
+// POSIX shell-like syntax of Python's shlex.split and dropping the escape
+// character of escaped backticks.
+func splitArgs(s string) ([]string, error) {
+	var (
+		args    []string
+		buf     strings.Builder
+		inWord  bool
+		quote   rune // Zero, single or double quote.
+		escaped bool
+	)
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			// Within double quotes, the escape character is preserved unless
+			// it escapes itself or the quote.
+			if quote == '"' && r != '\\' && r != '"' && r != '`' {
+				buf.WriteRune('\\')
+			}
+			buf.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				buf.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				buf.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case unicode.IsSpace(r):
+			if inWord {
+				args = append(args, buf.String())
+				buf.Reset()
+				inWord = false
+			}
+		default:
+			buf.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if escaped {
+		return nil, errors.New("no escaped character")
+	}
+	if quote != 0 {
+		return nil, errors.New("no closing quotation")
+	}
+	if inWord {
+		args = append(args, buf.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,311 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func TestSplitArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		args    string
+		want    []string
+		wantErr string
+	}{
+		"Splits words": {
+			args: `-m 770  /a/b/`,
+			want: []string{"-m", "770", "/a/b/"},
+		},
+		"Honours quotes": {
+			args: `"/a b/" '/c "d"/' "e'f"`,
+			want: []string{"/a b/", `/c "d"/`, "e'f"},
+		},
+		"Honours escapes": {
+			args: `a\ b "c\"d" "e\f" "g\` + "`" + `h" i\` + "`",
+			want: []string{"a b", `c"d`, `e\f`, "g`h", "i`"},
+		},
+		"Keeps empty quoted words": {
+			args: `"" a`,
+			want: []string{"", "a"},
+		},
+		"Fails with unclosed quotes": {
+			args:    `"a`,
+			wantErr: "no closing quotation",
+		},
+		"Fails with trailing escapes": {
+			args:    `a\`,
+			wantErr: "no escaped character",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := splitArgs(tc.args)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
+
+func TestRunners(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, funcName string, args ...string) (int, string, string) {
+		t.Helper()
+
+		var stdout, stderr bytes.Buffer
+		code := runners[funcName](context.Background(), args, &stdout, &stderr)
+
+		return code, stdout.String(), stderr.String()
+	}
+
+	t.Run("checkTransferDirectoryForObjects_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithDir("empty", fs.WithDir("sub")), fs.WithDir("full", fs.WithDir("sub", fs.WithFile("file", ""))))
+
+		code, _, _ := run(t, "checktransferdirectoryforobjects_v0.0", tmpDir.Join("empty"))
+		assert.Equal(t, code, 1)
+
+		code, _, _ = run(t, "checktransferdirectoryforobjects_v0.0", tmpDir.Join("full"))
+		assert.Equal(t, code, 0)
+	})
+
+	t.Run("copy_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithFile("src.xml", "new"), fs.WithFile("dst.xml", "old"), fs.WithDir("dir"))
+
+		code, _, _ := run(t, "copy_v0.0", tmpDir.Join("src.xml"), tmpDir.Join("dst.xml"), "-n")
+		assert.Equal(t, code, 0)
+		assertContent(t, tmpDir.Join("dst.xml"), "old")
+
+		code, _, _ = run(t, "copy_v0.0", tmpDir.Join("src.xml"), tmpDir.Join("dst.xml"))
+		assert.Equal(t, code, 0)
+		assertContent(t, tmpDir.Join("dst.xml"), "new")
+
+		code, _, _ = run(t, "copy_v0.0", tmpDir.Join("src.xml"), tmpDir.Join("dir"))
+		assert.Equal(t, code, 0)
+		assertContent(t, tmpDir.Join("dir", "src.xml"), "new")
+
+		code, _, stderr := run(t, "copy_v0.0", tmpDir.Join("missing.xml"), tmpDir.Join("dir"))
+		assert.Equal(t, code, 1)
+		assert.Equal(t, stderr, "cp: cannot stat '"+tmpDir.Join("missing.xml")+"': no such file or directory\n")
+	})
+
+	t.Run("createDirectory_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t)
+
+		code, _, _ := run(t, "createdirectory_v0.0", "-m", "770", tmpDir.Join("DIP")+"/", tmpDir.Join("DIP", "objects")+"/")
+		assert.Equal(t, code, 0)
+		fi, err := os.Stat(tmpDir.Join("DIP", "objects"))
+		assert.NilError(t, err)
+		assert.Equal(t, fi.Mode().Perm(), os.FileMode(0o770))
+
+		code, _, stderr := run(t, "createdirectory_v0.0", tmpDir.Join("DIP"))
+		assert.Equal(t, code, 1)
+		assert.Equal(t, stderr, "mkdir: cannot create directory '"+tmpDir.Join("DIP")+"': file exists\n")
+	})
+
+	t.Run("move_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithDir("sip", fs.WithDir("DIP", fs.WithFile("file", ""))), fs.WithDir("uploadedDIPs"))
+
+		code, _, _ := run(t, "move_v0.0", tmpDir.Join("sip", "DIP"), tmpDir.Join("sip", "DIP-renamed"))
+		assert.Equal(t, code, 0)
+
+		code, _, _ = run(t, "move_v0.0", tmpDir.Join("sip")+"/", tmpDir.Join("uploadedDIPs")+"/")
+		assert.Equal(t, code, 0)
+		assert.Assert(t, fs.Equal(tmpDir.Path(), fs.Expected(t,
+			fs.WithMode(0o755|os.ModeDir),
+			fs.WithDir("uploadedDIPs", fs.WithMode(0o755|os.ModeDir),
+				fs.WithDir("sip", fs.WithMode(0o755|os.ModeDir),
+					fs.WithDir("DIP-renamed", fs.WithMode(0o755|os.ModeDir), fs.WithFile("file", "")),
+				),
+			),
+		)))
+	})
+
+	t.Run("removeDirectories_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithDir("logs", fs.WithFile("file", "")))
+
+		code, stdout, _ := run(t, "removedirectories_v0.0", tmpDir.Join("logs"), tmpDir.Join("thumbnails"))
+		assert.Equal(t, code, 0)
+		assert.Equal(t, stdout, "Removing directory: "+tmpDir.Join("logs")+"\nDirectory does not exist: "+tmpDir.Join("thumbnails")+"\n")
+		_, err := os.Stat(tmpDir.Join("logs"))
+		assert.Assert(t, os.IsNotExist(err))
+	})
+
+	t.Run("setFilePermission_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithFile("aip.7z", "", fs.WithMode(0o600)))
+
+		code, _, _ := run(t, "setfilepermission_v0.0", "775", tmpDir.Join("aip.7z"))
+		assert.Equal(t, code, 0)
+		fi, err := os.Stat(tmpDir.Join("aip.7z"))
+		assert.NilError(t, err)
+		assert.Equal(t, fi.Mode().Perm(), os.FileMode(0o775))
+
+		code, _, _ = run(t, "setfilepermission_v0.0", "u+x", tmpDir.Join("aip.7z"))
+		assert.Equal(t, code, 1)
+	})
+
+	t.Run("test_v0.0", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := tempDir(t, fs.WithDir("DIP"), fs.WithFile("file", ""))
+
+		for _, tc := range []struct {
+			op, path string
+			want     int
+		}{
+			{"-d", tmpDir.Join("DIP"), 0},
+			{"-d", tmpDir.Join("file"), 1},
+			{"-f", tmpDir.Join("file"), 0},
+			{"-e", tmpDir.Join("missing"), 1},
+		} {
+			code, _, _ := run(t, "test_v0.0", tc.op, tc.path)
+			assert.Equal(t, code, tc.want, "test %s %s", tc.op, tc.path)
+		}
+	})
+}
+
+// tempDir returns a temporary directory removed when the test ends, runners
+// are only given paths within it.
+func tempDir(t *testing.T, ops ...fs.PathOp) *fs.Dir {
+	t.Helper()
+
+	return fs.DirFromPath(t, t.TempDir(), ops...)
+}
+
+func assertContent(t *testing.T, path, want string) {
+	t.Helper()
+
+	blob, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(blob), want)
+}
+
+// fakeExecutor records the batches passed to it.
+type fakeExecutor struct {
+	batches []*Batch
+}
+
+func (e *fakeExecutor) Execute(ctx context.Context, batch *Batch) <-chan *BatchResult {
+	e.batches = append(e.batches, batch)
+	done := make(chan *BatchResult, 1)
+	done <- &BatchResult{Results: map[uuid.UUID]*TaskResult{}}
+
+	return done
+}
+
+func (e *fakeExecutor) CanExecute(funcName string) bool {
+	return false
+}
+
+func TestRunnerExecutor(t *testing.T) {
+	t.Parallel()
+
+	newBatch := func(funcName string, args ...string) *Batch {
+		batch := &Batch{ID: uuid.New(), FuncName: funcName}
+		for _, arg := range args {
+			batch.Tasks = append(batch.Tasks, &BatchTask{ID: uuid.New(), CreatedAt: time.Now(), Args: arg})
+		}
+		return batch
+	}
+
+	t.Run("Runs batches in-process and records the results", func(t *testing.T) {
+		t.Parallel()
+
+		sharedDir := tempDir(t, fs.WithDir("sip"))
+		s := storemock.NewMockStore(gomock.NewController(t))
+		next := &fakeExecutor{}
+		e := newRunnerExecutor(logr.Discard(), s, next, sharedDir.Path())
+
+		batch := newBatch("test_v0.0", `-d "%sharedPath%sip"`, `-d "/var/archivematica/sharedDirectory/sip"`, `-d "%sharedPath%DIP"`)
+		s.EXPECT().UpdateTasks(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, tasks []*store.Task) error {
+			assert.Equal(t, len(tasks), 3)
+			assert.Equal(t, tasks[0].ID, batch.Tasks[0].ID)
+			assert.Equal(t, tasks[0].ExitCode.Int16, int16(0))
+			assert.Equal(t, tasks[1].ExitCode.Int16, int16(0))
+			assert.Equal(t, tasks[2].ExitCode.Int16, int16(1))
+			assert.Assert(t, tasks[2].EndedAt.Valid)
+			return nil
+		})
+
+		result := <-e.Execute(context.Background(), batch)
+		assert.NilError(t, result.Err)
+		assert.Equal(t, result.Results[batch.Tasks[0].ID].ExitCode, 0)
+		assert.Equal(t, result.Results[batch.Tasks[1].ID].ExitCode, 0)
+		assert.Equal(t, result.Results[batch.Tasks[2].ID].ExitCode, 1)
+		assert.Equal(t, len(next.batches), 0)
+		assert.Assert(t, e.CanExecute("test_v0.0"))
+	})
+
+	t.Run("Delegates batches without runner", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		next := &fakeExecutor{}
+		e := newRunnerExecutor(logr.Discard(), s, next, t.TempDir())
+
+		<-e.Execute(context.Background(), newBatch("normalize_v1.0", `"%SIPUUID%"`))
+		<-e.Execute(context.Background(), newBatch("copy_v0.0", `"%clientAssetsDirectory%README/README.html" "/tmp/README.html"`))
+		assert.Equal(t, len(next.batches), 2)
+		assert.Assert(t, !e.CanExecute("normalize_v1.0"))
+	})
+
+	t.Run("Returns the results when they cannot be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		sharedDir := tempDir(t, fs.WithDir("sip"))
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().UpdateTasks(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+		e := newRunnerExecutor(logr.Discard(), s, &fakeExecutor{}, sharedDir.Path())
+
+		batch := newBatch("removedirectories_v0.0", `"%sharedPath%sip"`)
+		result := <-e.Execute(context.Background(), batch)
+		assert.NilError(t, result.Err)
+		assert.Error(t, result.RecordErr, "record results: connection refused")
+		assert.Equal(t, result.Results[batch.Tasks[0].ID].ExitCode, 0)
+	})
+
+	t.Run("Reports invalid arguments", func(t *testing.T) {
+		t.Parallel()
+
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().UpdateTasks(gomock.Any(), gomock.Any()).Return(nil)
+		e := newRunnerExecutor(logr.Discard(), s, &fakeExecutor{}, t.TempDir())
+
+		batch := newBatch("test_v0.0", `-d "`+filepath.Join(t.TempDir(), "dir"))
+		result := <-e.Execute(context.Background(), batch)
+		assert.NilError(t, result.Err)
+		assert.DeepEqual(t, result.Results[batch.Tasks[0].ID].Stderr, "Invalid arguments: no closing quotation\n")
+	})
+}
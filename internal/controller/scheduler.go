@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+// queuedPackage is a package waiting in the scheduler queue.
+type queuedPackage struct {
+	pkg      *Package
+	queuedAt time.Time
+}
+
+// scheduler decides the order in which queued packages are processed.
+//
+// The queue is sorted by priority, highest first, and packages with the same
+// priority are kept in order of arrival. A package is only picked when doing so
+// does not exceed the concurrency limit or the quota of its package type. A
+// package that cannot be picked because of its quota does not block packages
+// of other types queued behind it.
+//
+// The scheduler is not safe for concurrent use, the controller protects it.
+type scheduler struct {
+	limit  int
+	quotas map[enums.PackageType]int
+	queue  []*queuedPackage
+}
+
+func newScheduler(config Config) *scheduler {
+	limit := config.MaxActivePackages
+	if limit < 1 {
+		limit = defaultMaxActivePackages
+	}
+
+	quotas := map[enums.PackageType]int{}
+	for pt, quota := range map[enums.PackageType]int{
+		enums.PackageTypeTransfer: config.MaxActiveTransfers,
+		enums.PackageTypeSIP:      config.MaxActiveSIPs,
+		enums.PackageTypeDIP:      config.MaxActiveDIPs,
+	} {
+		if quota > 0 {
+			quotas[pt] = quota
+		}
+	}
+
+	return &scheduler{
+		limit:  limit,
+		quotas: quotas,
+		queue:  []*queuedPackage{},
+	}
+}
+
+// push adds a package to the queue behind all the packages with the same or
+// higher priority.
+func (s *scheduler) push(pkg *Package, priority int32) {
+	pkg.priority = priority
+	s.insert(&queuedPackage{
+		pkg:      pkg,
+		queuedAt: time.Now(),
+	})
+}
+
+func (s *scheduler) insert(item *queuedPackage) {
+	pos := len(s.queue)
+	for i, queued := range s.queue {
+		if queued.pkg.priority < item.pkg.priority {
+			pos = i
+			break
+		}
+	}
+
+	s.queue = slices.Insert(s.queue, pos, item)
+}
+
+// next removes and returns the first package in the queue that can be
+// processed alongside the given active packages. It returns nil when no
+// package can be picked.
+func (s *scheduler) next(active []*Package) *Package {
+	if len(active) >= s.limit {
+		return nil
+	}
+
+	counts := map[enums.PackageType]int{}
+	for _, pkg := range active {
+		counts[pkg.packageType()]++
+	}
+
+	for i, item := range s.queue {
+		pt := item.pkg.packageType()
+		if quota, ok := s.quotas[pt]; ok && counts[pt] >= quota {
+			continue
+		}
+		s.queue = slices.Delete(s.queue, i, i+1)
+		return item.pkg
+	}
+
+	return nil
+}
+
+// remove deletes a package from the queue. It reports whether the package was
+// found.
+func (s *scheduler) remove(id uuid.UUID) (*queuedPackage, bool) {
+	pos := s.index(id)
+	if pos == -1 {
+		return nil, false
+	}
+
+	item := s.queue[pos]
+	s.queue = slices.Delete(s.queue, pos, pos+1)
+
+	return item, true
+}
+
+// reprioritize updates the priority of a queued package and moves it to its
+// new position. It returns the new position or -1 if the package is not
+// queued.
+func (s *scheduler) reprioritize(id uuid.UUID, priority int32) int {
+	item, ok := s.remove(id)
+	if !ok {
+		return -1
+	}
+
+	item.pkg.priority = priority
+	s.insert(item)
+
+	return s.index(id)
+}
+
+// promote moves a queued package to the front of the queue. The priority of
+// the package is raised to the priority of the package at the front so it is
+// not overtaken by packages queued afterwards with the same priority. It
+// returns the new position or -1 if the package is not queued.
+func (s *scheduler) promote(id uuid.UUID) int {
+	item, ok := s.remove(id)
+	if !ok {
+		return -1
+	}
+
+	if len(s.queue) > 0 && s.queue[0].pkg.priority > item.pkg.priority {
+		item.pkg.priority = s.queue[0].pkg.priority
+	}
+	s.queue = slices.Insert(s.queue, 0, item)
+
+	return 0
+}
+
+func (s *scheduler) index(id uuid.UUID) int {
+	return slices.IndexFunc(s.queue, func(item *queuedPackage) bool {
+		return item.pkg.id == id
+	})
+}
+
+// len returns the number of queued packages.
+func (s *scheduler) len() int {
+	return len(s.queue)
+}
+
+// list returns the queued packages in the order they are going to be picked.
+func (s *scheduler) list() []*adminv1.QueuedPackage {
+	ret := make([]*adminv1.QueuedPackage, 0, len(s.queue))
+	for i, item := range s.queue {
+		ret = append(ret, &adminv1.QueuedPackage{
+			Id:       item.pkg.id.String(),
+			Name:     item.pkg.Name(),
+			Type:     packageTypeProto(item.pkg.packageType()),
+			Priority: item.pkg.priority,
+			Position: int32(i), //nolint:gosec // (G115) no risk of overflow
+			QueuedAt: timestamppb.New(item.queuedAt),
+		})
+	}
+
+	return ret
+}
+
+// packageTypeProto converts a package type into its adminv1 representation.
+func packageTypeProto(pt enums.PackageType) adminv1.PackageType {
+	switch pt {
+	case enums.PackageTypeTransfer:
+		return adminv1.PackageType_PACKAGE_TYPE_TRANSFER
+	case enums.PackageTypeSIP:
+		return adminv1.PackageType_PACKAGE_TYPE_SIP
+	case enums.PackageTypeDIP:
+		return adminv1.PackageType_PACKAGE_TYPE_DIP
+	default:
+		return adminv1.PackageType_PACKAGE_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+func testPackage(t *testing.T, pt enums.PackageType) *Package {
+	t.Helper()
+
+	pkg := &Package{id: uuid.New()}
+	switch pt {
+	case enums.PackageTypeTransfer:
+		pkg.unit = &Transfer{pkg: pkg}
+	case enums.PackageTypeSIP:
+		pkg.unit = &SIP{pkg: pkg}
+	case enums.PackageTypeDIP:
+		pkg.unit = &DIP{pkg: pkg}
+	default:
+		t.Fatalf("unexpected package type %q", pt)
+	}
+
+	return pkg
+}
+
+func queuedIDs(s *scheduler) []uuid.UUID {
+	ret := make([]uuid.UUID, 0, s.len())
+	for _, item := range s.queue {
+		ret = append(ret, item.pkg.id)
+	}
+	return ret
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Uses the default limit", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{})
+		assert.Equal(t, s.limit, defaultMaxActivePackages)
+		assert.Equal(t, len(s.quotas), 0)
+	})
+
+	t.Run("Sorts packages by priority and arrival", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{MaxActivePackages: 10})
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeTransfer)
+		p3 := testPackage(t, enums.PackageTypeTransfer)
+		p4 := testPackage(t, enums.PackageTypeTransfer)
+		s.push(p1, 0)
+		s.push(p2, 5)
+		s.push(p3, 0)
+		s.push(p4, 5)
+
+		assert.DeepEqual(t, queuedIDs(s), []uuid.UUID{p2.id, p4.id, p1.id, p3.id})
+
+		var active []*Package
+		for _, want := range []*Package{p2, p4, p1, p3} {
+			pkg := s.next(active)
+			assert.Equal(t, pkg, want)
+			active = append(active, pkg)
+		}
+		assert.Assert(t, s.next(active) == nil)
+	})
+
+	t.Run("Honours the concurrency limit", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{MaxActivePackages: 1})
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeSIP)
+		s.push(p1, 0)
+		s.push(p2, 0)
+
+		assert.Equal(t, s.next(nil), p1)
+		assert.Assert(t, s.next([]*Package{p1}) == nil)
+		assert.Equal(t, s.next(nil), p2)
+	})
+
+	t.Run("Honours the quotas without blocking other types", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{MaxActivePackages: 3, MaxActiveTransfers: 1})
+		t1 := testPackage(t, enums.PackageTypeTransfer)
+		t2 := testPackage(t, enums.PackageTypeTransfer)
+		sip := testPackage(t, enums.PackageTypeSIP)
+		s.push(t1, 0)
+		s.push(t2, 0)
+		s.push(sip, 0)
+
+		active := []*Package{s.next(nil)}
+		assert.Equal(t, active[0], t1)
+
+		// The second transfer is skipped because of the quota.
+		assert.Equal(t, s.next(active), sip)
+		assert.DeepEqual(t, queuedIDs(s), []uuid.UUID{t2.id})
+		assert.Assert(t, s.next([]*Package{t1, sip}) == nil)
+		assert.Equal(t, s.next([]*Package{sip}), t2)
+	})
+
+	t.Run("Promotes a package to the front of the queue", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{})
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeTransfer)
+		p3 := testPackage(t, enums.PackageTypeTransfer)
+		s.push(p1, 10)
+		s.push(p2, 0)
+
+		assert.Equal(t, s.promote(p2.id), 0)
+		assert.DeepEqual(t, queuedIDs(s), []uuid.UUID{p2.id, p1.id})
+		assert.Equal(t, s.queue[0].pkg.priority, int32(10))
+
+		// Packages queued afterwards with the same priority stay behind.
+		s.push(p3, 10)
+		assert.DeepEqual(t, queuedIDs(s), []uuid.UUID{p2.id, p1.id, p3.id})
+
+		assert.Equal(t, s.promote(uuid.New()), -1)
+	})
+
+	t.Run("Reprioritizes a package", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{})
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeTransfer)
+		p3 := testPackage(t, enums.PackageTypeTransfer)
+		s.push(p1, 0)
+		s.push(p2, 0)
+		s.push(p3, 0)
+
+		assert.Equal(t, s.reprioritize(p3.id, 1), 0)
+		assert.Equal(t, s.reprioritize(p1.id, -1), 2)
+		assert.DeepEqual(t, queuedIDs(s), []uuid.UUID{p3.id, p2.id, p1.id})
+
+		assert.Equal(t, s.reprioritize(uuid.New(), 1), -1)
+	})
+
+	t.Run("Lists queued packages", func(t *testing.T) {
+		t.Parallel()
+
+		s := newScheduler(Config{})
+		p1 := testPackage(t, enums.PackageTypeTransfer)
+		p2 := testPackage(t, enums.PackageTypeDIP)
+		s.push(p1, 0)
+		s.push(p2, 3)
+
+		items := s.list()
+		assert.Equal(t, len(items), 2)
+		assert.Equal(t, items[0].Id, p2.id.String())
+		assert.Equal(t, items[0].Priority, int32(3))
+		assert.Equal(t, items[0].Position, int32(0))
+		assert.Equal(t, items[1].Id, p1.id.String())
+		assert.Equal(t, items[1].Position, int32(1))
+	})
+}
@@ -0,0 +1,553 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+// defaultSimulationSteps is the maximum number of steps of a simulation when
+// the configuration does not provide one.
+const defaultSimulationSteps = 10000
+
+// SimulationConfig describes how a workflow simulation is driven.
+type SimulationConfig struct {
+	// WatchedDir is the watched directory where the package is placed, e.g.
+	// "activeTransfers/standardTransfer". It takes precedence over
+	// TransferType.
+	WatchedDir string
+
+	// TransferType starts the simulation like a transfer submitted via the
+	// API, i.e. from the auto-approval bypass of the transfer type.
+	TransferType adminv1.TransferType
+
+	// ProcessingConfig is the processing configuration used to resolve the
+	// decision points.
+	ProcessingConfig []workflow.Choice
+
+	// ExitCodes are the exit codes returned by the client scripts, indexed by
+	// link identifier or by script name, e.g. "copy_v0.0". Consecutive runs
+	// return the codes in order and the last one is repeated, e.g. [0, 1] for
+	// hasPackages_v0.0 extracts packages once. Scripts exit with zero by
+	// default.
+	ExitCodes map[string][]int
+
+	// ResolveBlocking continues the simulation past the decision points that
+	// would block using their first choice.
+	ResolveBlocking bool
+
+	// MaxSteps is the maximum number of steps before the simulation stops.
+	MaxSteps int
+}
+
+// SimulationOutcome describes how a simulation ended.
+type SimulationOutcome string
+
+const (
+	// SimulationOutcomeDone means that the package reached a terminal link.
+	SimulationOutcomeDone SimulationOutcome = "done"
+
+	// SimulationOutcomeBlocked means that the package is awaiting a decision
+	// that the processing configuration does not resolve.
+	SimulationOutcomeBlocked SimulationOutcome = "blocked"
+
+	// SimulationOutcomeDeadEnd means that the package cannot continue, e.g. an
+	// exit code leads to a link that does not exist.
+	SimulationOutcomeDeadEnd SimulationOutcome = "dead-end"
+
+	// SimulationOutcomeLoop means that the package would be processed
+	// forever, e.g. it returns to a link in the same state or to a watched
+	// directory that it entered before.
+	SimulationOutcomeLoop SimulationOutcome = "loop"
+)
+
+// Simulation is the outcome of walking the workflow with a processing
+// configuration and a fake executor.
+type Simulation struct {
+	// Steps is the path taken through the workflow.
+	Steps []*SimulationStep `json:"steps"`
+
+	// Blocking are the decision points that would block the package.
+	Blocking []*SimulationStep `json:"blocking,omitempty"`
+
+	// Unresolved are the branches of the links visited that cannot be
+	// followed.
+	Unresolved []*UnresolvedBranch `json:"unresolved,omitempty"`
+
+	// Outcome describes how the simulation ended.
+	Outcome SimulationOutcome `json:"outcome"`
+
+	// Reason explains the outcome.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SimulationStep is a chain started or a link executed during a simulation.
+type SimulationStep struct {
+	// ChainID is the chain that the step belongs to.
+	ChainID uuid.UUID `json:"chain_id"`
+
+	// LinkID is the link executed, nil when the step starts a chain.
+	LinkID uuid.UUID `json:"link_id"`
+
+	// Manager is the manager of the link, e.g. "linkTaskManagerFiles".
+	Manager string `json:"manager,omitempty"`
+
+	// Description of the chain or the link.
+	Description string `json:"description"`
+
+	// WatchedDir is the watched directory that started the chain, if any.
+	WatchedDir string `json:"watched_dir,omitempty"`
+
+	// Script is the client script run by the link, if any.
+	Script string `json:"script,omitempty"`
+
+	// ExitCode is the exit code returned by the script.
+	ExitCode int `json:"exit_code"`
+
+	// Decision describes how the decision point was resolved, if any.
+	Decision string `json:"decision,omitempty"`
+
+	// Choices are the choices available when the decision point blocks.
+	Choices []string `json:"choices,omitempty"`
+}
+
+// UnresolvedBranch is a branch of a link that cannot be followed.
+type UnresolvedBranch struct {
+	LinkID      uuid.UUID `json:"link_id"`
+	Description string    `json:"description"`
+
+	// ExitCode is the exit code of the branch, nil for the fallback branch.
+	ExitCode *int `json:"exit_code,omitempty"`
+
+	// Reason explains why the branch cannot be followed.
+	Reason string `json:"reason"`
+}
+
+// Simulate walks the workflow like the controller would process a package
+// without running any job. Decisions are resolved with the processing
+// configuration and client scripts return the exit codes configured.
+func Simulate(wf *workflow.Document, config SimulationConfig) (*Simulation, error) {
+	s := &simulator{
+		wf:       wf,
+		config:   config,
+		sim:      &Simulation{Steps: []*SimulationStep{}},
+		vars:     map[string]uuid.UUID{},
+		reported: map[uuid.UUID]struct{}{},
+		visited:  map[uuid.UUID]int{},
+		runs:     map[string]int{},
+	}
+	if s.config.MaxSteps < 1 {
+		s.config.MaxSteps = defaultSimulationSteps
+	}
+
+	next, startAt, err := s.start()
+	if err != nil {
+		return nil, err
+	}
+	s.run(next, startAt)
+
+	return s.sim, nil
+}
+
+// ParseExitCodes parses the exit codes of a script given as a key-value pair,
+// e.g. "hasPackages_v0.0=0,1". The key is a script name or a link identifier.
+func ParseExitCodes(value string) (string, []int, error) {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return "", nil, fmt.Errorf("invalid exit codes %q: expected KEY=CODE[,CODE...]", value)
+	}
+
+	var codes []int
+	for _, item := range strings.Split(val, ",") {
+		code, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || code < 0 || code > 255 {
+			return "", nil, fmt.Errorf("invalid exit code %q", item)
+		}
+		codes = append(codes, code)
+	}
+
+	return key, codes, nil
+}
+
+// ProcessingConfig returns the choices of the processing configuration with
+// the given name, e.g. "automated".
+func (c *Controller) ProcessingConfig(name string) ([]workflow.Choice, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return nil, fmt.Errorf("invalid processing configuration name %q", name)
+	}
+
+	return workflow.ParseConfigFile(filepath.Join(c.sharedDir, "sharedMicroServiceTasksConfigs", "processingMCPConfigs", name+"ProcessingMCP.xml"))
+}
+
+// simulator holds the state of a simulation, mirroring jobIterator.
+type simulator struct {
+	wf     *workflow.Document
+	config SimulationConfig
+	sim    *Simulation
+
+	// chain is the current chain.
+	chain *workflow.Chain
+
+	// vars are the link unit variables of the package.
+	vars map[string]uuid.UUID
+
+	// watchedDir is the watched directory that starts the next chain.
+	watchedDir string
+
+	// reported are the links whose branches were already inspected.
+	reported map[uuid.UUID]struct{}
+
+	// visited records the state version of the last visit to each link.
+	visited map[uuid.UUID]int
+
+	// version of the state, it changes when a unit variable is modified or a
+	// script returns a new exit code. Visiting a link twice with the same
+	// version means that the package is in a loop.
+	version int
+
+	// runs is the number of runs of each exit code sequence.
+	runs map[string]int
+}
+
+// start returns the chain where the simulation starts and optionally the link
+// within the chain used as a bypass.
+func (s *simulator) start() (uuid.UUID, uuid.UUID, error) {
+	if s.config.WatchedDir != "" {
+		wd := s.findWatchedDir(s.config.WatchedDir)
+		if wd == nil {
+			return uuid.Nil, uuid.Nil, fmt.Errorf("watched directory %q not found in workflow", s.config.WatchedDir)
+		}
+		s.watchedDir = strings.Trim(wd.Path, "/")
+		return wd.ChainID, uuid.Nil, nil
+	}
+
+	tt := Transfers.WithType(s.config.TransferType)
+	if tt == nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unknown transfer type %s", s.config.TransferType)
+	}
+	if _, ok := s.wf.Chains[tt.BypassChainID]; !ok {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("chain %s of transfer type %q not found in workflow", tt.BypassChainID, tt.Name)
+	}
+
+	return tt.BypassChainID, tt.BypassLinkID, nil
+}
+
+func (s *simulator) run(next, startAt uuid.UUID) {
+	for range s.config.MaxSteps {
+		if wc, ok := s.wf.Chains[next]; ok {
+			s.chain = wc
+			s.step(&SimulationStep{ChainID: wc.ID, Description: wc.Description.String(), WatchedDir: s.watchedDir})
+			s.watchedDir = ""
+			next = wc.LinkID
+			if startAt != uuid.Nil {
+				next, startAt = startAt, uuid.Nil
+			}
+			continue
+		}
+
+		wl, ok := s.wf.Links[next]
+		if !ok {
+			s.end(SimulationOutcomeDeadEnd, fmt.Sprintf("link %s not found in workflow", next))
+			return
+		}
+		if v, ok := s.visited[wl.ID]; ok && v == s.version {
+			s.end(SimulationOutcomeLoop, fmt.Sprintf("link %q is reached again in the same state, configure the exit codes of the scripts that control the loop", wl.Description))
+			return
+		}
+		s.visited[wl.ID] = s.version
+		s.inspect(wl)
+
+		var (
+			step = &SimulationStep{ChainID: s.chain.ID, LinkID: wl.ID, Manager: wl.Manager, Description: wl.Description.String()}
+			err  error
+		)
+		s.step(step)
+		next, err = s.exec(wl, step)
+		if errors.Is(err, errBlocked) {
+			s.sim.Blocking = append(s.sim.Blocking, step)
+			if !s.config.ResolveBlocking {
+				s.end(SimulationOutcomeBlocked, fmt.Sprintf("decision %q is not resolved by the processing configuration", step.Description))
+				return
+			}
+		} else if err != nil {
+			s.end(SimulationOutcomeDeadEnd, err.Error())
+			return
+		}
+
+		if next != uuid.Nil {
+			continue
+		}
+
+		// End of chain, the package continues in a watched directory or ends.
+		wd := s.handOff(wl)
+		if wd == nil {
+			if wl.End {
+				s.end(SimulationOutcomeDone, "")
+			} else {
+				s.end(SimulationOutcomeDeadEnd, fmt.Sprintf("link %q ends the chain without moving the package to a watched directory", step.Description))
+			}
+			return
+		}
+		if wl.End {
+			// A new package is created, e.g. a SIP created from a transfer.
+			s.vars = map[string]uuid.UUID{}
+			s.version++
+		}
+		s.watchedDir = strings.Trim(wd.Path, "/")
+		next = wd.ChainID
+	}
+
+	s.end(SimulationOutcomeLoop, fmt.Sprintf("the simulation exceeded %d steps", s.config.MaxSteps))
+}
+
+var errBlocked = errors.New("blocked")
+
+// exec simulates the job of the link and returns the next link or chain, nil
+// when the chain ends. It returns errBlocked when the decision point would
+// block, the next link is the first choice.
+func (s *simulator) exec(wl *workflow.Link, step *SimulationStep) (uuid.UUID, error) {
+	switch config := wl.Config.(type) {
+	case workflow.LinkMicroServiceChainChoice:
+		if chainID := s.preconfiguredChoice(wl.ID); chainID != "" {
+			id, err := uuid.Parse(chainID)
+			if err != nil {
+				return uuid.Nil, fmt.Errorf("invalid preconfigured choice %q for decision %q", chainID, step.Description)
+			}
+			wc, ok := s.wf.Chains[id]
+			if !ok {
+				return uuid.Nil, fmt.Errorf("preconfigured choice %s for decision %q not found in workflow", id, step.Description)
+			}
+			step.Decision = fmt.Sprintf("preconfigured: %s", wc.Description)
+			return id, nil
+		}
+		var first uuid.UUID
+		for _, id := range config.Choices {
+			if wc, ok := s.wf.Chains[id]; ok {
+				if first == uuid.Nil {
+					first = id
+				}
+				step.Choices = append(step.Choices, wc.Description.String())
+			}
+		}
+		step.Decision = "blocking"
+		return first, errBlocked
+
+	case workflow.LinkMicroServiceChoiceReplacementDic:
+		next := exitCodeLinkID(wl, 0)
+		if len(config.Replacements) == 0 {
+			step.Decision = "dashboard settings"
+			return next, nil
+		}
+		if desc := s.preconfiguredReplacement(wl.ID); desc != "" {
+			step.Decision = fmt.Sprintf("preconfigured: %s", desc)
+			return next, nil
+		}
+		for _, item := range config.Replacements {
+			step.Choices = append(step.Choices, item.Description.String())
+		}
+		step.Decision = "blocking"
+		return next, errBlocked
+
+	case workflow.LinkStandardTaskConfig:
+		step.Script = config.Execute
+		step.ExitCode = s.exitCode(wl, config.Execute)
+		if ec, ok := wl.ExitCodes[step.ExitCode]; ok {
+			if ec.LinkID == nil {
+				return uuid.Nil, nil
+			}
+			return *ec.LinkID, nil
+		}
+		return wl.FallbackLinkID, nil
+
+	case workflow.LinkTaskConfigSetUnitVariable:
+		if s.vars[config.Variable] != config.LinkID {
+			s.vars[config.Variable] = config.LinkID
+			s.version++
+		}
+		return exitCodeLinkID(wl, 0), nil
+
+	case workflow.LinkTaskConfigUnitVariableLinkPull:
+		if id, ok := s.vars[config.Variable]; ok && id != uuid.Nil {
+			return id, nil
+		}
+		return config.LinkID, nil
+	}
+
+	return uuid.Nil, fmt.Errorf("unknown job manager: %q", wl.Manager)
+}
+
+// exitCode returns the next exit code configured for the script of the link.
+func (s *simulator) exitCode(wl *workflow.Link, script string) int {
+	for _, key := range []string{wl.ID.String(), script} {
+		codes := s.config.ExitCodes[key]
+		if len(codes) == 0 {
+			continue
+		}
+		n := s.runs[key]
+		s.runs[key]++
+		if n < len(codes)-1 {
+			s.version++ // The next run may return a different code.
+		} else {
+			n = len(codes) - 1
+		}
+		return codes[n]
+	}
+
+	return 0
+}
+
+// preconfiguredChoice mirrors Package.PreconfiguredChoice.
+func (s *simulator) preconfiguredChoice(linkID uuid.UUID) string {
+	for _, choice := range s.config.ProcessingConfig {
+		if choice.LinkID() == linkID {
+			return choice.GoToChain
+		}
+	}
+
+	return ""
+}
+
+// preconfiguredReplacement mirrors updateContextDecisionJob, it returns the
+// description of the replacement chosen by the processing configuration.
+func (s *simulator) preconfiguredReplacement(linkID uuid.UUID) string {
+	normalized := linkID
+	if v, ok := updateContextDecisionJobChoiceMapping[linkID]; ok {
+		normalized = v
+	}
+	wl, ok := s.wf.Links[normalized]
+	if !ok {
+		return ""
+	}
+	config, ok := wl.Config.(workflow.LinkMicroServiceChoiceReplacementDic)
+	if !ok {
+		return ""
+	}
+
+	var ret string
+	for _, choice := range s.config.ProcessingConfig {
+		if choice.AppliesTo != normalized.String() {
+			continue
+		}
+		desired := choice.ChainID()
+		if v, ok := updateContextDecisionJobChoiceMapping[desired]; ok {
+			desired = v
+		}
+		for _, replacement := range config.Replacements {
+			if replacement.ID == desired {
+				ret = replacement.Description.String()
+			}
+		}
+	}
+
+	return ret
+}
+
+// inspect reports the branches of the link that lead nowhere, only once per
+// link.
+func (s *simulator) inspect(wl *workflow.Link) {
+	if _, ok := s.reported[wl.ID]; ok {
+		return
+	}
+	s.reported[wl.ID] = struct{}{}
+
+	exists := func(id uuid.UUID) bool {
+		_, isLink := s.wf.Links[id]
+		_, isChain := s.wf.Chains[id]
+		return isLink || isChain
+	}
+
+	codes := make([]int, 0, len(wl.ExitCodes))
+	for code := range wl.ExitCodes {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	for _, code := range codes {
+		ec := wl.ExitCodes[code]
+		if ec.LinkID != nil && !exists(*ec.LinkID) {
+			s.sim.Unresolved = append(s.sim.Unresolved, &UnresolvedBranch{
+				LinkID:      wl.ID,
+				Description: wl.Description.String(),
+				ExitCode:    &code,
+				Reason:      fmt.Sprintf("link %s not found in workflow", *ec.LinkID),
+			})
+		}
+	}
+	if wl.FallbackLinkID != uuid.Nil && !exists(wl.FallbackLinkID) {
+		s.sim.Unresolved = append(s.sim.Unresolved, &UnresolvedBranch{
+			LinkID:      wl.ID,
+			Description: wl.Description.String(),
+			Reason:      fmt.Sprintf("fallback link %s not found in workflow", wl.FallbackLinkID),
+		})
+	}
+	if _, ok := wl.Config.(workflow.LinkStandardTaskConfig); ok && wl.FallbackLinkID == uuid.Nil && !wl.End && s.handOff(wl) == nil {
+		if _, ok := wl.ExitCodes[1]; !ok {
+			// Failures end the chain without a terminal link.
+			code := 1
+			s.sim.Unresolved = append(s.sim.Unresolved, &UnresolvedBranch{
+				LinkID:      wl.ID,
+				Description: wl.Description.String(),
+				ExitCode:    &code,
+				Reason:      "no branch for non-zero exit codes and no fallback link",
+			})
+		}
+	}
+}
+
+// watchedDirArg matches the paths to watched directories used in arguments.
+var watchedDirArg = regexp.MustCompile(`(?:%sharedPath%watchedDirectories/|%watchDirectoryPath%)([^"'\s%]*)`)
+
+// handOff returns the watched directory where the link moves the package, if
+// any. Client scripts like moveTransfer_v0.0 end the chain so the package is
+// picked up by the watched directory.
+func (s *simulator) handOff(wl *workflow.Link) *workflow.WatchedDirectory {
+	config, ok := wl.Config.(workflow.LinkStandardTaskConfig)
+	if !ok {
+		return nil
+	}
+
+	var ret *workflow.WatchedDirectory
+	for _, match := range watchedDirArg.FindAllStringSubmatch(config.Arguments, -1) {
+		path := strings.Trim(strings.TrimSuffix(match[1], "."), "/")
+		for _, wd := range s.wf.WatchedDirectories {
+			wdPath := strings.Trim(wd.Path, "/")
+			if path != wdPath && !strings.HasPrefix(path, wdPath+"/") {
+				continue
+			}
+			if ret == nil || len(wdPath) > len(strings.Trim(ret.Path, "/")) {
+				ret = wd
+			}
+		}
+	}
+
+	return ret
+}
+
+// findWatchedDir returns the watched directory with the given path.
+func (s *simulator) findWatchedDir(path string) *workflow.WatchedDirectory {
+	path = strings.Trim(path, "/")
+	for _, wd := range s.wf.WatchedDirectories {
+		if strings.Trim(wd.Path, "/") == path {
+			return wd
+		}
+	}
+
+	return nil
+}
+
+func (s *simulator) step(step *SimulationStep) {
+	s.sim.Steps = append(s.sim.Steps, step)
+}
+
+func (s *simulator) end(outcome SimulationOutcome, reason string) {
+	s.sim.Outcome = outcome
+	s.sim.Reason = reason
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	wf, err := workflow.Default()
+	assert.NilError(t, err)
+
+	builtin := func(t *testing.T, name string) []workflow.Choice {
+		t.Helper()
+
+		config, ok := workflow.BuiltinConfig(name)
+		assert.Assert(t, ok)
+
+		return config.Choices
+	}
+
+	t.Run("Processes the package with the automated configuration", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := Simulate(wf, SimulationConfig{
+			TransferType:     adminv1.TransferType_TRANSFER_TYPE_STANDARD,
+			ProcessingConfig: builtin(t, "automated"),
+			ExitCodes:        map[string][]int{"hasPackages_v0.0": {0, 1}},
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, sim.Outcome, SimulationOutcomeDone, sim.Reason)
+		assert.Equal(t, len(sim.Blocking), 0)
+		assert.Equal(t, sim.Steps[len(sim.Steps)-1].Script, "storeAIP_v0.0")
+	})
+
+	t.Run("Detects loops", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := Simulate(wf, SimulationConfig{
+			TransferType:     adminv1.TransferType_TRANSFER_TYPE_STANDARD,
+			ProcessingConfig: builtin(t, "automated"),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, sim.Outcome, SimulationOutcomeLoop)
+	})
+
+	t.Run("Stops at blocking decisions", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := Simulate(wf, SimulationConfig{
+			WatchedDir:       "activeTransfers/standardTransfer",
+			ProcessingConfig: builtin(t, "default"),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, sim.Outcome, SimulationOutcomeBlocked)
+		assert.Equal(t, len(sim.Blocking), 1)
+		assert.Assert(t, len(sim.Blocking[0].Choices) > 1)
+		assert.Equal(t, sim.Steps[0].WatchedDir, "activeTransfers/standardTransfer")
+	})
+
+	t.Run("Resolves blocking decisions", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := Simulate(wf, SimulationConfig{
+			WatchedDir:       "activeTransfers/standardTransfer",
+			ProcessingConfig: builtin(t, "default"),
+			ExitCodes:        map[string][]int{"hasPackages_v0.0": {0, 1}},
+			ResolveBlocking:  true,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, sim.Outcome, SimulationOutcomeDone, sim.Reason)
+		assert.Assert(t, len(sim.Blocking) > 1)
+	})
+
+	t.Run("Stops after the maximum number of steps", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := Simulate(wf, SimulationConfig{
+			ProcessingConfig: builtin(t, "automated"),
+			MaxSteps:         5,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, sim.Outcome, SimulationOutcomeLoop)
+		assert.Equal(t, sim.Reason, "the simulation exceeded 5 steps")
+	})
+
+	t.Run("Rejects unknown watched directories", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Simulate(wf, SimulationConfig{WatchedDir: "unknown"})
+		assert.ErrorContains(t, err, "unknown")
+	})
+}
+
+func TestParseExitCodes(t *testing.T) {
+	t.Parallel()
+
+	key, codes, err := ParseExitCodes("hasPackages_v0.0=0, 1")
+	assert.NilError(t, err)
+	assert.Equal(t, key, "hasPackages_v0.0")
+	assert.DeepEqual(t, codes, []int{0, 1})
+
+	_, _, err = ParseExitCodes("hasPackages_v0.0")
+	assert.Error(t, err, `invalid exit codes "hasPackages_v0.0": expected KEY=CODE[,CODE...]`)
+
+	_, _, err = ParseExitCodes("hasPackages_v0.0=256")
+	assert.Error(t, err, `invalid exit code "256"`)
+}
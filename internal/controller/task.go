@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,9 +12,11 @@ import (
 	"sync"
 	"time"
 
-	"github.com/artefactual-labs/gearmin"
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd/metrics"
 	"github.com/artefactual-labs/ccp/internal/store"
@@ -28,17 +31,27 @@ import (
 // set it juuuust right.
 var batchSize = 128
 
-// taskBackend submits tasks to MCPClient via Gearman.
+const (
+	// failedTaskExitCode is the exit code given to the tasks that the worker
+	// fails to process.
+	failedTaskExitCode = 1
+
+	// timedOutTaskExitCode is the exit code given to the tasks of a batch that
+	// exceeded its deadline, it matches the status used by timeout(1).
+	timedOutTaskExitCode = 124
+)
+
+// taskBackend submits tasks to the workers via an Executor, e.g. to MCPClient
+// via Gearman.
 //
-// Tasks are batched into batchSize groups, serialized and sent to MCPClient.
-// This adds some complexity but saves a lot of overhead.
+// Tasks are batched into batchSize groups and sent to the workers. This adds
+// some complexity but saves a lot of overhead.
 //
 // This is our first iteration and can be improved. A few ideas:
 //   - Investigate overhead of sync.WaitGroup, do we have a better alternative?
 //   - Introduce an object representing the batch, similar to GearmanTaskBatch.
 //     It's an opportunity to hide `tasks` and `taskResults` with something more
 //     succint or expressive.
-//   - Deal with failed tasks, test it.
 //   - Make the backend an application object for better resource management.
 //   - Review injected dependencies and defined fields, some are unused?
 type taskBackend struct {
@@ -53,12 +66,18 @@ type taskBackend struct {
 	// store is used to persist tasks.
 	store store.Store
 
-	// gearman is the job server we use to dispatch the tasks.
-	gearman *gearmin.Server
+	// executor is used to dispatch the tasks.
+	executor Executor
 
 	// Present in all client chain links: files, directories, output.
 	config *workflow.LinkStandardTaskConfig
 
+	// retries describes how batches are retried when the worker fails.
+	retries RetryPolicy
+
+	// timeouts describes how long batches can run.
+	timeouts TimeoutPolicy
+
 	// wg is used to wait until all batches are completed.
 	wg sync.WaitGroup
 
@@ -74,22 +93,24 @@ type taskBackend struct {
 	// results contains the aggregated outcome of all batches.
 	results *taskResults
 
-	// mu is used to synchronize write access from handleJobUpdate.
+	// mu is used to synchronize write access from handleResults and markTasks.
 	mu sync.Mutex
 }
 
-func newTaskBackend(logger logr.Logger, metrics *metrics.Metrics, job *job, store store.Store, gearman *gearmin.Server, config *workflow.LinkStandardTaskConfig) *taskBackend {
+func newTaskBackend(logger logr.Logger, metrics *metrics.Metrics, job *job, store store.Store, executor Executor, config *workflow.LinkStandardTaskConfig) *taskBackend {
 	return &taskBackend{
-		logger:  logger.V(3),
-		metrics: metrics,
-		job:     job,
-		store:   store,
-		gearman: gearman,
-		batch:   make([]*task, 0, batchSize),
+		logger:   logger.V(3),
+		metrics:  metrics,
+		job:      job,
+		store:    store,
+		executor: executor,
+		batch:    make([]*task, 0, batchSize),
 		results: &taskResults{
 			Results: map[uuid.UUID]*taskResult{},
 		},
-		config: config,
+		config:   config,
+		retries:  job.config.Retries.policy(config.Execute),
+		timeouts: job.config.Timeouts.policy(config.Execute),
 	}
 }
 
@@ -149,60 +170,207 @@ func (b *taskBackend) sendBatch(ctx context.Context) (err error) {
 	b.tasks = append(b.tasks, batch...)
 	b.mu.Unlock()
 
+	ctx, span := tracer.Start(ctx, "batch "+b.config.Execute, trace.WithAttributes(
+		attrJobID.String(b.job.id.String()),
+		attrScript.String(b.config.Execute),
+		attrBatchSize.Int(size),
+	))
+
 	if err := b.saveTasks(ctx, batch); err != nil {
+		endSpan(span, err)
 		return err
 	}
 
-	// The payload is shaped as a dictionary.
-	payload := tasks{Tasks: make(map[uuid.UUID]*task, size)}
-	for _, item := range batch {
-		payload.Tasks[item.ID] = item
-	}
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("marshal tasks: %v", err)
-	}
-
 	b.logger.Info("Submitting batch to MCPClient.", "script", b.config.Execute, "size", size)
 
+	// The batch is queued until a worker registers the function, which may
+	// never happen if the workers do not support the script.
+	if b.count == 0 && !b.executor.CanExecute(b.funcName()) {
+		b.job.logger.Info("No worker has registered the function, the batch is queued until one does.", "script", b.config.Execute, "function", b.funcName())
+	}
+
 	b.metrics.GearmanActiveJobsGauge.Inc()
 	b.metrics.GearmanPendingJobsGauge.Dec()
 
 	// Launch a goroutine to wait for this batch.
-	done := make(chan *gearmin.JobUpdate, 1)
 	b.wg.Add(1)
 	go func() {
 		defer func() {
+			span.End()
+			b.metrics.GearmanActiveJobsGauge.Dec()
 			b.wg.Done()
 		}()
-		for {
-			select {
-			case update := <-done:
-				b.handleJobUpdate(ctx, update)
-				return
-			case <-ctx.Done():
-				return
-			}
-		}
+		b.run(ctx, batch)
 	}()
 
-	b.gearman.Submit(
-		&gearmin.JobRequest{
-			ID:         uuid.NewString(),                  // Ensure uniqueness.
-			FuncName:   strings.ToLower(b.config.Execute), // MCPClient lowercases the function name.
-			Data:       data,
-			Background: false,
-			Callback: func(update gearmin.JobUpdate) {
-				done <- &update
-			},
-		},
-	)
-
 	b.count++
 
 	return nil
 }
 
+// run submits a batch and waits until it is processed. The batch is submitted
+// again when the worker fails to process it, as long as the retry policy of
+// the script allows it. The tasks are marked as failed once the attempts are
+// exhausted.
+func (b *taskBackend) run(ctx context.Context, batch []*task) {
+	backoff := min(b.retries.Backoff, b.retries.MaxBackoff)
+
+	for attempt := 1; ; attempt++ {
+		execCtx, cancel := context.WithCancel(ctx)
+		result, ok := b.await(ctx, batch, b.executor.Execute(execCtx, b.newBatch(batch)))
+		cancel() // Withdraws the batch if we gave up on it.
+		if !ok {
+			return
+		}
+
+		if result.Err == nil {
+			b.handleResults(ctx, result)
+			return
+		}
+
+		reason := result.Err.Error()
+		if attempt >= b.retries.Attempts {
+			b.fail(ctx, batch, reason, attempt)
+			return
+		}
+
+		b.job.logger.Info("Worker failed to process batch, retrying.", "script", b.config.Execute, "attempt", attempt, "backoff", backoff, "reason", reason)
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(attrAttempt.Int(attempt), attribute.String("reason", reason)))
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			b.abandon(ctx, batch)
+			return
+		}
+		backoff = min(backoff*2, b.retries.MaxBackoff)
+	}
+}
+
+// await waits for the final update of a submitted batch. It reports batches
+// that run past the warning threshold of the timeout policy and gives up on
+// the batch when the deadline is exceeded or the context is cancelled, in
+// which case it returns false.
+func (b *taskBackend) await(ctx context.Context, batch []*task, done <-chan *BatchResult) (*BatchResult, bool) {
+	var (
+		started  = time.Now()
+		deadline = b.timeouts.deadline(len(batch))
+		timeout  <-chan time.Time
+		warning  <-chan time.Time
+	)
+	if deadline > 0 {
+		t := time.NewTimer(deadline)
+		defer t.Stop()
+		timeout = t.C
+	}
+	if b.timeouts.Warning > 0 {
+		t := time.NewTimer(b.timeouts.Warning)
+		defer t.Stop()
+		warning = t.C
+	}
+
+	for {
+		select {
+		case result := <-done:
+			return result, true
+		case <-warning:
+			warning = nil
+			b.metrics.GearmanSlowJobsCounter.WithLabelValues(b.config.Execute).Inc()
+			b.job.logger.Info("Batch is taking longer than expected.", "script", b.config.Execute, "size", len(batch), "elapsed", time.Since(started).Round(time.Second))
+		case <-timeout:
+			b.timeOut(ctx, batch, deadline)
+			return nil, false
+		case <-ctx.Done():
+			b.abandon(ctx, batch)
+			return nil, false
+		}
+	}
+}
+
+// newBatch returns a new batch with the given tasks.
+func (b *taskBackend) newBatch(batch []*task) *Batch {
+	ret := &Batch{
+		ID:       uuid.New(),
+		FuncName: b.funcName(),
+		Tasks:    make([]*BatchTask, 0, len(batch)),
+	}
+	for _, item := range batch {
+		ret.Tasks = append(ret.Tasks, &BatchTask{
+			ID:          item.ID,
+			CreatedAt:   item.CreatedAt,
+			Args:        item.Args,
+			WantsOutput: item.WantsOutput,
+		})
+	}
+
+	return ret
+}
+
+// funcName returns the name of the function registered by the workers to run
+// the script, MCPClient lowercases the function names.
+func (b *taskBackend) funcName() string {
+	return strings.ToLower(b.config.Execute)
+}
+
+// fail records the tasks of a batch that the worker could not process as
+// failed so the exit code of the job reflects the failure.
+func (b *taskBackend) fail(ctx context.Context, batch []*task, reason string, attempts int) {
+	b.job.logger.Error(errors.New(reason), "Worker failed to process batch.", "script", b.config.Execute, "size", len(batch), "attempts", attempts)
+
+	trace.SpanFromContext(ctx).SetStatus(codes.Error, reason)
+
+	stderr := fmt.Sprintf("Worker failed to process the task after %d attempt(s): %s\n", attempts, reason)
+	b.markTasks(ctx, batch, failedTaskExitCode, stderr)
+}
+
+// timeOut gives up on a batch that exceeded its deadline. The batch may still
+// run if the executor cannot withdraw it but its results are discarded, the
+// tasks are marked as timed out.
+func (b *taskBackend) timeOut(ctx context.Context, batch []*task, deadline time.Duration) {
+	b.metrics.GearmanTimedOutJobsCounter.WithLabelValues(b.config.Execute).Inc()
+	b.job.logger.Error(errors.New("deadline exceeded"), "Batch timed out.", "script", b.config.Execute, "size", len(batch), "deadline", deadline)
+
+	trace.SpanFromContext(ctx).SetStatus(codes.Error, "deadline exceeded")
+
+	stderr := fmt.Sprintf("Task timed out: the batch did not complete within %s.\n", deadline)
+	b.markTasks(ctx, batch, timedOutTaskExitCode, stderr)
+}
+
+// markTasks records the outcome of the tasks of a batch that did not produce
+// results, the outcome is used to compute the exit code of the job.
+func (b *taskBackend) markTasks(ctx context.Context, batch []*task, exitCode int16, stderr string) {
+	if err := ctx.Err(); err != nil {
+		return
+	}
+
+	var (
+		now = time.Now().UTC()
+		tt  = make([]*store.Task, 0, len(batch))
+	)
+
+	b.mu.Lock()
+	for _, task := range batch {
+		b.results.Results[task.ID] = &taskResult{
+			ExitCode:   int(exitCode),
+			FinishedAt: now,
+			Stderr:     stderr,
+			task:       task,
+		}
+		_ = task.writeOutput("", stderr)
+		tt = append(tt, &store.Task{
+			ID:       task.ID,
+			Stderr:   stderr,
+			ExitCode: sql.NullInt16{Int16: exitCode, Valid: true},
+			EndedAt:  sql.NullTime{Time: now, Valid: true},
+		})
+	}
+	b.mu.Unlock()
+
+	if err := b.store.UpdateTasks(ctx, tt); err != nil {
+		b.job.logger.Error(err, "Failed to record the outcome of the tasks.", "script", b.config.Execute)
+	}
+}
+
 // saveTasks persists the tasks before they're used by MCPClient.
 func (b *taskBackend) saveTasks(ctx context.Context, batch []*task) error {
 	tt := make([]*store.Task, 0, len(batch))
@@ -233,44 +401,42 @@ func (b *taskBackend) saveTasks(ctx context.Context, batch []*task) error {
 	return b.store.CreateTasks(ctx, tt)
 }
 
-func (b *taskBackend) handleJobUpdate(ctx context.Context, update *gearmin.JobUpdate) {
+func (b *taskBackend) handleResults(ctx context.Context, result *BatchResult) {
 	if err := ctx.Err(); err != nil {
 		return
 	}
 
-	b.logger.Info("Received job update from worker.", "type", update.Type)
-	b.metrics.GearmanActiveJobsGauge.Dec()
-
-	var data []byte
-
-	switch update.Type {
-	// TODO: handle exceptions (with payload) and failures.
-	case gearmin.JobUpdateTypeException:
-		fallthrough
-	case gearmin.JobUpdateTypeFail:
-		return
-	case gearmin.JobUpdateTypeComplete:
-		data = update.Data
-	}
-
-	res := &taskResults{}
-	if err := json.Unmarshal(data, res); err != nil {
-		b.logger.Info("Failed to decode results of a batch.", "type", update.Type)
-		return
+	b.logger.Info("Received batch results from worker.", "results", len(result.Results))
+	if result.RecordErr != nil {
+		b.job.logger.Error(result.RecordErr, "Failed to record the results of the batch.", "script", b.config.Execute, "results", len(result.Results))
+		trace.SpanFromContext(ctx).AddEvent("record-failed", trace.WithAttributes(attribute.String("reason", result.RecordErr.Error())))
 	}
 
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	for _, task := range b.tasks {
 		id := task.ID
-		if r, ok := res.Results[id]; ok {
-			r.task = task
-			b.results.Results[id] = r
+		if r, ok := result.Results[id]; ok {
+			b.results.Results[id] = &taskResult{
+				ExitCode:   r.ExitCode,
+				FinishedAt: r.FinishedAt,
+				Stdout:     r.Stdout,
+				Stderr:     r.Stderr,
+				task:       task,
+			}
 			_ = task.writeOutput(r.Stdout, r.Stderr)
 		}
 	}
 }
 
+// abandon gives up on a batch when the context is cancelled, e.g. when the
+// package is cancelled. The batch is withdrawn if the executor supports it,
+// otherwise it may still run but the results are discarded once they arrive.
+func (b *taskBackend) abandon(ctx context.Context, batch []*task) {
+	b.logger.Info("Abandoning batch.", "script", b.config.Execute, "size", len(batch), "cause", context.Cause(ctx))
+	trace.SpanFromContext(ctx).AddEvent("abandoned")
+}
+
 func (b *taskBackend) wait(ctx context.Context) (*taskResults, error) {
 	// Check if we have anything for this job that hasn't been submitted.
 	if len(b.batch) > 0 {
@@ -303,6 +469,7 @@ func (b *taskBackend) waitGroup(ctx context.Context) error {
 	}
 }
 
+// tasks is the payload of the Gearman jobs, see gearmanExecutor.
 type tasks struct {
 	Tasks map[uuid.UUID]*task `json:"tasks"`
 }
@@ -381,6 +548,8 @@ func (t *task) writeFile(path, contents string) error {
 	return nil
 }
 
+// taskResults contains the results of the tasks of a job. It is also the
+// payload of the results of the Gearman jobs.
 type taskResults struct {
 	Results map[uuid.UUID]*taskResult `json:"task_results"`
 }
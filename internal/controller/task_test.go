@@ -78,7 +78,7 @@ func TestTaskBackend(t *testing.T) {
 	})).AnyTimes()
 
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: 10})
-	backend := newTaskBackend(logger, metrics.NewMetrics(nil), &job{}, s, srv, &workflow.LinkStandardTaskConfig{
+	backend := newTaskBackend(logger, metrics.NewMetrics(nil), &job{}, s, NewGearmanExecutor(srv, nil), &workflow.LinkStandardTaskConfig{
 		Execute:    fnName,
 		StdoutFile: tmpDir.Join("stdout.log"),
 		StderrFile: tmpDir.Join("stderr.log"),
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/artefactual-labs/ccp/internal/workflow"
+)
+
+// tracer creates the spans of the controller: one root span per package
+// activation, a child span per job and a span per batch of tasks.
+var tracer = otel.Tracer("github.com/artefactual-labs/ccp/internal/controller")
+
+// Span attributes.
+const (
+	attrPackageID   = attribute.Key("ccp.package.id")
+	attrPackageName = attribute.Key("ccp.package.name")
+	attrPackageType = attribute.Key("ccp.package.type")
+	attrChainID     = attribute.Key("ccp.chain.id")
+	attrChainDesc   = attribute.Key("ccp.chain.description")
+	attrLinkID      = attribute.Key("ccp.link.id")
+	attrLinkManager = attribute.Key("ccp.link.manager")
+	attrJobID       = attribute.Key("ccp.job.id")
+	attrScript      = attribute.Key("ccp.script")
+	attrBatchSize   = attribute.Key("ccp.batch.size")
+	attrAttempt     = attribute.Key("ccp.batch.attempt")
+)
+
+func packageAttrs(pkg *Package) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attrPackageID.String(pkg.id.String()),
+		attrPackageName.String(pkg.Name()),
+		attrPackageType.String(pkg.packageType().String()),
+	}
+}
+
+func chainAttrs(wc *workflow.Chain) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attrChainID.String(wc.ID.String()),
+		attrChainDesc.String(wc.Description.String()),
+	}
+}
+
+// endSpan records the error, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Location types.
+const (
+	TypeLocal = "local"
+	TypeS3    = "s3"
+	TypeSFTP  = "sftp"
+)
+
+type Config struct {
+	// Locations set in the server configuration.
+	Locations []LocationConfig
+}
+
+// LocationConfig describes a location.
+type LocationConfig struct {
+	// ID is the identifier used to refer to the location, e.g. in the
+	// <location-uuid>:<path> sources of the transfers.
+	ID uuid.UUID
+
+	// Name of the location, used in logs and to name the transfer sources
+	// that point to the root of the location.
+	Name string
+
+	// Type of location: local (default), s3 or sftp.
+	Type string
+
+	// Path is the root of the location: a directory of the local filesystem,
+	// a key prefix of the S3 bucket or a directory of the SFTP server.
+	Path string
+
+	S3   S3Config
+	SFTP SFTPConfig
+}
+
+// S3Config describes the connection to an S3-compatible service, e.g. MinIO.
+type S3Config struct {
+	// Endpoint is the host and optional port of the service.
+	Endpoint string
+
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+
+	// Insecure uses plain HTTP instead of HTTPS.
+	Insecure bool
+}
+
+// SFTPConfig describes the connection to an SFTP server.
+type SFTPConfig struct {
+	// Address is the host and port of the server, e.g. "sftp:22".
+	Address string
+
+	User string
+
+	// Password and KeyFile authenticate the user, at least one is required.
+	Password string
+	KeyFile  string
+
+	// KnownHostsFile is used to verify the key of the server.
+	KnownHostsFile string
+
+	// Insecure accepts any key from the server when KnownHostsFile is not
+	// given.
+	Insecure bool
+}
+
+// ParseLocation parses a location given as a list of space-separated
+// key-value pairs, e.g.:
+//
+//	id=c059a454-dafa-418e-a126-74d0c7219ce6 path=/home
+//	id=7d1f0d45-0bb5-4ab5-8e5b-8bd0b8c5ad5e type=s3 endpoint=minio:9000 bucket=sips access-key=minio secret-key=minio123 insecure=true
+//	id=e5e5e31a-3a9f-4a4e-a3e1-5a6bb4d8bd8f type=sftp address=sftp:22 user=archivist key-file=/etc/ccp/id_ed25519 known-hosts=/etc/ccp/known_hosts
+func ParseLocation(value string) (LocationConfig, error) {
+	var config LocationConfig
+
+	for _, field := range strings.Fields(value) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return config, fmt.Errorf("invalid field %q: missing value", field)
+		}
+		var err error
+		switch key {
+		case "id":
+			if config.ID, err = uuid.Parse(val); err != nil {
+				return config, fmt.Errorf("invalid id %q", val)
+			}
+		case "name":
+			config.Name = val
+		case "type":
+			config.Type = val
+		case "path":
+			config.Path = val
+		case "endpoint":
+			config.S3.Endpoint = val
+		case "bucket":
+			config.S3.Bucket = val
+		case "region":
+			config.S3.Region = val
+		case "access-key":
+			config.S3.AccessKey = val
+		case "secret-key":
+			config.S3.SecretKey = val
+		case "address":
+			config.SFTP.Address = val
+		case "user":
+			config.SFTP.User = val
+		case "password":
+			config.SFTP.Password = val
+		case "key-file":
+			config.SFTP.KeyFile = val
+		case "known-hosts":
+			config.SFTP.KnownHostsFile = val
+		case "insecure":
+			insecure, err := strconv.ParseBool(val)
+			if err != nil {
+				return config, fmt.Errorf("invalid insecure %q", val)
+			}
+			config.S3.Insecure, config.SFTP.Insecure = insecure, insecure
+		default:
+			return config, fmt.Errorf("invalid field %q: unknown key", field)
+		}
+	}
+	if config.Type == "" {
+		config.Type = TypeLocal
+	}
+
+	if err := config.validate(); err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func (c *LocationConfig) validate() error {
+	if c.ID == uuid.Nil {
+		return errors.New("missing id")
+	}
+
+	switch c.Type {
+	case TypeLocal:
+		if c.Path == "" {
+			return errors.New("missing path")
+		}
+		if !filepath.IsAbs(c.Path) {
+			return fmt.Errorf("invalid path %q: path is not absolute", c.Path)
+		}
+		c.Path = filepath.Clean(c.Path)
+	case TypeS3:
+		if c.S3.Endpoint == "" {
+			return errors.New("missing endpoint")
+		}
+		if c.S3.Bucket == "" {
+			return errors.New("missing bucket")
+		}
+	case TypeSFTP:
+		if c.SFTP.Address == "" {
+			return errors.New("missing address")
+		}
+		if c.SFTP.User == "" {
+			return errors.New("missing user")
+		}
+		if c.SFTP.Password == "" && c.SFTP.KeyFile == "" {
+			return errors.New("missing password or key-file")
+		}
+		if c.SFTP.KnownHostsFile == "" && !c.SFTP.Insecure {
+			return errors.New("missing known-hosts")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", c.Type)
+	}
+
+	return nil
+}
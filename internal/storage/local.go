@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Local is a location backed by a directory of the local filesystem.
+type Local struct {
+	root string
+}
+
+var _ Location = (*Local)(nil)
+
+func NewLocal(root string) *Local {
+	return &Local{root: filepath.Clean(root)}
+}
+
+// LocalPath returns the path of the local filesystem that corresponds to the
+// given path of the location.
+func (l *Local) LocalPath(name string) (string, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(l.root, filepath.FromSlash(rel)), nil
+}
+
+func (l *Local) Stat(ctx context.Context, name string) (Entry, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return Entry{}, err
+	}
+	fi, err := os.Stat(filepath.Join(l.root, filepath.FromSlash(rel)))
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return localEntry(rel, fi), nil
+}
+
+func (l *Local) List(ctx context.Context, name string) ([]Entry, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return nil, err
+	}
+	items, err := os.ReadDir(filepath.Join(l.root, filepath.FromSlash(rel)))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(items))
+	for _, item := range items {
+		fi, err := item.Info()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, localEntry(path.Join(rel, item.Name()), fi))
+	}
+
+	return entries, nil
+}
+
+func (l *Local) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	p, err := l.LocalPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(p)
+}
+
+func (l *Local) Write(ctx context.Context, name string, r io.Reader, size int64) (err error) {
+	p, err := l.LocalPath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), os.FileMode(0o770)); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(0o660))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	n, err := io.Copy(f, r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && n != size {
+		return fmt.Errorf("write %s: wrote %d bytes, expected %d", name, n, size)
+	}
+
+	return f.Sync()
+}
+
+func (l *Local) Close() error {
+	return nil
+}
+
+func localEntry(rel string, fi os.FileInfo) Entry {
+	entry := Entry{
+		Path:    rel,
+		ModTime: fi.ModTime(),
+		IsDir:   fi.IsDir(),
+	}
+	if !entry.IsDir {
+		entry.Size = fi.Size()
+	}
+
+	return entry
+}
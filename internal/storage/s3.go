@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3 is a location backed by a bucket of an S3-compatible service. Directories
+// are key prefixes, e.g. the directory "objects" holds the objects whose keys
+// start with "objects/".
+type S3 struct {
+	client *minio.Client
+	bucket string
+
+	// prefix of the keys of the location, without trailing slash.
+	prefix string
+}
+
+var _ Location = (*S3)(nil)
+
+// NewS3 returns a location rooted at the given key prefix of the bucket.
+func NewS3(prefix string, config S3Config) (*S3, error) {
+	return newS3(prefix, config, nil)
+}
+
+// newS3 accepts the transport used by the client, nil uses the default one.
+func newS3(prefix string, config S3Config, transport http.RoundTripper) (*S3, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:     credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure:    !config.Insecure,
+		Region:    config.Region,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3{
+		client: client,
+		bucket: config.Bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}, nil
+}
+
+// key returns the key of the object at the given path.
+func (s *S3) key(rel string) string {
+	return strings.TrimPrefix(path.Join(s.prefix, rel), "/")
+}
+
+// dirPrefix returns the prefix of the keys of the directory at the given path.
+func (s *S3) dirPrefix(rel string) string {
+	if key := s.key(rel); key != "" {
+		return key + "/"
+	}
+
+	return ""
+}
+
+func (s *S3) Stat(ctx context.Context, name string) (Entry, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return Entry{}, err
+	}
+	if rel == "" {
+		return Entry{IsDir: true}, nil
+	}
+
+	info, err := s.client.StatObject(ctx, s.bucket, s.key(rel), minio.StatObjectOptions{})
+	if err == nil {
+		return Entry{Path: rel, Size: info.Size, ModTime: info.LastModified}, nil
+	}
+	if !isNotFound(err) {
+		return Entry{}, err
+	}
+
+	// The path is a directory when there are objects under its prefix.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.dirPrefix(rel), MaxKeys: 1}) {
+		if obj.Err != nil {
+			return Entry{}, obj.Err
+		}
+		return Entry{Path: rel, IsDir: true}, nil
+	}
+
+	return Entry{}, fmt.Errorf("stat %s: %w", name, fs.ErrNotExist)
+}
+
+func (s *S3) List(ctx context.Context, name string) ([]Entry, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	prefix := s.dirPrefix(rel)
+	entries := []Entry{}
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		base := strings.TrimPrefix(obj.Key, prefix)
+		isDir := strings.HasSuffix(base, "/")
+		base = strings.TrimSuffix(base, "/")
+		if base == "" {
+			continue // Marker object of the directory itself.
+		}
+		// The names are not cleaned so keys like "../name" are not mistaken
+		// for other entries, Download rejects them.
+		entry := Entry{Path: base, IsDir: isDir}
+		if rel != "" {
+			entry.Path = rel + "/" + base
+		}
+		if !isDir {
+			entry.Size = obj.Size
+			entry.ModTime = obj.LastModified
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *S3) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, s.key(rel), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject does not send the request until the object is read.
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if isNotFound(err) {
+			return nil, fmt.Errorf("open %s: %w", name, fs.ErrNotExist)
+		}
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (s *S3) Write(ctx context.Context, name string, r io.Reader, size int64) error {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return err
+	}
+	if rel == "" {
+		return fmt.Errorf("%w %q: path is a directory", ErrInvalidPath, name)
+	}
+
+	_, err = s.client.PutObject(ctx, s.bucket, s.key(rel), r, size, minio.PutObjectOptions{})
+
+	return err
+}
+
+func (s *S3) Close() error {
+	return nil
+}
+
+func isNotFound(err error) bool {
+	resp := minio.ToErrorResponse(err)
+
+	return resp.StatusCode == http.StatusNotFound || resp.Code == "NoSuchKey"
+}
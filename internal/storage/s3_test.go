@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+// fakeS3 is a stand-in for MinIO that implements the subset of the S3 API
+// used by the location: HEAD, GET and PUT objects and ListObjectsV2.
+type fakeS3 struct {
+	bucket  string
+	objects map[string][]byte
+	mu      sync.Mutex
+}
+
+func newFakeS3(t *testing.T, bucket string, objects map[string]string) *httptest.Server {
+	t.Helper()
+
+	f := &fakeS3{bucket: bucket, objects: map[string][]byte{}}
+	for key, data := range objects {
+		f.objects[key] = []byte(data)
+	}
+
+	srv := httptest.NewTLSServer(f)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		f.list(w, r.URL.Query())
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", time.Unix(0, 0).UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		f.objects[key] = data
+		w.Header().Set("ETag", `"etag"`)
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, query url.Values) {
+	type object struct {
+		Key          string
+		Size         int
+		LastModified string
+	}
+	type prefix struct {
+		Prefix string
+	}
+	result := struct {
+		XMLName        xml.Name `xml:"ListBucketResult"`
+		Name           string
+		Prefix         string
+		KeyCount       int
+		IsTruncated    bool
+		Contents       []object
+		CommonPrefixes []prefix
+	}{Name: f.bucket, Prefix: query.Get("prefix")}
+
+	keys := []string{}
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	delimiter := query.Get("delimiter")
+	seen := map[string]bool{}
+	for _, key := range keys {
+		rest, ok := strings.CutPrefix(key, result.Prefix)
+		if !ok {
+			continue
+		}
+		if i := strings.Index(rest, delimiter); delimiter != "" && i >= 0 {
+			p := result.Prefix + rest[:i+1]
+			if !seen[p] {
+				seen[p] = true
+				result.CommonPrefixes = append(result.CommonPrefixes, prefix{p})
+			}
+			continue
+		}
+		result.Contents = append(result.Contents, object{
+			Key:          key,
+			Size:         len(f.objects[key]),
+			LastModified: time.Unix(0, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+	}{Code: code})
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// newLocation returns a location backed by a fake server that holds a
+	// few objects plus the extra objects given.
+	newLocation := func(t *testing.T, extra ...string) *S3 {
+		t.Helper()
+
+		objects := map[string]string{
+			"ts/images/objects/image.jpg": "12345",
+			"ts/images/notes.txt":         "123",
+			"ts/images/empty/":            "",
+			"other/file.txt":              "1",
+		}
+		for _, key := range extra {
+			objects[key] = "evil"
+		}
+		srv := newFakeS3(t, "transfers", objects)
+		loc, err := newS3("/ts/", S3Config{
+			Endpoint:  strings.TrimPrefix(srv.URL, "https://"),
+			Bucket:    "transfers",
+			Region:    "us-east-1",
+			AccessKey: "minio",
+			SecretKey: "minio123",
+		}, srv.Client().Transport)
+		assert.NilError(t, err)
+
+		return loc
+	}
+
+	t.Run("Describes files and directories", func(t *testing.T) {
+		t.Parallel()
+
+		loc := newLocation(t)
+
+		entry, err := loc.Stat(ctx, "/images/notes.txt")
+		assert.NilError(t, err)
+		assert.Equal(t, entry.Path, "images/notes.txt")
+		assert.Equal(t, entry.Size, int64(3))
+		assert.Assert(t, !entry.IsDir)
+
+		entry, err = loc.Stat(ctx, "images")
+		assert.NilError(t, err)
+		assert.Assert(t, entry.IsDir)
+
+		_, err = loc.Stat(ctx, "audio")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		_, err = loc.Stat(ctx, "../other/file.txt")
+		assert.ErrorIs(t, err, ErrInvalidPath)
+	})
+
+	t.Run("Lists directories", func(t *testing.T) {
+		t.Parallel()
+
+		loc := newLocation(t)
+
+		entries, err := loc.List(ctx, "images")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, entries, []Entry{
+			{Path: "images/notes.txt", Size: 3, ModTime: time.Unix(0, 0).UTC()},
+			{Path: "images/empty", IsDir: true},
+			{Path: "images/objects", IsDir: true},
+		})
+	})
+
+	t.Run("Reads and writes files", func(t *testing.T) {
+		t.Parallel()
+
+		loc := newLocation(t)
+
+		err := loc.Write(ctx, "images/objects/new.txt", strings.NewReader("new"), 3)
+		assert.NilError(t, err)
+
+		r, err := loc.Open(ctx, "images/objects/new.txt")
+		assert.NilError(t, err)
+		defer r.Close()
+		blob, err := io.ReadAll(r)
+		assert.NilError(t, err)
+		assert.Equal(t, string(blob), "new")
+
+		_, err = loc.Open(ctx, "images/objects/missing.txt")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("Downloads directories", func(t *testing.T) {
+		t.Parallel()
+
+		loc := newLocation(t)
+		dest := t.TempDir()
+
+		err := Download(ctx, loc, "images", dest, nil)
+		assert.NilError(t, err)
+		assertFile(t, dest, "objects/image.jpg", "12345")
+		assertFile(t, dest, "notes.txt", "123")
+		assertDir(t, dest, "empty")
+	})
+	t.Run("Rejects keys outside of the destination", func(t *testing.T) {
+		t.Parallel()
+
+		for _, key := range []string{
+			"ts/images/../../evil.txt",
+			"ts/images/objects/../../../evil.txt",
+			"ts/images/./evil.txt",
+		} {
+			loc := newLocation(t, key)
+			dir := t.TempDir()
+			dest := filepath.Join(dir, "images")
+
+			err := Download(ctx, loc, "images", dest, nil)
+			assert.ErrorIs(t, err, ErrInvalidPath, key)
+			_, err = os.Stat(filepath.Join(dir, "evil.txt"))
+			assert.ErrorIs(t, err, fs.ErrNotExist, key)
+		}
+	})
+}
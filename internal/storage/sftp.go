@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SFTP is a location backed by a directory of an SFTP server. The connection
+// is established when the location is first used and again after it is lost.
+type SFTP struct {
+	// root is the directory of the location on the server.
+	root string
+
+	// dial connects to the server.
+	dial func() (*sftp.Client, io.Closer, error)
+
+	// client and conn are protected by mu.
+	client *sftp.Client
+	conn   io.Closer
+	mu     sync.Mutex
+}
+
+var _ Location = (*SFTP)(nil)
+
+// NewSFTP returns a location rooted at the given directory of the server, the
+// home directory of the user when it is empty.
+func NewSFTP(root string, config SFTPConfig) (*SFTP, error) {
+	sshConfig := &ssh.ClientConfig{User: config.User}
+
+	if config.Password != "" {
+		sshConfig.Auth = append(sshConfig.Auth, ssh.Password(config.Password))
+	}
+	if config.KeyFile != "" {
+		blob, err := os.ReadFile(config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read key file: %v", err)
+		}
+		signer, err := ssh.ParsePrivateKey(blob)
+		if err != nil {
+			return nil, fmt.Errorf("parse key file: %v", err)
+		}
+		sshConfig.Auth = append(sshConfig.Auth, ssh.PublicKeys(signer))
+	}
+
+	switch {
+	case config.KnownHostsFile != "":
+		callback, err := knownhosts.New(config.KnownHostsFile)
+		if err != nil {
+			return nil, fmt.Errorf("read known hosts file: %v", err)
+		}
+		sshConfig.HostKeyCallback = callback
+	case config.Insecure:
+		sshConfig.HostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint: gosec
+	default:
+		return nil, errors.New("missing known hosts file")
+	}
+
+	return newSFTP(root, func() (*sftp.Client, io.Closer, error) {
+		conn, err := ssh.Dial("tcp", config.Address, sshConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("dial %s: %v", config.Address, err)
+		}
+		client, err := sftp.NewClient(conn)
+		if err != nil {
+			_ = conn.Close()
+			return nil, nil, fmt.Errorf("start sftp session: %v", err)
+		}
+		return client, conn, nil
+	}), nil
+}
+
+func newSFTP(root string, dial func() (*sftp.Client, io.Closer, error)) *SFTP {
+	if root == "" {
+		root = "."
+	}
+
+	return &SFTP{root: root, dial: dial}
+}
+
+// session returns the client, connecting to the server if needed. The client
+// is dropped once its connection closes so the next operation connects again.
+func (s *SFTP) session() (*sftp.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	client, conn, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	s.client, s.conn = client, conn
+
+	go func() {
+		_ = client.Wait()
+
+		s.mu.Lock()
+		if s.client == client {
+			_ = s.disconnect()
+		}
+		s.mu.Unlock()
+	}()
+
+	return client, nil
+}
+
+// disconnect must be called with the lock held.
+func (s *SFTP) disconnect() error {
+	if s.client == nil {
+		return nil
+	}
+
+	err := s.client.Close()
+	if s.conn != nil {
+		err = errors.Join(err, s.conn.Close())
+	}
+	s.client, s.conn = nil, nil
+
+	return err
+}
+
+// remotePath returns the path of the server and the canonical path of the
+// location for the given path.
+func (s *SFTP) remotePath(name string) (string, string, error) {
+	rel, err := CleanPath(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return path.Join(s.root, rel), rel, nil
+}
+
+func (s *SFTP) Stat(ctx context.Context, name string) (Entry, error) {
+	p, rel, err := s.remotePath(name)
+	if err != nil {
+		return Entry{}, err
+	}
+	client, err := s.session()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	fi, err := client.Stat(p)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return localEntry(rel, fi), nil
+}
+
+func (s *SFTP) List(ctx context.Context, name string) ([]Entry, error) {
+	p, rel, err := s.remotePath(name)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.session()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := client.ReadDirContext(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(items))
+	for _, fi := range items {
+		entries = append(entries, localEntry(path.Join(rel, fi.Name()), fi))
+	}
+
+	return entries, nil
+}
+
+func (s *SFTP) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	p, _, err := s.remotePath(name)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.session()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := client.Open(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *SFTP) Write(ctx context.Context, name string, r io.Reader, size int64) (err error) {
+	p, rel, err := s.remotePath(name)
+	if err != nil {
+		return err
+	}
+	if rel == "" {
+		return fmt.Errorf("%w %q: path is a directory", ErrInvalidPath, name)
+	}
+	client, err := s.session()
+	if err != nil {
+		return err
+	}
+	if err := client.MkdirAll(path.Dir(p)); err != nil {
+		return err
+	}
+	f, err := client.Create(p)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	n, err := f.ReadFrom(r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && n != size {
+		return fmt.Errorf("write %s: wrote %d bytes, expected %d", name, n, size)
+	}
+
+	return nil
+}
+
+func (s *SFTP) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.disconnect()
+}
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"gotest.tools/v3/assert"
+	tfs "gotest.tools/v3/fs"
+	"gotest.tools/v3/poll"
+)
+
+// newPipeSFTP returns a location connected to an in-process SFTP server
+// through a pipe, dials counts the connections made.
+func newPipeSFTP(t *testing.T, root string, dials *int) *SFTP {
+	t.Helper()
+
+	loc := newSFTP(root, func() (*sftp.Client, io.Closer, error) {
+		*dials++
+		serverConn, clientConn := net.Pipe()
+		server, err := sftp.NewServer(serverConn)
+		if err != nil {
+			return nil, nil, err
+		}
+		go func() { _ = server.Serve() }()
+
+		client, err := sftp.NewClientPipe(clientConn, clientConn)
+		if err != nil {
+			return nil, nil, err
+		}
+		return client, server, nil
+	})
+	t.Cleanup(func() { _ = loc.Close() })
+
+	return loc
+}
+
+func TestSFTP(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newDir := func(t *testing.T) *tfs.Dir {
+		t.Helper()
+
+		return tfs.NewDir(t, "ccp",
+			tfs.WithDir("images",
+				tfs.WithDir("objects", tfs.WithFile("image.jpg", "12345")),
+				tfs.WithFile("notes.txt", "123"),
+			),
+		)
+	}
+
+	t.Run("Describes and lists files and directories", func(t *testing.T) {
+		t.Parallel()
+
+		var dials int
+		loc := newPipeSFTP(t, newDir(t).Path(), &dials)
+
+		entry, err := loc.Stat(ctx, "images/notes.txt")
+		assert.NilError(t, err)
+		assert.Equal(t, entry.Path, "images/notes.txt")
+		assert.Equal(t, entry.Size, int64(3))
+
+		entries, err := loc.List(ctx, "/images/")
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 2)
+
+		_, err = loc.Stat(ctx, "audio")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		assert.Equal(t, dials, 1) // The connection is reused.
+	})
+
+	t.Run("Reads and writes files", func(t *testing.T) {
+		t.Parallel()
+
+		var dials int
+		dir := newDir(t)
+		loc := newPipeSFTP(t, dir.Path(), &dials)
+
+		err := loc.Write(ctx, "audio/track.mp3", strings.NewReader("track"), 5)
+		assert.NilError(t, err)
+		assertFile(t, dir.Path(), "audio/track.mp3", "track")
+
+		err = loc.Write(ctx, "audio/short.mp3", strings.NewReader("track"), 6)
+		assert.ErrorContains(t, err, "wrote 5 bytes, expected 6")
+
+		r, err := loc.Open(ctx, "images/objects/image.jpg")
+		assert.NilError(t, err)
+		defer r.Close()
+		blob, err := io.ReadAll(r)
+		assert.NilError(t, err)
+		assert.Equal(t, string(blob), "12345")
+	})
+
+	t.Run("Connects again when the connection is lost", func(t *testing.T) {
+		t.Parallel()
+
+		var dials int
+		loc := newPipeSFTP(t, newDir(t).Path(), &dials)
+
+		_, err := loc.Stat(ctx, "images")
+		assert.NilError(t, err)
+		loc.mu.Lock()
+		_ = loc.conn.Close() // Stops the server.
+		loc.mu.Unlock()
+
+		poll.WaitOn(t, func(poll.LogT) poll.Result {
+			if _, err := loc.Stat(ctx, "images"); err != nil {
+				return poll.Continue("stat: %v", err)
+			}
+			return poll.Success()
+		}, poll.WithDelay(10*time.Millisecond))
+		assert.Equal(t, dials, 2)
+	})
+
+	t.Run("Downloads directories", func(t *testing.T) {
+		t.Parallel()
+
+		var dials int
+		loc := newPipeSFTP(t, newDir(t).Path(), &dials)
+		dest := t.TempDir()
+
+		err := Download(ctx, loc, "images", dest, nil)
+		assert.NilError(t, err)
+		assertFile(t, dest, "objects/image.jpg", "12345")
+		assertFile(t, dest, "notes.txt", "123")
+	})
+}
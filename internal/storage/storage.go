@@ -0,0 +1,296 @@
+// Package storage provides access to the locations where the transfer sources
+// are stored, e.g. a local directory, an S3-compatible bucket or an SFTP
+// server.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidPath is returned when a path is not valid within a location, e.g.
+// when it points outside of the location.
+var ErrInvalidPath = errors.New("invalid path")
+
+// Location is a place where files are stored. Paths are slash-separated and
+// relative to the root of the location, the root is the empty path.
+type Location interface {
+	// Stat describes the file or directory at the given path. The error
+	// wraps fs.ErrNotExist when there is nothing at the given path.
+	Stat(ctx context.Context, path string) (Entry, error)
+
+	// List returns the entries of the directory at the given path.
+	List(ctx context.Context, path string) ([]Entry, error)
+
+	// Open opens the file at the given path for reading.
+	Open(ctx context.Context, path string) (io.ReadCloser, error)
+
+	// Write creates or replaces the file at the given path with the size
+	// bytes read from r. Parent directories are created as needed.
+	Write(ctx context.Context, path string, r io.Reader, size int64) error
+
+	// Close releases the resources used by the location.
+	Close() error
+}
+
+// Entry describes a file or a directory of a location.
+type Entry struct {
+	// Path of the entry relative to the root of the location.
+	Path string
+
+	// Size in bytes, zero for directories.
+	Size int64
+
+	// Modification time, it may not be known for directories.
+	ModTime time.Time
+
+	IsDir bool
+}
+
+// Name returns the last element of the path of the entry.
+func (e Entry) Name() string {
+	return path.Base(e.Path)
+}
+
+// CleanPath returns the canonical form of a path within a location, e.g.
+// "/objects/" becomes "objects", or ErrInvalidPath when the path points
+// outside of the location.
+func CleanPath(name string) (string, error) {
+	rel := strings.Trim(name, "/")
+	if rel == "" {
+		return "", nil
+	}
+	rel = path.Clean(rel)
+	if !fs.ValidPath(rel) {
+		return "", fmt.Errorf("%w %q", ErrInvalidPath, name)
+	}
+
+	return rel, nil
+}
+
+// Walk calls fn for every file and directory found under the given path,
+// including the path itself. Entries of a directory are visited in the order
+// returned by the location.
+func Walk(ctx context.Context, loc Location, name string, fn func(Entry) error) error {
+	entry, err := loc.Stat(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return walk(ctx, loc, entry, fn)
+}
+
+func walk(ctx context.Context, loc Location, entry Entry, fn func(Entry) error) error {
+	if err := fn(entry); err != nil {
+		return err
+	}
+	if !entry.IsDir {
+		return nil
+	}
+
+	entries, err := loc.List(ctx, entry.Path)
+	if err != nil {
+		return err
+	}
+	for _, item := range entries {
+		if err := walk(ctx, loc, item, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Download copies the file or directory at the given path of the location to
+// dest in the local filesystem. The readers of the files copied are wrapped
+// with wrap unless it is nil, e.g. to report progress.
+//
+// Entries with names that would be written outside of dest, e.g. object keys
+// containing "..", are rejected with ErrInvalidPath.
+func Download(ctx context.Context, loc Location, name, dest string, wrap func(io.Reader) io.Reader) error {
+	root, err := CleanPath(name)
+	if err != nil {
+		return err
+	}
+
+	top := true
+	return Walk(ctx, loc, root, func(entry Entry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := downloadPath(root, entry.Path, top)
+		if err != nil {
+			return err
+		}
+		top = false
+		target := filepath.Join(dest, filepath.FromSlash(rel))
+
+		if entry.IsDir {
+			return os.MkdirAll(target, os.FileMode(0o770))
+		}
+
+		return downloadFile(ctx, loc, entry.Path, target, wrap)
+	})
+}
+
+// downloadPath returns the path of the entry relative to root. Only the top
+// entry can be root itself, the others must be clean paths under root so they
+// are written under the destination.
+func downloadPath(root, name string, top bool) (string, error) {
+	if top && name == root {
+		return "", nil
+	}
+
+	rel := name
+	if root != "" {
+		var ok bool
+		if rel, ok = strings.CutPrefix(name, root+"/"); !ok {
+			return "", fmt.Errorf("%w %q", ErrInvalidPath, name)
+		}
+	}
+	if clean, err := CleanPath(rel); err != nil || clean != rel || rel == "" || rel == "." || !filepath.IsLocal(filepath.FromSlash(rel)) {
+		return "", fmt.Errorf("%w %q", ErrInvalidPath, name)
+	}
+
+	return rel, nil
+}
+
+func downloadFile(ctx context.Context, loc Location, name, dest string, wrap func(io.Reader) io.Reader) (err error) {
+	src, err := loc.Open(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(0o660))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var r io.Reader = src
+	if wrap != nil {
+		r = wrap(r)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("download %s: %w", name, err)
+	}
+
+	return f.Sync()
+}
+
+// Registry holds the locations set in the configuration indexed by their
+// identifiers.
+type Registry struct {
+	locations map[uuid.UUID]*registered
+}
+
+type registered struct {
+	Location
+	name string
+}
+
+// NewRegistry creates the locations set in the configuration. Remote
+// locations do not connect until they are used.
+func NewRegistry(config Config) (*Registry, error) {
+	r := &Registry{locations: make(map[uuid.UUID]*registered, len(config.Locations))}
+
+	for _, item := range config.Locations {
+		loc, err := Open(item)
+		if err != nil {
+			_ = r.Close()
+			return nil, fmt.Errorf("open location %s: %v", item.ID, err)
+		}
+		if err := r.Register(item.ID, item.Name, loc); err != nil {
+			_ = loc.Close()
+			_ = r.Close()
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Register adds a location to the registry, e.g. a location with a backend
+// that cannot be set in the configuration. The name defaults to the
+// identifier.
+func (r *Registry) Register(id uuid.UUID, name string, loc Location) error {
+	if _, ok := r.locations[id]; ok {
+		return fmt.Errorf("location %s is duplicated", id)
+	}
+	if name == "" {
+		name = id.String()
+	}
+	r.locations[id] = &registered{Location: loc, name: name}
+
+	return nil
+}
+
+// Location returns the location with the given identifier. It is safe to call
+// on a nil registry.
+func (r *Registry) Location(id uuid.UUID) (Location, bool) {
+	if r == nil {
+		return nil, false
+	}
+	item, ok := r.locations[id]
+	if !ok {
+		return nil, false
+	}
+
+	return item.Location, true
+}
+
+// Name returns the name of the location with the given identifier, it
+// defaults to the identifier when the configuration does not name it.
+func (r *Registry) Name(id uuid.UUID) string {
+	if r != nil {
+		if item, ok := r.locations[id]; ok {
+			return item.name
+		}
+	}
+
+	return id.String()
+}
+
+// Close closes all the locations.
+func (r *Registry) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	var errs error
+	for _, item := range r.locations {
+		errs = errors.Join(errs, item.Close())
+	}
+
+	return errs
+}
+
+// Open creates the location described by the configuration.
+func Open(config LocationConfig) (Location, error) {
+	switch config.Type {
+	case TypeLocal, "":
+		return NewLocal(config.Path), nil
+	case TypeS3:
+		return NewS3(config.Path, config.S3)
+	case TypeSFTP:
+		return NewSFTP(config.Path, config.SFTP)
+	default:
+		return nil, fmt.Errorf("unknown type %q", config.Type)
+	}
+}
@@ -0,0 +1,239 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func assertFile(t *testing.T, dir, name, want string) {
+	t.Helper()
+
+	blob, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	assert.NilError(t, err)
+	assert.Equal(t, string(blob), want)
+}
+
+func assertDir(t *testing.T, dir, name string) {
+	t.Helper()
+
+	fi, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+	assert.NilError(t, err)
+	assert.Assert(t, fi.IsDir())
+}
+
+func TestCleanPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		"Root":                 {path: "/", want: ""},
+		"Empty":                {path: "", want: ""},
+		"Trims slashes":        {path: "/objects/", want: "objects"},
+		"Cleans":               {path: "objects//a/../b", want: "objects/b"},
+		"Rejects parent paths": {path: "../etc", wantErr: true},
+		"Rejects escapes":      {path: "objects/../../etc", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CleanPath(tc.path)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPath)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tc.want)
+		})
+	}
+}
+
+func TestLocal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newLocation := func(t *testing.T) (*Local, *fs.Dir) {
+		t.Helper()
+
+		dir := fs.NewDir(t, "ccp",
+			fs.WithDir("images",
+				fs.WithDir("objects", fs.WithFile("image.jpg", "12345")),
+				fs.WithFile("notes.txt", "123"),
+			),
+		)
+
+		return NewLocal(dir.Path()), dir
+	}
+
+	t.Run("Resolves local paths", func(t *testing.T) {
+		t.Parallel()
+
+		loc, dir := newLocation(t)
+
+		p, err := loc.LocalPath("/images/objects")
+		assert.NilError(t, err)
+		assert.Equal(t, p, dir.Join("images", "objects"))
+
+		_, err = loc.LocalPath("../images")
+		assert.ErrorIs(t, err, ErrInvalidPath)
+	})
+
+	t.Run("Walks directories", func(t *testing.T) {
+		t.Parallel()
+
+		loc, _ := newLocation(t)
+
+		paths := []string{}
+		err := Walk(ctx, loc, "images", func(entry Entry) error {
+			paths = append(paths, entry.Path)
+			return nil
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, paths, []string{"images", "images/notes.txt", "images/objects", "images/objects/image.jpg"})
+	})
+
+	t.Run("Writes files", func(t *testing.T) {
+		t.Parallel()
+
+		loc, dir := newLocation(t)
+
+		err := loc.Write(ctx, "audio/track.mp3", strings.NewReader("track"), 5)
+		assert.NilError(t, err)
+		assertFile(t, dir.Path(), "audio/track.mp3", "track")
+	})
+
+	t.Run("Downloads files with progress", func(t *testing.T) {
+		t.Parallel()
+
+		loc, _ := newLocation(t)
+		dest := filepath.Join(t.TempDir(), "notes.txt")
+
+		var n int64
+		err := Download(ctx, loc, "images/notes.txt", dest, func(r io.Reader) io.Reader {
+			return &countingReader{r: r, n: &n}
+		})
+		assert.NilError(t, err)
+		assertFile(t, filepath.Dir(dest), "notes.txt", "123")
+		assert.Equal(t, n, int64(3))
+	})
+}
+
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	*r.n += int64(n)
+	return n, err
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+
+	r, err := NewRegistry(Config{Locations: []LocationConfig{
+		{ID: id, Type: TypeLocal, Path: t.TempDir()},
+	}})
+	assert.NilError(t, err)
+	t.Cleanup(func() { _ = r.Close() })
+
+	loc, ok := r.Location(id)
+	assert.Assert(t, ok)
+	_, ok = loc.(*Local)
+	assert.Assert(t, ok)
+	assert.Equal(t, r.Name(id), id.String())
+
+	_, ok = r.Location(uuid.New())
+	assert.Assert(t, !ok)
+
+	var nilRegistry *Registry
+	_, ok = nilRegistry.Location(id)
+	assert.Assert(t, !ok)
+
+	_, err = NewRegistry(Config{Locations: []LocationConfig{
+		{ID: id, Path: "/a"},
+		{ID: id, Path: "/b"},
+	}})
+	assert.Error(t, err, "location c059a454-dafa-418e-a126-74d0c7219ce6 is duplicated")
+}
+
+func TestParseLocation(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("c059a454-dafa-418e-a126-74d0c7219ce6")
+
+	tests := map[string]struct {
+		value   string
+		want    LocationConfig
+		wantErr string
+	}{
+		"Parses a local location": {
+			value: "id=c059a454-dafa-418e-a126-74d0c7219ce6 path=/home/",
+			want:  LocationConfig{ID: id, Type: TypeLocal, Path: "/home"},
+		},
+		"Parses an S3 location": {
+			value: "id=c059a454-dafa-418e-a126-74d0c7219ce6 name=minio type=s3 endpoint=minio:9000 bucket=sips path=ts access-key=minio secret-key=minio123 insecure=true",
+			want: LocationConfig{ID: id, Name: "minio", Type: TypeS3, Path: "ts", S3: S3Config{
+				Endpoint: "minio:9000", Bucket: "sips", AccessKey: "minio", SecretKey: "minio123", Insecure: true,
+			}, SFTP: SFTPConfig{Insecure: true}},
+		},
+		"Parses an SFTP location": {
+			value: "id=c059a454-dafa-418e-a126-74d0c7219ce6 type=sftp address=sftp:22 user=archivist password=s3cr3t known-hosts=/etc/ccp/known_hosts path=/transfers",
+			want: LocationConfig{ID: id, Type: TypeSFTP, Path: "/transfers", SFTP: SFTPConfig{
+				Address: "sftp:22", User: "archivist", Password: "s3cr3t", KnownHostsFile: "/etc/ccp/known_hosts",
+			}},
+		},
+		"Rejects missing identifiers": {
+			value:   "path=/home",
+			wantErr: "missing id",
+		},
+		"Rejects relative local paths": {
+			value:   "id=c059a454-dafa-418e-a126-74d0c7219ce6 path=home",
+			wantErr: `invalid path "home": path is not absolute`,
+		},
+		"Rejects S3 locations without bucket": {
+			value:   "id=c059a454-dafa-418e-a126-74d0c7219ce6 type=s3 endpoint=minio:9000",
+			wantErr: "missing bucket",
+		},
+		"Rejects SFTP locations without host verification": {
+			value:   "id=c059a454-dafa-418e-a126-74d0c7219ce6 type=sftp address=sftp:22 user=archivist password=s3cr3t",
+			wantErr: "missing known-hosts",
+		},
+		"Rejects unknown types": {
+			value:   "id=c059a454-dafa-418e-a126-74d0c7219ce6 type=ftp",
+			wantErr: `unknown type "ftp"`,
+		},
+		"Rejects unknown keys": {
+			value:   "id=c059a454-dafa-418e-a126-74d0c7219ce6 path=/home purpose=TS",
+			wantErr: `invalid field "purpose=TS": unknown key`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseLocation(tc.value)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
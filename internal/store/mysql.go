@@ -3,9 +3,11 @@ package store
 import (
 	"context"
 	"database/sql"
+	_ "embed"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -74,7 +76,17 @@ type mysqlStoreImpl struct {
 
 var _ Store = (*mysqlStoreImpl)(nil)
 
+// myCCPSchema creates the tables owned by CCP, i.e. those that are not part
+// of the Archivematica schema.
+//
+//go:embed sqlc/mysql/ccp.sql
+var myCCPSchema string
+
 func newMySQLStore(logger logr.Logger, pool *sql.DB) (*mysqlStoreImpl, error) {
+	if _, err := pool.ExecContext(context.Background(), myCCPSchema); err != nil {
+		return nil, fmt.Errorf("error creating tables: %v", err)
+	}
+
 	queries, err := sqlc.Prepare(context.Background(), pool)
 	if err != nil {
 		return nil, err
@@ -91,7 +103,10 @@ func newMySQLStore(logger logr.Logger, pool *sql.DB) (*mysqlStoreImpl, error) {
 func (s *mysqlStoreImpl) RemoveTransientData(ctx context.Context) (err error) {
 	defer wrap(&err, "RemoveTransientData")
 
-	conn, _ := s.pool.Conn(ctx)
+	conn, err := s.pool.Conn(ctx)
+	if err != nil {
+		return err
+	}
 	defer conn.Close()
 
 	q := sqlc.New(conn)
@@ -125,10 +140,10 @@ func (s *mysqlStoreImpl) RemoveTransientData(ctx context.Context) (err error) {
 	return nil
 }
 
-func (s *mysqlStoreImpl) CreateJob(ctx context.Context, params *sqlc.CreateJobParams) (err error) {
+func (s *mysqlStoreImpl) CreateJob(ctx context.Context, params *CreateJobParams) (err error) {
 	defer wrap(&err, "CreateJob")
 
-	return s.queries.CreateJob(ctx, params)
+	return s.queries.CreateJob(ctx, (*sqlc.CreateJobParams)(params))
 }
 
 func (s *mysqlStoreImpl) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
@@ -247,6 +262,34 @@ func (s *mysqlStoreImpl) CreateTasks(ctx context.Context, tasks []*Task) (err er
 	return nil
 }
 
+func (s *mysqlStoreImpl) UpdateTasks(ctx context.Context, tasks []*Task) (err error) {
+	defer wrap(&err, "UpdateTasks(tasks)")
+
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := s.queries.WithTx(tx)
+	for _, task := range tasks {
+		params := &sqlc.UpdateTaskParams{
+			Stdout:   task.Stdout,
+			Stderror: task.Stderr,
+			Endtime:  task.EndedAt,
+			Taskuuid: task.ID,
+		}
+		if task.ExitCode.Valid {
+			params.Exitcode = sql.NullInt64{Int64: int64(task.ExitCode.Int16), Valid: true}
+		}
+		if err := q.UpdateTask(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (s *mysqlStoreImpl) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) (ret []*adminv1.Package, err error) {
 	defer wrap(&err, "ReadPackagesWithCreationTimestamps(tasks)")
 
@@ -330,6 +373,43 @@ func (s *mysqlStoreImpl) UpdatePackageStatus(ctx context.Context, id uuid.UUID,
 	return err
 }
 
+func (s *mysqlStoreImpl) ListProcessingPackages(ctx context.Context) (_ []ProcessingPackage, err error) {
+	defer wrap(&err, "ListProcessingPackages")
+
+	transfers, err := s.queries.ListProcessingTransfers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sips, err := s.queries.ListProcessingSIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]ProcessingPackage, 0, len(transfers)+len(sips))
+	for _, item := range transfers {
+		ret = append(ret, ProcessingPackage{
+			ID:   item.Transferuuid,
+			Type: enums.PackageTypeTransfer,
+			Path: item.Currentlocation,
+		})
+	}
+	for _, item := range sips {
+		pkg := ProcessingPackage{
+			ID:   item.SIPID,
+			Type: enums.PackageTypeSIP,
+			Path: item.Currentpath.String,
+		}
+		// DIPs are also recorded in the SIPs table.
+		if item.Siptype == "DIP" {
+			pkg.Type = enums.PackageTypeDIP
+		}
+		ret = append(ret, pkg)
+	}
+
+	return ret, nil
+}
+
 func (s *mysqlStoreImpl) ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error) {
 	defer wrap(&err, "ReadTransferLocation(%s)", id)
 
@@ -488,6 +568,16 @@ func (s *mysqlStoreImpl) UpdateTransferLocation(ctx context.Context, id uuid.UUI
 	})
 }
 
+func (s *mysqlStoreImpl) HidePackage(ctx context.Context, id uuid.UUID) (err error) {
+	defer wrap(&err, "HidePackage(%s)", id)
+
+	if err := s.queries.HideTransfer(ctx, id); err != nil {
+		return err
+	}
+
+	return s.queries.HideSIP(ctx, id)
+}
+
 func (s *mysqlStoreImpl) ReadSIP(ctx context.Context, id uuid.UUID) (_ SIP, err error) {
 	defer wrap(&err, "ReadSIP(%s)", id)
 
@@ -871,6 +961,22 @@ func (s *mysqlStoreImpl) CreateUnitVar(ctx context.Context, id uuid.UUID, packag
 	}
 }
 
+func (s *mysqlStoreImpl) DeleteUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (err error) {
+	defer wrap(&err, "DeleteUnitVar(%s, %s, %s)", id, packageType, name)
+
+	return s.queries.DeleteUnitVar(ctx, &sqlc.DeleteUnitVarParams{
+		UnitID: id,
+		UnitType: sql.NullString{
+			String: packageType.String(),
+			Valid:  true,
+		},
+		Name: sql.NullString{
+			String: name,
+			Valid:  true,
+		},
+	})
+}
+
 func (s *mysqlStoreImpl) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) (_ []File, err error) {
 	defer wrap(&err, "Files(%s, %s, %s, %s, %s)", id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
 
@@ -944,7 +1050,7 @@ func (s *mysqlStoreImpl) ReadDict(ctx context.Context, name string) (_ map[strin
 
 	ret := make(map[string]string, ln)
 	for _, row := range rows {
-		ret[row.Name] = ret[row.Value]
+		ret[row.Name] = row.Value
 	}
 
 	return ret, nil
@@ -977,6 +1083,126 @@ func (s *mysqlStoreImpl) ValidateUserAPIKey(ctx context.Context, username, key s
 	return ret, nil
 }
 
+func (s *mysqlStoreImpl) CreateWebhook(ctx context.Context, webhook *Webhook) (err error) {
+	defer wrap(&err, "CreateWebhook(%s)", webhook.ID)
+
+	return s.queries.CreateWebhook(ctx, &sqlc.CreateWebhookParams{
+		Webhookuuid: webhook.ID,
+		Url:         webhook.URL,
+		Secret:      webhook.Secret,
+		Events:      strings.Join(webhook.Events, ","),
+		CreatedAt:   webhook.CreatedAt,
+	})
+}
+
+func (s *mysqlStoreImpl) ListWebhooks(ctx context.Context) (_ []*Webhook, err error) {
+	defer wrap(&err, "ListWebhooks")
+
+	rows, err := s.queries.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*Webhook, 0, len(rows))
+	for _, row := range rows {
+		item := &Webhook{
+			ID:        row.Webhookuuid,
+			URL:       row.Url,
+			Secret:    row.Secret,
+			CreatedAt: row.CreatedAt,
+		}
+		if row.Events != "" {
+			item.Events = strings.Split(row.Events, ",")
+		}
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
+
+func (s *mysqlStoreImpl) DeleteWebhook(ctx context.Context, id uuid.UUID) (err error) {
+	defer wrap(&err, "DeleteWebhook(%s)", id)
+
+	n, err := s.queries.DeleteWebhook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *mysqlStoreImpl) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (err error) {
+	defer wrap(&err, "CreateWebhookDelivery(%s)", delivery.ID)
+
+	params := &sqlc.CreateWebhookDeliveryParams{
+		Deliveryuuid: delivery.ID,
+		Webhookuuid:  delivery.WebhookID,
+		Event:        delivery.Event,
+		Packageuuid:  delivery.PackageID,
+		Payload:      delivery.Payload,
+		Attempts:     int32(delivery.Attempts),
+		Deadletter:   delivery.DeadLetter,
+		CreatedAt:    delivery.CreatedAt,
+	}
+	if delivery.StatusCode != 0 {
+		params.Statuscode = sql.NullInt32{Int32: int32(delivery.StatusCode), Valid: true}
+	}
+	if delivery.Error != "" {
+		params.Error = sql.NullString{String: delivery.Error, Valid: true}
+	}
+	if !delivery.CompletedAt.IsZero() {
+		params.Completedtime = sql.NullTime{Time: delivery.CompletedAt, Valid: true}
+	}
+
+	return s.queries.CreateWebhookDelivery(ctx, params)
+}
+
+func (s *mysqlStoreImpl) ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) (_ []*WebhookDelivery, err error) {
+	defer wrap(&err, "ListWebhookDeliveries(%s, %d)", webhookID, limit)
+
+	var rows []*sqlc.Webhookdelivery
+	if webhookID == uuid.Nil {
+		rows, err = s.queries.ListWebhookDeliveries(ctx, int32(limit))
+	} else {
+		rows, err = s.queries.ListWebhookDeliveriesByWebhook(ctx, &sqlc.ListWebhookDeliveriesByWebhookParams{
+			Webhookuuid: webhookID,
+			Limit:       int32(limit),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		item := &WebhookDelivery{
+			ID:         row.Deliveryuuid,
+			WebhookID:  row.Webhookuuid,
+			Event:      row.Event,
+			PackageID:  row.Packageuuid,
+			Payload:    row.Payload,
+			Attempts:   int(row.Attempts),
+			DeadLetter: row.Deadletter,
+			CreatedAt:  row.CreatedAt,
+		}
+		if row.Statuscode.Valid {
+			item.StatusCode = int(row.Statuscode.Int32)
+		}
+		if row.Error.Valid {
+			item.Error = row.Error.String
+		}
+		if row.Completedtime.Valid {
+			item.CompletedAt = row.Completedtime.Time
+		}
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
+
 func (s *mysqlStoreImpl) Running() bool {
 	return s != nil
 }
@@ -1,9 +1,15 @@
 package store
 
 import (
+	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
+	"github.com/go-logr/logr"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
@@ -21,3 +27,45 @@ func TestAddDecimalPrecision(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, pkg.CreatedAt.AsTime().Format(time.RFC3339Nano), "2011-01-05T12:21:40.123456789Z")
 }
+
+func TestMySQLStore(t *testing.T) {
+	dsn := os.Getenv("CCP_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("Skipping MySQL tests (CCP_TEST_MYSQL_DSN is not set).")
+	}
+
+	t.Parallel()
+
+	config, err := mysqldriver.ParseDSN(dsn)
+	assert.NilError(t, err)
+
+	admin, err := connectToMySQL(logr.Discard(), dsn)
+	assert.NilError(t, err)
+	t.Cleanup(func() { admin.Close() })
+
+	// The Archivematica tables are created by the dashboard migrations, load
+	// the dump used by sqlc instead.
+	schema, err := os.ReadFile("sqlc/mysql/schema.sql")
+	assert.NilError(t, err)
+
+	testStore(t, func(t *testing.T) (Store, *goqu.Database) {
+		t.Helper()
+
+		name := "ccp_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		_, err := admin.Exec("CREATE DATABASE " + name)
+		assert.NilError(t, err)
+		t.Cleanup(func() { _, _ = admin.Exec("DROP DATABASE " + name) })
+
+		config := config.Clone()
+		config.DBName = name
+		pool, err := connectToMySQL(logr.Discard(), config.FormatDSN())
+		assert.NilError(t, err)
+		_, err = pool.Exec(string(schema))
+		assert.NilError(t, err)
+		s, err := newMySQLStore(logr.Discard(), pool)
+		assert.NilError(t, err)
+		t.Cleanup(func() { s.Close() })
+
+		return s, s.goqu
+	})
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"gotest.tools/v3/assert"
+)
+
+func TestPostgresStore(t *testing.T) {
+	dsn := os.Getenv("CCP_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("Skipping PostgreSQL tests (CCP_TEST_POSTGRES_DSN is not set).")
+	}
+
+	t.Parallel()
+
+	// The database of each store is set in the path of the URL.
+	u, err := url.Parse(dsn)
+	assert.NilError(t, err)
+
+	admin, err := connectToPostgres(logr.Discard(), dsn)
+	assert.NilError(t, err)
+	t.Cleanup(func() { admin.Close() })
+
+	testStore(t, func(t *testing.T) (Store, *goqu.Database) {
+		t.Helper()
+
+		name := "ccp_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		_, err := admin.Exec("CREATE DATABASE " + name)
+		assert.NilError(t, err)
+		t.Cleanup(func() { _, _ = admin.Exec("DROP DATABASE " + name) })
+
+		u := *u
+		u.Path = "/" + name
+		pool, err := connectToPostgres(logr.Discard(), u.String())
+		assert.NilError(t, err)
+		s, err := newPostgresStore(logr.Discard(), pool)
+		assert.NilError(t, err)
+		t.Cleanup(func() { s.Close() })
+
+		return s, s.goqu
+	})
+}
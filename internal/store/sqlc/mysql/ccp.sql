@@ -0,0 +1,27 @@
+-- Tables owned by CCP. They are not part of the Archivematica schema, the
+-- store creates them when they are missing during startup.
+
+CREATE TABLE IF NOT EXISTS `Webhooks` (
+  `webhookUUID` varchar(36) NOT NULL,
+  `url` longtext NOT NULL,
+  `secret` longtext NOT NULL,
+  `events` varchar(255) NOT NULL,
+  `createdTime` datetime(6) NOT NULL,
+  PRIMARY KEY (`webhookUUID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE IF NOT EXISTS `WebhookDeliveries` (
+  `deliveryUUID` varchar(36) NOT NULL,
+  `webhookUUID` varchar(36) NOT NULL,
+  `event` varchar(50) NOT NULL,
+  `packageUUID` varchar(36) NOT NULL,
+  `payload` longtext NOT NULL,
+  `attempts` int(11) NOT NULL,
+  `statusCode` int(11) DEFAULT NULL,
+  `error` longtext,
+  `deadLetter` tinyint(1) NOT NULL,
+  `createdTime` datetime(6) NOT NULL,
+  `completedTime` datetime(6) DEFAULT NULL,
+  PRIMARY KEY (`deliveryUUID`),
+  KEY `WebhookDeliveries_webhookUUID_createdTime` (`webhookUUID`, `createdTime`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -47,6 +47,13 @@ JOIN (
 LEFT JOIN SIPs s ON s.sipUUID = j.SIPUUID
 WHERE j.unitType = 'unitSIP' AND NOT j.SIPUUID LIKE '%None%' AND s.hidden = 0;
 
+--
+-- Tasks
+--
+
+-- name: UpdateTask :exec
+UPDATE Tasks SET exitCode = ?, stdOut = ?, stdError = ?, endTime = ? WHERE taskUUID = ?;
+
 --
 -- Transfers
 --
@@ -70,6 +77,12 @@ UPDATE Transfers SET currentLocation = ? WHERE transferUUID = ?;
 -- name: UpdateTransferStatus :exec
 UPDATE Transfers SET status = ? WHERE transferUUID = ?;
 
+-- name: HideTransfer :exec
+UPDATE Transfers SET hidden = 1 WHERE transferUUID = ?;
+
+-- name: ListProcessingTransfers :many
+SELECT transferUUID, currentLocation FROM Transfers WHERE status IN (0, 1);
+
 --
 -- SIPs
 --
@@ -92,6 +105,12 @@ UPDATE SIPs SET currentPath = ? WHERE sipUUID = ?;
 -- name: UpdateSIPStatus :exec
 UPDATE SIPs SET status = ? WHERE sipUUID = ?;
 
+-- name: HideSIP :exec
+UPDATE SIPs SET hidden = 1 WHERE sipUUID = ?;
+
+-- name: ListProcessingSIPs :many
+SELECT sipUUID, currentPath, sipType FROM SIPs WHERE status IN (0, 1);
+
 --
 -- Clean-ups
 --
@@ -106,10 +125,10 @@ DELETE FROM Jobs WHERE currentStep = 1;
 UPDATE Jobs SET currentStep = 4 WHERE currentStep = 3;
 
 -- name: CleanUpActiveTransfers :exec
-UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1);
+UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND NOT EXISTS (SELECT 1 FROM UnitVariables WHERE unitUUID = transferUUID AND variable = 'iteratorState');
 
 -- name: CleanUpActiveSIPs :exec
-UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1);
+UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND NOT EXISTS (SELECT 1 FROM UnitVariables WHERE unitUUID = sipUUID AND variable = 'iteratorState');
 
 -- name: CleanUpActiveTasks :exec
 UPDATE Tasks SET exitCode = -1, stdError = "MCP shut down while processing." WHERE exitCode IS NULL;
@@ -149,6 +168,9 @@ WHERE
     AND unitUUID = sqlc.arg(unit_id)
     AND variable = sqlc.arg(name);
 
+-- name: DeleteUnitVar :exec
+DELETE FROM UnitVariables WHERE unitType = sqlc.arg(unit_type) AND unitUUID = sqlc.arg(unit_id) AND variable = sqlc.arg(name);
+
 --
 -- Dashboard settings
 --
@@ -173,3 +195,25 @@ JOIN tastypie_apikey ON auth_user.id = tastypie_apikey.user_id
 LEFT JOIN main_userprofile ON auth_user.id = main_userprofile.user_id
 WHERE auth_user.username = ? AND tastypie_apikey.key = ? AND auth_user.is_active = 1
 LIMIT 1;
+
+--
+-- Webhooks
+--
+
+-- name: CreateWebhook :exec
+INSERT INTO Webhooks (webhookUUID, url, secret, events, createdTime) VALUES (?, ?, ?, ?, ?);
+
+-- name: ListWebhooks :many
+SELECT * FROM Webhooks ORDER BY createdTime;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM Webhooks WHERE webhookUUID = ?;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO WebhookDeliveries (deliveryUUID, webhookUUID, event, packageUUID, payload, attempts, statusCode, error, deadLetter, createdTime, completedTime) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM WebhookDeliveries ORDER BY createdTime DESC LIMIT ?;
+
+-- name: ListWebhookDeliveriesByWebhook :many
+SELECT * FROM WebhookDeliveries WHERE webhookUUID = ? ORDER BY createdTime DESC LIMIT ?;
@@ -0,0 +1,177 @@
+-- Subset of the Archivematica schema used by CCP, translated to PostgreSQL,
+-- and the tables owned by CCP. Identifiers are quoted to keep the names used
+-- by the Archivematica models. The store creates the tables that are missing
+-- during startup.
+
+CREATE TABLE IF NOT EXISTS "Jobs" (
+  "jobUUID" varchar(36) NOT NULL,
+  "jobType" varchar(250) NOT NULL,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  "createdTimeDec" numeric(26,10) NOT NULL,
+  "directory" text NOT NULL,
+  "SIPUUID" varchar(36) NOT NULL,
+  "unitType" varchar(50) NOT NULL,
+  "currentStep" integer NOT NULL,
+  "microserviceGroup" varchar(50) NOT NULL,
+  "hidden" boolean NOT NULL,
+  "subJobOf" varchar(36) NOT NULL,
+  "MicroServiceChainLinksPK" varchar(36) DEFAULT NULL,
+  PRIMARY KEY ("jobUUID")
+);
+
+CREATE INDEX IF NOT EXISTS "Jobs_SIPUUID_currentStep_micro_2638efea_idx" ON "Jobs" ("SIPUUID", "currentStep", "microserviceGroup", "MicroServiceChainLinksPK");
+CREATE INDEX IF NOT EXISTS "Jobs_SIPUUID_createdTime_createdTimeDec_f3e10445_idx" ON "Jobs" ("SIPUUID", "createdTime", "createdTimeDec");
+
+CREATE TABLE IF NOT EXISTS "Tasks" (
+  "taskUUID" varchar(36) NOT NULL,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  "fileUUID" varchar(36) DEFAULT NULL,
+  "fileName" text NOT NULL,
+  "exec" varchar(250) NOT NULL,
+  "arguments" varchar(1000) NOT NULL,
+  "startTime" timestamp(6) with time zone DEFAULT NULL,
+  "endTime" timestamp(6) with time zone DEFAULT NULL,
+  "client" varchar(50) NOT NULL,
+  "stdOut" text NOT NULL,
+  "stdError" text NOT NULL,
+  "exitCode" bigint DEFAULT NULL,
+  "jobuuid" varchar(36) NOT NULL REFERENCES "Jobs" ("jobUUID"),
+  PRIMARY KEY ("taskUUID")
+);
+
+CREATE INDEX IF NOT EXISTS "Tasks_jobuuid_458e89f7_fk_Jobs_jobUUID" ON "Tasks" ("jobuuid");
+
+CREATE TABLE IF NOT EXISTS "Transfers" (
+  "transferUUID" varchar(36) NOT NULL,
+  "currentLocation" text NOT NULL,
+  "type" varchar(50) NOT NULL,
+  "accessionID" text NOT NULL,
+  "sourceOfAcquisition" text NOT NULL,
+  "typeOfTransfer" text NOT NULL,
+  "description" text NOT NULL,
+  "notes" text NOT NULL,
+  "hidden" boolean NOT NULL,
+  "transferMetadataSetRowUUID" varchar(36) DEFAULT NULL,
+  "dirUUIDs" boolean NOT NULL,
+  "access_system_id" text NOT NULL,
+  "completed_at" timestamp(6) with time zone DEFAULT NULL,
+  "status" smallint NOT NULL,
+  PRIMARY KEY ("transferUUID")
+);
+
+CREATE TABLE IF NOT EXISTS "SIPs" (
+  "sipUUID" varchar(36) NOT NULL,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  "currentPath" text,
+  "hidden" boolean NOT NULL,
+  "aipFilename" text,
+  "sipType" varchar(8) NOT NULL,
+  "dirUUIDs" boolean NOT NULL,
+  "completed_at" timestamp(6) with time zone DEFAULT NULL,
+  "status" smallint NOT NULL,
+  PRIMARY KEY ("sipUUID")
+);
+
+CREATE TABLE IF NOT EXISTS "UnitVariables" (
+  "pk" varchar(36) NOT NULL,
+  "unitType" varchar(50) DEFAULT NULL,
+  "unitUUID" varchar(36) DEFAULT NULL,
+  "variable" text,
+  "variableValue" text,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  "updatedTime" timestamp(6) with time zone NOT NULL,
+  "microServiceChainLink" varchar(36) DEFAULT NULL,
+  PRIMARY KEY ("pk")
+);
+
+CREATE INDEX IF NOT EXISTS "UnitVariables_ep46xp7f_idx" ON "UnitVariables" ("unitUUID", "unitType", "variable");
+
+CREATE TABLE IF NOT EXISTS "Files" (
+  "fileUUID" varchar(36) NOT NULL,
+  "originalLocation" text NOT NULL,
+  "currentLocation" text,
+  "fileGrpUse" varchar(50) NOT NULL,
+  "fileGrpUUID" varchar(36) NOT NULL,
+  "checksum" varchar(128) NOT NULL,
+  "fileSize" bigint DEFAULT NULL,
+  "label" text NOT NULL,
+  "enteredSystem" timestamp(6) with time zone NOT NULL,
+  "removedTime" timestamp(6) with time zone DEFAULT NULL,
+  "sipUUID" varchar(36) DEFAULT NULL REFERENCES "SIPs" ("sipUUID"),
+  "transferUUID" varchar(36) DEFAULT NULL REFERENCES "Transfers" ("transferUUID"),
+  "checksumType" varchar(36) NOT NULL,
+  "modificationTime" timestamp(6) with time zone,
+  PRIMARY KEY ("fileUUID")
+);
+
+CREATE INDEX IF NOT EXISTS "Files_sipUUID_fileGrpUse_390d13ef_idx" ON "Files" ("sipUUID", "fileGrpUse");
+CREATE INDEX IF NOT EXISTS "Files_transfer_lvrgv3pn_idx" ON "Files" ("transferUUID", "currentLocation");
+CREATE INDEX IF NOT EXISTS "Files_sip_1x6rkqbm_idx" ON "Files" ("sipUUID", "currentLocation");
+
+CREATE TABLE IF NOT EXISTS "DashboardSettings" (
+  "pk" serial NOT NULL,
+  "name" varchar(255) NOT NULL,
+  "value" text NOT NULL,
+  "lastModified" timestamp(6) with time zone NOT NULL,
+  "scope" varchar(255) NOT NULL,
+  PRIMARY KEY ("pk")
+);
+
+CREATE TABLE IF NOT EXISTS "auth_user" (
+  "id" serial NOT NULL,
+  "password" varchar(128) NOT NULL,
+  "last_login" timestamp(6) with time zone DEFAULT NULL,
+  "is_superuser" boolean NOT NULL,
+  "username" varchar(150) NOT NULL UNIQUE,
+  "first_name" varchar(30) NOT NULL,
+  "last_name" varchar(30) NOT NULL,
+  "email" varchar(254) NOT NULL,
+  "is_staff" boolean NOT NULL,
+  "is_active" boolean NOT NULL,
+  "date_joined" timestamp(6) with time zone NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "tastypie_apikey" (
+  "id" serial NOT NULL,
+  "key" varchar(128) NOT NULL,
+  "created" timestamp(6) with time zone NOT NULL,
+  "user_id" integer NOT NULL UNIQUE REFERENCES "auth_user" ("id"),
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "tastypie_apikey_key_17b411bb" ON "tastypie_apikey" ("key");
+
+CREATE TABLE IF NOT EXISTS "main_userprofile" (
+  "id" serial NOT NULL,
+  "agent_id" integer NOT NULL UNIQUE,
+  "user_id" integer NOT NULL UNIQUE REFERENCES "auth_user" ("id"),
+  "system_emails" boolean NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "Webhooks" (
+  "webhookUUID" varchar(36) NOT NULL,
+  "url" text NOT NULL,
+  "secret" text NOT NULL,
+  "events" varchar(255) NOT NULL,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  PRIMARY KEY ("webhookUUID")
+);
+
+CREATE TABLE IF NOT EXISTS "WebhookDeliveries" (
+  "deliveryUUID" varchar(36) NOT NULL,
+  "webhookUUID" varchar(36) NOT NULL,
+  "event" varchar(50) NOT NULL,
+  "packageUUID" varchar(36) NOT NULL,
+  "payload" text NOT NULL,
+  "attempts" integer NOT NULL,
+  "statusCode" integer DEFAULT NULL,
+  "error" text,
+  "deadLetter" boolean NOT NULL,
+  "createdTime" timestamp(6) with time zone NOT NULL,
+  "completedTime" timestamp(6) with time zone DEFAULT NULL,
+  PRIMARY KEY ("deliveryUUID")
+);
+
+CREATE INDEX IF NOT EXISTS "WebhookDeliveries_webhookUUID_createdTime" ON "WebhookDeliveries" ("webhookUUID", "createdTime");
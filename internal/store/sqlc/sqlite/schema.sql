@@ -0,0 +1,177 @@
+-- Subset of the Archivematica schema used by CCP, translated to SQLite, and
+-- the tables owned by CCP. The store creates the tables that are missing
+-- during startup.
+
+CREATE TABLE IF NOT EXISTS `Jobs` (
+  `jobUUID` TEXT NOT NULL,
+  `jobType` TEXT NOT NULL,
+  `createdTime` DATETIME NOT NULL,
+  `createdTimeDec` TEXT NOT NULL,
+  `directory` TEXT NOT NULL,
+  `SIPUUID` TEXT NOT NULL,
+  `unitType` TEXT NOT NULL,
+  `currentStep` INTEGER NOT NULL,
+  `microserviceGroup` TEXT NOT NULL,
+  `hidden` BOOLEAN NOT NULL,
+  `subJobOf` TEXT NOT NULL,
+  `MicroServiceChainLinksPK` TEXT DEFAULT NULL,
+  PRIMARY KEY (`jobUUID`)
+);
+
+CREATE INDEX IF NOT EXISTS `Jobs_SIPUUID_currentStep_micro_2638efea_idx` ON `Jobs` (`SIPUUID`, `currentStep`, `microserviceGroup`, `MicroServiceChainLinksPK`);
+CREATE INDEX IF NOT EXISTS `Jobs_SIPUUID_createdTime_createdTimeDec_f3e10445_idx` ON `Jobs` (`SIPUUID`, `createdTime`, `createdTimeDec`);
+
+CREATE TABLE IF NOT EXISTS `Tasks` (
+  `taskUUID` TEXT NOT NULL,
+  `createdTime` DATETIME NOT NULL,
+  `fileUUID` TEXT DEFAULT NULL,
+  `fileName` TEXT NOT NULL,
+  `exec` TEXT NOT NULL,
+  `arguments` TEXT NOT NULL,
+  `startTime` DATETIME DEFAULT NULL,
+  `endTime` DATETIME DEFAULT NULL,
+  `client` TEXT NOT NULL,
+  `stdOut` TEXT NOT NULL,
+  `stdError` TEXT NOT NULL,
+  `exitCode` INTEGER DEFAULT NULL,
+  `jobuuid` TEXT NOT NULL,
+  PRIMARY KEY (`taskUUID`),
+  FOREIGN KEY (`jobuuid`) REFERENCES `Jobs` (`jobUUID`)
+);
+
+CREATE INDEX IF NOT EXISTS `Tasks_jobuuid_458e89f7_fk_Jobs_jobUUID` ON `Tasks` (`jobuuid`);
+
+CREATE TABLE IF NOT EXISTS `Transfers` (
+  `transferUUID` TEXT NOT NULL,
+  `currentLocation` TEXT NOT NULL,
+  `type` TEXT NOT NULL,
+  `accessionID` TEXT NOT NULL,
+  `sourceOfAcquisition` TEXT NOT NULL,
+  `typeOfTransfer` TEXT NOT NULL,
+  `description` TEXT NOT NULL,
+  `notes` TEXT NOT NULL,
+  `hidden` BOOLEAN NOT NULL,
+  `transferMetadataSetRowUUID` TEXT DEFAULT NULL,
+  `dirUUIDs` BOOLEAN NOT NULL,
+  `access_system_id` TEXT NOT NULL,
+  `completed_at` DATETIME DEFAULT NULL,
+  `status` INTEGER NOT NULL,
+  PRIMARY KEY (`transferUUID`)
+);
+
+CREATE TABLE IF NOT EXISTS `SIPs` (
+  `sipUUID` TEXT NOT NULL,
+  `createdTime` DATETIME NOT NULL,
+  `currentPath` TEXT,
+  `hidden` BOOLEAN NOT NULL,
+  `aipFilename` TEXT,
+  `sipType` TEXT NOT NULL,
+  `dirUUIDs` BOOLEAN NOT NULL,
+  `completed_at` DATETIME DEFAULT NULL,
+  `status` INTEGER NOT NULL,
+  PRIMARY KEY (`sipUUID`)
+);
+
+CREATE TABLE IF NOT EXISTS `UnitVariables` (
+  `pk` TEXT NOT NULL,
+  `unitType` TEXT DEFAULT NULL,
+  `unitUUID` TEXT DEFAULT NULL,
+  `variable` TEXT,
+  `variableValue` TEXT,
+  `createdTime` DATETIME NOT NULL,
+  `updatedTime` DATETIME NOT NULL,
+  `microServiceChainLink` TEXT DEFAULT NULL,
+  PRIMARY KEY (`pk`)
+);
+
+CREATE INDEX IF NOT EXISTS `UnitVariables_ep46xp7f_idx` ON `UnitVariables` (`unitUUID`, `unitType`, `variable`);
+
+CREATE TABLE IF NOT EXISTS `Files` (
+  `fileUUID` TEXT NOT NULL,
+  `originalLocation` TEXT NOT NULL,
+  `currentLocation` TEXT,
+  `fileGrpUse` TEXT NOT NULL,
+  `fileGrpUUID` TEXT NOT NULL,
+  `checksum` TEXT NOT NULL,
+  `fileSize` INTEGER DEFAULT NULL,
+  `label` TEXT NOT NULL,
+  `enteredSystem` DATETIME NOT NULL,
+  `removedTime` DATETIME DEFAULT NULL,
+  `sipUUID` TEXT DEFAULT NULL,
+  `transferUUID` TEXT DEFAULT NULL,
+  `checksumType` TEXT NOT NULL,
+  `modificationTime` DATETIME,
+  PRIMARY KEY (`fileUUID`),
+  FOREIGN KEY (`sipUUID`) REFERENCES `SIPs` (`sipUUID`),
+  FOREIGN KEY (`transferUUID`) REFERENCES `Transfers` (`transferUUID`)
+);
+
+CREATE INDEX IF NOT EXISTS `Files_sipUUID_fileGrpUse_390d13ef_idx` ON `Files` (`sipUUID`, `fileGrpUse`);
+CREATE INDEX IF NOT EXISTS `Files_transfer_lvrgv3pn_idx` ON `Files` (`transferUUID`, `currentLocation`);
+CREATE INDEX IF NOT EXISTS `Files_sip_1x6rkqbm_idx` ON `Files` (`sipUUID`, `currentLocation`);
+
+CREATE TABLE IF NOT EXISTS `DashboardSettings` (
+  `pk` INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `name` TEXT NOT NULL,
+  `value` TEXT NOT NULL,
+  `lastModified` DATETIME NOT NULL,
+  `scope` TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `auth_user` (
+  `id` INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `password` TEXT NOT NULL,
+  `last_login` DATETIME DEFAULT NULL,
+  `is_superuser` BOOLEAN NOT NULL,
+  `username` TEXT NOT NULL UNIQUE,
+  `first_name` TEXT NOT NULL,
+  `last_name` TEXT NOT NULL,
+  `email` TEXT NOT NULL,
+  `is_staff` BOOLEAN NOT NULL,
+  `is_active` BOOLEAN NOT NULL,
+  `date_joined` DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `tastypie_apikey` (
+  `id` INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `key` TEXT NOT NULL,
+  `created` DATETIME NOT NULL,
+  `user_id` INTEGER NOT NULL UNIQUE,
+  FOREIGN KEY (`user_id`) REFERENCES `auth_user` (`id`)
+);
+
+CREATE INDEX IF NOT EXISTS `tastypie_apikey_key_17b411bb` ON `tastypie_apikey` (`key`);
+
+CREATE TABLE IF NOT EXISTS `main_userprofile` (
+  `id` INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `agent_id` INTEGER NOT NULL UNIQUE,
+  `user_id` INTEGER NOT NULL UNIQUE,
+  `system_emails` BOOLEAN NOT NULL,
+  FOREIGN KEY (`user_id`) REFERENCES `auth_user` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `Webhooks` (
+  `webhookUUID` TEXT NOT NULL,
+  `url` TEXT NOT NULL,
+  `secret` TEXT NOT NULL,
+  `events` TEXT NOT NULL,
+  `createdTime` DATETIME NOT NULL,
+  PRIMARY KEY (`webhookUUID`)
+);
+
+CREATE TABLE IF NOT EXISTS `WebhookDeliveries` (
+  `deliveryUUID` TEXT NOT NULL,
+  `webhookUUID` TEXT NOT NULL,
+  `event` TEXT NOT NULL,
+  `packageUUID` TEXT NOT NULL,
+  `payload` TEXT NOT NULL,
+  `attempts` INTEGER NOT NULL,
+  `statusCode` INTEGER DEFAULT NULL,
+  `error` TEXT,
+  `deadLetter` BOOLEAN NOT NULL,
+  `createdTime` DATETIME NOT NULL,
+  `completedTime` DATETIME DEFAULT NULL,
+  PRIMARY KEY (`deliveryUUID`)
+);
+
+CREATE INDEX IF NOT EXISTS `WebhookDeliveries_webhookUUID_createdTime` ON `WebhookDeliveries` (`webhookUUID`, `createdTime`);
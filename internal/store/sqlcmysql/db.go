@@ -54,15 +54,48 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createUnitVarStmt, err = db.PrepareContext(ctx, createUnitVar); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUnitVar: %w", err)
 	}
+	if q.createWebhookStmt, err = db.PrepareContext(ctx, createWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhook: %w", err)
+	}
+	if q.createWebhookDeliveryStmt, err = db.PrepareContext(ctx, createWebhookDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWebhookDelivery: %w", err)
+	}
+	if q.deleteUnitVarStmt, err = db.PrepareContext(ctx, deleteUnitVar); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUnitVar: %w", err)
+	}
+	if q.deleteWebhookStmt, err = db.PrepareContext(ctx, deleteWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWebhook: %w", err)
+	}
+	if q.hideSIPStmt, err = db.PrepareContext(ctx, hideSIP); err != nil {
+		return nil, fmt.Errorf("error preparing query HideSIP: %w", err)
+	}
+	if q.hideTransferStmt, err = db.PrepareContext(ctx, hideTransfer); err != nil {
+		return nil, fmt.Errorf("error preparing query HideTransfer: %w", err)
+	}
 	if q.listJobsStmt, err = db.PrepareContext(ctx, listJobs); err != nil {
 		return nil, fmt.Errorf("error preparing query ListJobs: %w", err)
 	}
+	if q.listProcessingSIPsStmt, err = db.PrepareContext(ctx, listProcessingSIPs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProcessingSIPs: %w", err)
+	}
+	if q.listProcessingTransfersStmt, err = db.PrepareContext(ctx, listProcessingTransfers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProcessingTransfers: %w", err)
+	}
 	if q.listSIPsWithCreationTimestampsStmt, err = db.PrepareContext(ctx, listSIPsWithCreationTimestamps); err != nil {
 		return nil, fmt.Errorf("error preparing query ListSIPsWithCreationTimestamps: %w", err)
 	}
 	if q.listTransfersWithCreationTimestampsStmt, err = db.PrepareContext(ctx, listTransfersWithCreationTimestamps); err != nil {
 		return nil, fmt.Errorf("error preparing query ListTransfersWithCreationTimestamps: %w", err)
 	}
+	if q.listWebhookDeliveriesStmt, err = db.PrepareContext(ctx, listWebhookDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveries: %w", err)
+	}
+	if q.listWebhookDeliveriesByWebhookStmt, err = db.PrepareContext(ctx, listWebhookDeliveriesByWebhook); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhookDeliveriesByWebhook: %w", err)
+	}
+	if q.listWebhooksStmt, err = db.PrepareContext(ctx, listWebhooks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWebhooks: %w", err)
+	}
 	if q.readDashboardSettingStmt, err = db.PrepareContext(ctx, readDashboardSetting); err != nil {
 		return nil, fmt.Errorf("error preparing query ReadDashboardSetting: %w", err)
 	}
@@ -108,6 +141,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateSIPStatusStmt, err = db.PrepareContext(ctx, updateSIPStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateSIPStatus: %w", err)
 	}
+	if q.updateTaskStmt, err = db.PrepareContext(ctx, updateTask); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTask: %w", err)
+	}
 	if q.updateTransferLocationStmt, err = db.PrepareContext(ctx, updateTransferLocation); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTransferLocation: %w", err)
 	}
@@ -172,11 +208,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createUnitVarStmt: %w", cerr)
 		}
 	}
+	if q.createWebhookStmt != nil {
+		if cerr := q.createWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookStmt: %w", cerr)
+		}
+	}
+	if q.createWebhookDeliveryStmt != nil {
+		if cerr := q.createWebhookDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWebhookDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.deleteUnitVarStmt != nil {
+		if cerr := q.deleteUnitVarStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUnitVarStmt: %w", cerr)
+		}
+	}
+	if q.deleteWebhookStmt != nil {
+		if cerr := q.deleteWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWebhookStmt: %w", cerr)
+		}
+	}
+	if q.hideSIPStmt != nil {
+		if cerr := q.hideSIPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing hideSIPStmt: %w", cerr)
+		}
+	}
+	if q.hideTransferStmt != nil {
+		if cerr := q.hideTransferStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing hideTransferStmt: %w", cerr)
+		}
+	}
 	if q.listJobsStmt != nil {
 		if cerr := q.listJobsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listJobsStmt: %w", cerr)
 		}
 	}
+	if q.listProcessingSIPsStmt != nil {
+		if cerr := q.listProcessingSIPsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProcessingSIPsStmt: %w", cerr)
+		}
+	}
+	if q.listProcessingTransfersStmt != nil {
+		if cerr := q.listProcessingTransfersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProcessingTransfersStmt: %w", cerr)
+		}
+	}
 	if q.listSIPsWithCreationTimestampsStmt != nil {
 		if cerr := q.listSIPsWithCreationTimestampsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listSIPsWithCreationTimestampsStmt: %w", cerr)
@@ -187,6 +263,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listTransfersWithCreationTimestampsStmt: %w", cerr)
 		}
 	}
+	if q.listWebhookDeliveriesStmt != nil {
+		if cerr := q.listWebhookDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.listWebhookDeliveriesByWebhookStmt != nil {
+		if cerr := q.listWebhookDeliveriesByWebhookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhookDeliveriesByWebhookStmt: %w", cerr)
+		}
+	}
+	if q.listWebhooksStmt != nil {
+		if cerr := q.listWebhooksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWebhooksStmt: %w", cerr)
+		}
+	}
 	if q.readDashboardSettingStmt != nil {
 		if cerr := q.readDashboardSettingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing readDashboardSettingStmt: %w", cerr)
@@ -262,6 +353,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateSIPStatusStmt: %w", cerr)
 		}
 	}
+	if q.updateTaskStmt != nil {
+		if cerr := q.updateTaskStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTaskStmt: %w", cerr)
+		}
+	}
 	if q.updateTransferLocationStmt != nil {
 		if cerr := q.updateTransferLocationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateTransferLocationStmt: %w", cerr)
@@ -326,9 +422,20 @@ type Queries struct {
 	createSIPStmt                           *sql.Stmt
 	createTransferStmt                      *sql.Stmt
 	createUnitVarStmt                       *sql.Stmt
+	createWebhookStmt                       *sql.Stmt
+	createWebhookDeliveryStmt               *sql.Stmt
+	deleteUnitVarStmt                       *sql.Stmt
+	deleteWebhookStmt                       *sql.Stmt
+	hideSIPStmt                             *sql.Stmt
+	hideTransferStmt                        *sql.Stmt
 	listJobsStmt                            *sql.Stmt
+	listProcessingSIPsStmt                  *sql.Stmt
+	listProcessingTransfersStmt             *sql.Stmt
 	listSIPsWithCreationTimestampsStmt      *sql.Stmt
 	listTransfersWithCreationTimestampsStmt *sql.Stmt
+	listWebhookDeliveriesStmt               *sql.Stmt
+	listWebhookDeliveriesByWebhookStmt      *sql.Stmt
+	listWebhooksStmt                        *sql.Stmt
 	readDashboardSettingStmt                *sql.Stmt
 	readDashboardSettingsWithNameLikeStmt   *sql.Stmt
 	readDashboardSettingsWithScopeStmt      *sql.Stmt
@@ -344,6 +451,7 @@ type Queries struct {
 	updateJobStatusStmt                     *sql.Stmt
 	updateSIPLocationStmt                   *sql.Stmt
 	updateSIPStatusStmt                     *sql.Stmt
+	updateTaskStmt                          *sql.Stmt
 	updateTransferLocationStmt              *sql.Stmt
 	updateTransferStatusStmt                *sql.Stmt
 	updateUnitVarStmt                       *sql.Stmt
@@ -363,9 +471,20 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		createSIPStmt:                           q.createSIPStmt,
 		createTransferStmt:                      q.createTransferStmt,
 		createUnitVarStmt:                       q.createUnitVarStmt,
+		createWebhookStmt:                       q.createWebhookStmt,
+		createWebhookDeliveryStmt:               q.createWebhookDeliveryStmt,
+		deleteUnitVarStmt:                       q.deleteUnitVarStmt,
+		deleteWebhookStmt:                       q.deleteWebhookStmt,
+		hideSIPStmt:                             q.hideSIPStmt,
+		hideTransferStmt:                        q.hideTransferStmt,
 		listJobsStmt:                            q.listJobsStmt,
+		listProcessingSIPsStmt:                  q.listProcessingSIPsStmt,
+		listProcessingTransfersStmt:             q.listProcessingTransfersStmt,
 		listSIPsWithCreationTimestampsStmt:      q.listSIPsWithCreationTimestampsStmt,
 		listTransfersWithCreationTimestampsStmt: q.listTransfersWithCreationTimestampsStmt,
+		listWebhookDeliveriesStmt:               q.listWebhookDeliveriesStmt,
+		listWebhookDeliveriesByWebhookStmt:      q.listWebhookDeliveriesByWebhookStmt,
+		listWebhooksStmt:                        q.listWebhooksStmt,
 		readDashboardSettingStmt:                q.readDashboardSettingStmt,
 		readDashboardSettingsWithNameLikeStmt:   q.readDashboardSettingsWithNameLikeStmt,
 		readDashboardSettingsWithScopeStmt:      q.readDashboardSettingsWithScopeStmt,
@@ -381,6 +500,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		updateJobStatusStmt:                     q.updateJobStatusStmt,
 		updateSIPLocationStmt:                   q.updateSIPLocationStmt,
 		updateSIPStatusStmt:                     q.updateSIPStatusStmt,
+		updateTaskStmt:                          q.updateTaskStmt,
 		updateTransferLocationStmt:              q.updateTransferLocationStmt,
 		updateTransferStatusStmt:                q.updateTransferStatusStmt,
 		updateUnitVarStmt:                       q.updateUnitVarStmt,
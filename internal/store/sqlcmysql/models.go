@@ -647,3 +647,25 @@ type Unitvariable struct {
 	UpdatedAt     time.Time
 	LinkID        uuid.NullUUID
 }
+
+type Webhook struct {
+	Webhookuuid uuid.UUID
+	Url         string
+	Secret      string
+	Events      string
+	CreatedAt   time.Time
+}
+
+type Webhookdelivery struct {
+	Deliveryuuid  uuid.UUID
+	Webhookuuid   uuid.UUID
+	Event         string
+	Packageuuid   uuid.UUID
+	Payload       string
+	Attempts      int32
+	Statuscode    sql.NullInt32
+	Error         sql.NullString
+	Deadletter    bool
+	CreatedAt     time.Time
+	Completedtime sql.NullTime
+}
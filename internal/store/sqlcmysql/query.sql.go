@@ -23,7 +23,7 @@ func (q *Queries) CleanUpActiveJobs(ctx context.Context) error {
 }
 
 const cleanUpActiveSIPs = `-- name: CleanUpActiveSIPs :exec
-UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1)
+UPDATE SIPs SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND NOT EXISTS (SELECT 1 FROM UnitVariables WHERE unitUUID = sipUUID AND variable = 'iteratorState')
 `
 
 func (q *Queries) CleanUpActiveSIPs(ctx context.Context) error {
@@ -41,7 +41,7 @@ func (q *Queries) CleanUpActiveTasks(ctx context.Context) error {
 }
 
 const cleanUpActiveTransfers = `-- name: CleanUpActiveTransfers :exec
-UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1)
+UPDATE Transfers SET status = 4, completed_at = UTC_TIMESTAMP() WHERE status IN (0, 1) AND NOT EXISTS (SELECT 1 FROM UnitVariables WHERE unitUUID = transferUUID AND variable = 'iteratorState')
 `
 
 func (q *Queries) CleanUpActiveTransfers(ctx context.Context) error {
@@ -184,6 +184,111 @@ func (q *Queries) CreateUnitVar(ctx context.Context, arg *CreateUnitVarParams) e
 	return err
 }
 
+const createWebhook = `-- name: CreateWebhook :exec
+
+INSERT INTO Webhooks (webhookUUID, url, secret, events, createdTime) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateWebhookParams struct {
+	Webhookuuid uuid.UUID
+	Url         string
+	Secret      string
+	Events      string
+	CreatedAt   time.Time
+}
+
+// Webhooks
+func (q *Queries) CreateWebhook(ctx context.Context, arg *CreateWebhookParams) error {
+	_, err := q.exec(ctx, q.createWebhookStmt, createWebhook,
+		arg.Webhookuuid,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO WebhookDeliveries (deliveryUUID, webhookUUID, event, packageUUID, payload, attempts, statusCode, error, deadLetter, createdTime, completedTime) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateWebhookDeliveryParams struct {
+	Deliveryuuid  uuid.UUID
+	Webhookuuid   uuid.UUID
+	Event         string
+	Packageuuid   uuid.UUID
+	Payload       string
+	Attempts      int32
+	Statuscode    sql.NullInt32
+	Error         sql.NullString
+	Deadletter    bool
+	CreatedAt     time.Time
+	Completedtime sql.NullTime
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg *CreateWebhookDeliveryParams) error {
+	_, err := q.exec(ctx, q.createWebhookDeliveryStmt, createWebhookDelivery,
+		arg.Deliveryuuid,
+		arg.Webhookuuid,
+		arg.Event,
+		arg.Packageuuid,
+		arg.Payload,
+		arg.Attempts,
+		arg.Statuscode,
+		arg.Error,
+		arg.Deadletter,
+		arg.CreatedAt,
+		arg.Completedtime,
+	)
+	return err
+}
+
+const deleteUnitVar = `-- name: DeleteUnitVar :exec
+DELETE FROM UnitVariables WHERE unitType = ? AND unitUUID = ? AND variable = ?
+`
+
+type DeleteUnitVarParams struct {
+	UnitType sql.NullString
+	UnitID   uuid.UUID
+	Name     sql.NullString
+}
+
+func (q *Queries) DeleteUnitVar(ctx context.Context, arg *DeleteUnitVarParams) error {
+	_, err := q.exec(ctx, q.deleteUnitVarStmt, deleteUnitVar, arg.UnitType, arg.UnitID, arg.Name)
+	return err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM Webhooks WHERE webhookUUID = ?
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, webhookuuid uuid.UUID) (int64, error) {
+	result, err := q.exec(ctx, q.deleteWebhookStmt, deleteWebhook, webhookuuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const hideSIP = `-- name: HideSIP :exec
+UPDATE SIPs SET hidden = 1 WHERE sipUUID = ?
+`
+
+func (q *Queries) HideSIP(ctx context.Context, sipuuid uuid.UUID) error {
+	_, err := q.exec(ctx, q.hideSIPStmt, hideSIP, sipuuid)
+	return err
+}
+
+const hideTransfer = `-- name: HideTransfer :exec
+UPDATE Transfers SET hidden = 1 WHERE transferUUID = ?
+`
+
+func (q *Queries) HideTransfer(ctx context.Context, transferuuid uuid.UUID) error {
+	_, err := q.exec(ctx, q.hideTransferStmt, hideTransfer, transferuuid)
+	return err
+}
+
 const listJobs = `-- name: ListJobs :many
 SELECT jobuuid, jobtype, createdtime, createdtimedec, directory, sipuuid, unittype, currentstep, microservicegroup, hidden, subjobof, microservicechainlinkspk FROM Jobs WHERE SIPUUID = ? ORDER BY createdTime DESC
 `
@@ -224,6 +329,71 @@ func (q *Queries) ListJobs(ctx context.Context, sipuuid uuid.UUID) ([]*Job, erro
 	return items, nil
 }
 
+const listProcessingSIPs = `-- name: ListProcessingSIPs :many
+SELECT sipUUID, currentPath, sipType FROM SIPs WHERE status IN (0, 1)
+`
+
+type ListProcessingSIPsRow struct {
+	SIPID       uuid.UUID
+	Currentpath sql.NullString
+	Siptype     string
+}
+
+func (q *Queries) ListProcessingSIPs(ctx context.Context) ([]*ListProcessingSIPsRow, error) {
+	rows, err := q.query(ctx, q.listProcessingSIPsStmt, listProcessingSIPs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProcessingSIPsRow{}
+	for rows.Next() {
+		var i ListProcessingSIPsRow
+		if err := rows.Scan(&i.SIPID, &i.Currentpath, &i.Siptype); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProcessingTransfers = `-- name: ListProcessingTransfers :many
+SELECT transferUUID, currentLocation FROM Transfers WHERE status IN (0, 1)
+`
+
+type ListProcessingTransfersRow struct {
+	Transferuuid    uuid.UUID
+	Currentlocation string
+}
+
+func (q *Queries) ListProcessingTransfers(ctx context.Context) ([]*ListProcessingTransfersRow, error) {
+	rows, err := q.query(ctx, q.listProcessingTransfersStmt, listProcessingTransfers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProcessingTransfersRow{}
+	for rows.Next() {
+		var i ListProcessingTransfersRow
+		if err := rows.Scan(&i.Transferuuid, &i.Currentlocation); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSIPsWithCreationTimestamps = `-- name: ListSIPsWithCreationTimestamps :many
 SELECT
     j.SIPUUID,
@@ -332,6 +502,122 @@ func (q *Queries) ListTransfersWithCreationTimestamps(ctx context.Context) ([]*L
 	return items, nil
 }
 
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT deliveryuuid, webhookuuid, event, packageuuid, payload, attempts, statuscode, error, deadletter, createdtime, completedtime FROM WebhookDeliveries ORDER BY createdTime DESC LIMIT ?
+`
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, limit int32) ([]*Webhookdelivery, error) {
+	rows, err := q.query(ctx, q.listWebhookDeliveriesStmt, listWebhookDeliveries, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Webhookdelivery{}
+	for rows.Next() {
+		var i Webhookdelivery
+		if err := rows.Scan(
+			&i.Deliveryuuid,
+			&i.Webhookuuid,
+			&i.Event,
+			&i.Packageuuid,
+			&i.Payload,
+			&i.Attempts,
+			&i.Statuscode,
+			&i.Error,
+			&i.Deadletter,
+			&i.CreatedAt,
+			&i.Completedtime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveriesByWebhook = `-- name: ListWebhookDeliveriesByWebhook :many
+SELECT deliveryuuid, webhookuuid, event, packageuuid, payload, attempts, statuscode, error, deadletter, createdtime, completedtime FROM WebhookDeliveries WHERE webhookUUID = ? ORDER BY createdTime DESC LIMIT ?
+`
+
+type ListWebhookDeliveriesByWebhookParams struct {
+	Webhookuuid uuid.UUID
+	Limit       int32
+}
+
+func (q *Queries) ListWebhookDeliveriesByWebhook(ctx context.Context, arg *ListWebhookDeliveriesByWebhookParams) ([]*Webhookdelivery, error) {
+	rows, err := q.query(ctx, q.listWebhookDeliveriesByWebhookStmt, listWebhookDeliveriesByWebhook, arg.Webhookuuid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Webhookdelivery{}
+	for rows.Next() {
+		var i Webhookdelivery
+		if err := rows.Scan(
+			&i.Deliveryuuid,
+			&i.Webhookuuid,
+			&i.Event,
+			&i.Packageuuid,
+			&i.Payload,
+			&i.Attempts,
+			&i.Statuscode,
+			&i.Error,
+			&i.Deadletter,
+			&i.CreatedAt,
+			&i.Completedtime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooks = `-- name: ListWebhooks :many
+SELECT webhookuuid, url, secret, events, createdtime FROM Webhooks ORDER BY createdTime
+`
+
+func (q *Queries) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	rows, err := q.query(ctx, q.listWebhooksStmt, listWebhooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Webhook{}
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.Webhookuuid,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const readDashboardSetting = `-- name: ReadDashboardSetting :one
 SELECT name, value, scope FROM DashboardSettings WHERE name = ?
 `
@@ -696,6 +982,31 @@ func (q *Queries) UpdateSIPStatus(ctx context.Context, arg *UpdateSIPStatusParam
 	return err
 }
 
+const updateTask = `-- name: UpdateTask :exec
+
+UPDATE Tasks SET exitCode = ?, stdOut = ?, stdError = ?, endTime = ? WHERE taskUUID = ?
+`
+
+type UpdateTaskParams struct {
+	Exitcode sql.NullInt64
+	Stdout   string
+	Stderror string
+	Endtime  sql.NullTime
+	Taskuuid uuid.UUID
+}
+
+// Tasks
+func (q *Queries) UpdateTask(ctx context.Context, arg *UpdateTaskParams) error {
+	_, err := q.exec(ctx, q.updateTaskStmt, updateTask,
+		arg.Exitcode,
+		arg.Stdout,
+		arg.Stderror,
+		arg.Endtime,
+		arg.Taskuuid,
+	)
+	return err
+}
+
 const updateTransferLocation = `-- name: UpdateTransferLocation :exec
 UPDATE Transfers SET currentLocation = ? WHERE transferUUID = ?
 `
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/go-logr/logr"
+	"gotest.tools/v3/assert"
+)
+
+func TestSQLiteDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dsn     string
+		want    string
+		wantErr string
+	}{
+		"Adds the pragmas and the time format": {
+			dsn:  "ccp.db",
+			want: "ccp.db?_pragma=busy_timeout%2810000%29&_pragma=foreign_keys%281%29&_pragma=journal_mode%28WAL%29&_time_format=sqlite",
+		},
+		"Keeps the pragmas given": {
+			dsn:  "file:ccp.db?_pragma=journal_mode(DELETE)&_pragma=busy_timeout(5000)",
+			want: "file:ccp.db?_pragma=journal_mode%28DELETE%29&_pragma=busy_timeout%285000%29&_pragma=foreign_keys%281%29&_time_format=sqlite",
+		},
+		"Rejects a missing path": {
+			dsn:     "?_pragma=foreign_keys(1)",
+			wantErr: "missing database path",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sqliteDSN(tc.dsn)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tc.want)
+		})
+	}
+}
+
+func TestSQLiteStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Creates the tables once", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "ccp.db")
+		for range 2 {
+			s, err := New(logr.Discard(), "sqlite", path)
+			assert.NilError(t, err)
+			assert.Assert(t, s.Running())
+			assert.NilError(t, s.Close())
+		}
+	})
+
+	testStore(t, func(t *testing.T) (Store, *goqu.Database) {
+		t.Helper()
+
+		pool, err := connectToSQLite(logr.Discard(), filepath.Join(t.TempDir(), "ccp.db"))
+		assert.NilError(t, err)
+		s, err := newSQLiteStore(logr.Discard(), pool)
+		assert.NilError(t, err)
+		t.Cleanup(func() { s.Close() })
+
+		return s, s.goqu
+	})
+}
@@ -13,18 +13,19 @@ import (
 
 	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	"github.com/artefactual-labs/ccp/internal/store/enums"
-	sqlc "github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
 )
 
 var ErrNotFound error = errors.New("object not found")
 
 type Store interface {
 	// RemoveTransientData removes data from the store that the processing
-	// engine can't handle after the application is started.
+	// engine can't handle after the application is started. Transfers and
+	// SIPs with a persisted iterator state (the "iteratorState" package
+	// variable) keep their status so their processing can be resumed.
 	RemoveTransientData(ctx context.Context) error
 
 	// CreateJob creates a new Job.
-	CreateJob(ctx context.Context, params *sqlc.CreateJobParams) error
+	CreateJob(ctx context.Context, params *CreateJobParams) error
 
 	// UpdateJobStatus modifies the status of a Job.
 	UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) error
@@ -39,6 +40,11 @@ type Store interface {
 	// CreateTasks creates a group of Tasks in bulk.
 	CreateTasks(ctx context.Context, tasks []*Task) error
 
+	// UpdateTasks records the outcome of a group of Tasks, i.e. their exit
+	// code, output and end time. It is used when the outcome is not recorded
+	// by the worker, e.g. when the worker fails to process the tasks.
+	UpdateTasks(ctx context.Context, tasks []*Task) error
+
 	// ReadPackagesWithCreationTimestamps returns a list of packages along with
 	// their creation timestamps. It excludes hidden packages.
 	ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) ([]*adminv1.Package, error)
@@ -46,6 +52,11 @@ type Store interface {
 	// UpdatePackageStatus modifies the status of a Transfer, DIP or SIP.
 	UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) error
 
+	// ListProcessingPackages returns the Transfers, SIPs and DIPs whose status
+	// is unknown or processing, e.g. because the application was stopped while
+	// they were being processed.
+	ListProcessingPackages(ctx context.Context) ([]ProcessingPackage, error)
+
 	// ReadTransferLocation returns the current path of a Transfer.
 	ReadTransferLocation(ctx context.Context, id uuid.UUID) (loc string, err error)
 
@@ -67,6 +78,11 @@ type Store interface {
 	// UpdateTransferLocation updates the current location of a given transfer.
 	UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) error
 
+	// HidePackage sets the hidden flag of the Transfer or the SIP with the
+	// given identifier so it is left out of the package listings. It is not an
+	// error if the package does not exist.
+	HidePackage(ctx context.Context, id uuid.UUID) error
+
 	// ReadSIP returns a SIP given its identifier.
 	ReadSIP(ctx context.Context, id uuid.UUID) (sip SIP, err error)
 
@@ -101,6 +117,10 @@ type Store interface {
 	// CreateUnitVar creates a new variable.
 	CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, update bool) error
 
+	// DeleteUnitVar deletes a package variable. It is not an error if the
+	// variable does not exist.
+	DeleteUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) error
+
 	// Files returns a list of files. This could return some kind of iterator
 	// interface; rangefunc did work but it's not supported by linters yet.
 	Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) ([]File, error)
@@ -111,6 +131,24 @@ type Store interface {
 	// ReadDict reads a dictionary given its name.
 	ReadDict(ctx context.Context, name string) (map[string]string, error)
 
+	// CreateWebhook creates a webhook subscription.
+	CreateWebhook(ctx context.Context, webhook *Webhook) error
+
+	// ListWebhooks returns the webhook subscriptions sorted by creation time.
+	ListWebhooks(ctx context.Context) ([]*Webhook, error)
+
+	// DeleteWebhook deletes a webhook subscription. It returns ErrNotFound if
+	// the subscription does not exist.
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+
+	// CreateWebhookDelivery records the outcome of a webhook delivery.
+	CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+
+	// ListWebhookDeliveries returns the most recent webhook deliveries, first
+	// the most recent. Deliveries of all webhooks are listed when webhookID is
+	// nil.
+	ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*WebhookDelivery, error)
+
 	// ValidateUserAPIKey checks if a user with the given username and API key
 	// exists and is active. It returns a pointer to the User if valid, or nil
 	// and an error otherwise. A nil User doesn't necessarily mean the user
@@ -121,8 +159,11 @@ type Store interface {
 	Close() error
 }
 
+// New returns the store of the given driver: "mysql", "sqlite" or "postgres".
+// The SQLite and PostgreSQL stores create their tables when they are missing,
+// e.g. with a dsn like "ccp.db" or "postgres://ccp@localhost/ccp".
 func New(logger logr.Logger, driver, dsn string) (Store, error) {
-	var store *mysqlStoreImpl
+	var store Store
 
 	switch strings.ToLower(driver) {
 	case "mysql":
@@ -137,11 +178,37 @@ func New(logger logr.Logger, driver, dsn string) (Store, error) {
 				return nil, fmt.Errorf("new MySQL store: %v", err)
 			}
 		}
+	case "sqlite":
+		{
+			logger = logger.WithName("sqlite")
+			pool, err := connectToSQLite(logger, dsn)
+			if err != nil {
+				return nil, fmt.Errorf("connect to SQLite: %v", err)
+			}
+			store, err = newSQLiteStore(logger, pool)
+			if err != nil {
+				_ = pool.Close()
+				return nil, fmt.Errorf("new SQLite store: %v", err)
+			}
+		}
+	case "postgres":
+		{
+			logger = logger.WithName("postgres")
+			pool, err := connectToPostgres(logger, dsn)
+			if err != nil {
+				return nil, fmt.Errorf("connect to PostgreSQL: %v", err)
+			}
+			store, err = newPostgresStore(logger, pool)
+			if err != nil {
+				_ = pool.Close()
+				return nil, fmt.Errorf("new PostgreSQL store: %v", err)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("unsupported db driver: %q", driver)
 	}
 
-	return store, nil
+	return newTracingStore(store, strings.ToLower(driver)), nil
 }
 
 type UnitVar struct {
@@ -158,6 +225,12 @@ type Transfer struct {
 	Status      adminv1.PackageStatus
 }
 
+type ProcessingPackage struct {
+	ID   uuid.UUID
+	Type enums.PackageType
+	Path string
+}
+
 type SIP struct {
 	ID          uuid.UUID
 	CreatedAt   time.Time
@@ -210,6 +283,23 @@ type FindAwaitingJobParams struct {
 	Group     *string
 }
 
+// CreateJobParams are the attributes of a new Job, every driver converts them
+// to the parameters of its queries.
+type CreateJobParams struct {
+	ID                uuid.UUID
+	Type              string
+	CreatedAt         time.Time
+	Createdtimedec    string
+	Directory         string
+	SIPID             uuid.UUID
+	Unittype          string
+	Currentstep       int32
+	Microservicegroup string
+	Hidden            bool
+	LinkID            uuid.NullUUID
+	Subjobof          string
+}
+
 type User struct {
 	ID       int
 	Username string
@@ -217,3 +307,28 @@ type User struct {
 	Active   bool
 	AgentID  *int
 }
+
+// Webhook is a subscription to package events delivered via HTTP.
+type Webhook struct {
+	ID        uuid.UUID
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+// WebhookDelivery is the outcome of delivering an event to a webhook. A
+// delivery that exhausted its attempts is recorded as a dead letter.
+type WebhookDelivery struct {
+	ID          uuid.UUID
+	WebhookID   uuid.UUID
+	Event       string
+	PackageID   uuid.UUID
+	Payload     string
+	Attempts    int
+	StatusCode  int
+	Error       string
+	DeadLetter  bool
+	CreatedAt   time.Time
+	CompletedAt time.Time
+}
@@ -0,0 +1,361 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"go.artefactual.dev/tools/ref"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+// testStore runs the test suite shared by the store implementations. newStore
+// must return a store backed by an empty database and a goqu database used to
+// seed the tables that the store does not write to.
+func testStore(t *testing.T, newStore func(t *testing.T) (Store, *goqu.Database)) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("Manages transfers", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newStore(t)
+		id := uuid.New()
+
+		_, err := s.ReadTransfer(ctx, id)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		created, err := s.UpsertTransfer(ctx, id, "/var/archivematica/transfer/")
+		assert.NilError(t, err)
+		assert.Assert(t, created)
+
+		created, err = s.UpsertTransfer(ctx, id, "/var/archivematica/transfer-moved/")
+		assert.NilError(t, err)
+		assert.Assert(t, !created)
+
+		loc, err := s.ReadTransferLocation(ctx, id)
+		assert.NilError(t, err)
+		assert.Equal(t, loc, "/var/archivematica/transfer-moved/")
+
+		found, created, err := s.EnsureTransfer(ctx, "/var/archivematica/transfer-moved/")
+		assert.NilError(t, err)
+		assert.Assert(t, !created)
+		assert.Equal(t, found, id)
+
+		assert.NilError(t, s.UpdatePackageStatus(ctx, id, enums.PackageTypeTransfer, enums.PackageStatusCompletedSuccessfully))
+		transfer, err := s.ReadTransfer(ctx, id)
+		assert.NilError(t, err)
+		assert.Equal(t, transfer.Status, adminv1.PackageStatus_PACKAGE_STATUS_COMPLETED_SUCCESSFULLY)
+
+		other, created, err := s.EnsureTransfer(ctx, "/var/archivematica/other/")
+		assert.NilError(t, err)
+		assert.Assert(t, created)
+		assert.Assert(t, other != id)
+
+		pkgs, err := s.ListProcessingPackages(ctx)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, pkgs, []ProcessingPackage{
+			{ID: other, Type: enums.PackageTypeTransfer, Path: "/var/archivematica/other/"},
+		})
+	})
+
+	t.Run("Manages SIPs and DIPs", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newStore(t)
+		sipID, dipID := uuid.New(), uuid.New()
+
+		created, err := s.UpsertSIP(ctx, sipID, "/var/archivematica/sip/")
+		assert.NilError(t, err)
+		assert.Assert(t, created)
+
+		created, err = s.UpsertDIP(ctx, dipID, "/var/archivematica/dip/")
+		assert.NilError(t, err)
+		assert.Assert(t, created)
+
+		sip, err := s.ReadSIP(ctx, sipID)
+		assert.NilError(t, err)
+		assert.Equal(t, sip.CurrentPath, "/var/archivematica/sip/")
+		assert.Equal(t, sip.Type, "SIP")
+		assert.Assert(t, !sip.CreatedAt.IsZero())
+
+		found, created, err := s.EnsureDIP(ctx, "/var/archivematica/dip/")
+		assert.NilError(t, err)
+		assert.Assert(t, !created)
+		assert.Equal(t, found, dipID)
+
+		pkgs, err := s.ListProcessingPackages(ctx)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, pkgs, []ProcessingPackage{
+			{ID: sipID, Type: enums.PackageTypeSIP, Path: "/var/archivematica/sip/"},
+			{ID: dipID, Type: enums.PackageTypeDIP, Path: "/var/archivematica/dip/"},
+		})
+	})
+
+	t.Run("Manages unit variables", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newStore(t)
+		id := uuid.New()
+		linkID := uuid.New()
+
+		_, err := s.ReadUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		assert.NilError(t, s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration", "automated", uuid.Nil, true))
+		assert.NilError(t, s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration", "automated", uuid.Nil, false))
+		err = s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration", "default", uuid.Nil, false)
+		assert.ErrorContains(t, err, "variable exists but with different propreties")
+		assert.NilError(t, s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration", "default", uuid.Nil, true))
+
+		value, err := s.ReadUnitVar(ctx, id, enums.PackageTypeSIP, "processingConfiguration")
+		assert.NilError(t, err)
+		assert.Equal(t, value, "default")
+
+		assert.NilError(t, s.CreateUnitVar(ctx, id, enums.PackageTypeSIP, "reNormalize", "", linkID, true))
+		got, err := s.ReadUnitLinkID(ctx, id, enums.PackageTypeSIP, "reNormalize")
+		assert.NilError(t, err)
+		assert.Equal(t, got, linkID)
+
+		vars, err := s.ReadUnitVars(ctx, id, enums.PackageTypeSIP, "reNormalize")
+		assert.NilError(t, err)
+		assert.Equal(t, len(vars), 1)
+		assert.Equal(t, *vars[0].LinkID, linkID)
+
+		assert.NilError(t, s.DeleteUnitVar(ctx, id, enums.PackageTypeSIP, "reNormalize"))
+		_, err = s.ReadUnitLinkID(ctx, id, enums.PackageTypeSIP, "reNormalize")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Manages jobs and tasks", func(t *testing.T) {
+		t.Parallel()
+
+		s, db := newStore(t)
+		transferID := uuid.New()
+		jobID := uuid.New()
+		taskID := uuid.New()
+		createdAt := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+		_, err := s.UpsertTransfer(ctx, transferID, "/var/archivematica/transfer/")
+		assert.NilError(t, err)
+
+		assert.NilError(t, s.CreateJob(ctx, &CreateJobParams{
+			ID:                jobID,
+			Type:              "Approve standard transfer",
+			CreatedAt:         createdAt,
+			Createdtimedec:    "0.5",
+			Directory:         "/var/archivematica/transfer/",
+			SIPID:             transferID,
+			Unittype:          "unitTransfer",
+			Currentstep:       1,
+			Microservicegroup: "Approve transfer",
+			LinkID:            uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		}))
+
+		job, err := s.FindAwaitingJob(ctx, &FindAwaitingJobParams{Directory: ref.New("/var/archivematica/transfer/")})
+		assert.NilError(t, err)
+		assert.Equal(t, job.Id, jobID.String())
+
+		jobs, err := s.ListJobs(ctx, transferID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(jobs), 1)
+		assert.Equal(t, jobs[0].Status, adminv1.JobStatus_JOB_STATUS_AWAITING_DECISION)
+		assert.Equal(t, jobs[0].PackageType, adminv1.PackageType_PACKAGE_TYPE_TRANSFER)
+		assert.Equal(t, jobs[0].CreatedAt.AsTime(), createdAt.Add(time.Second/2))
+
+		pkgs, err := s.ReadPackagesWithCreationTimestamps(ctx, adminv1.PackageType_PACKAGE_TYPE_TRANSFER)
+		assert.NilError(t, err)
+		assert.Equal(t, len(pkgs), 1)
+		assert.Equal(t, pkgs[0].Id, transferID.String())
+
+		assert.NilError(t, s.UpdateJobStatus(ctx, jobID, "STATUS_EXECUTING_COMMANDS"))
+		assert.NilError(t, s.CreateTasks(ctx, []*Task{{
+			ID:        taskID,
+			CreatedAt: createdAt,
+			Exec:      "copy_v0.0",
+			StartedAt: sql.NullTime{Time: createdAt, Valid: true},
+			JobID:     jobID,
+		}}))
+		assert.NilError(t, s.UpdateTasks(ctx, []*Task{{
+			ID:       taskID,
+			Stdout:   "done",
+			ExitCode: sql.NullInt16{Int16: 0, Valid: true},
+			EndedAt:  sql.NullTime{Time: createdAt.Add(time.Minute), Valid: true},
+		}}))
+
+		task := struct {
+			Stdout   string `db:"stdOut"`
+			ExitCode int    `db:"exitCode"`
+		}{}
+		ok, err := db.From("Tasks").Where(goqu.Ex{"taskUUID": taskID.String()}).ScanStructContext(ctx, &task)
+		assert.NilError(t, err)
+		assert.Assert(t, ok)
+		assert.Equal(t, task.Stdout, "done")
+		assert.Equal(t, task.ExitCode, 0)
+
+		// Jobs executing commands fail when the application is restarted.
+		assert.NilError(t, s.RemoveTransientData(ctx))
+		jobs, err = s.ListJobs(ctx, transferID)
+		assert.NilError(t, err)
+		assert.Equal(t, jobs[0].Status, adminv1.JobStatus_JOB_STATUS_FAILED)
+		transfer, err := s.ReadTransfer(ctx, transferID)
+		assert.NilError(t, err)
+		assert.Equal(t, transfer.Status, adminv1.PackageStatus_PACKAGE_STATUS_FAILED)
+
+		assert.NilError(t, s.HidePackage(ctx, transferID))
+		pkgs, err = s.ReadPackagesWithCreationTimestamps(ctx, adminv1.PackageType_PACKAGE_TYPE_TRANSFER)
+		assert.NilError(t, err)
+		assert.Equal(t, len(pkgs), 0)
+	})
+
+	t.Run("Lists files", func(t *testing.T) {
+		t.Parallel()
+
+		s, db := newStore(t)
+		sipID := uuid.New()
+		_, err := s.UpsertSIP(ctx, sipID, "/var/archivematica/sip/")
+		assert.NilError(t, err)
+
+		fileID := uuid.New()
+		insert(t, db, "Files", goqu.Record{
+			"fileUUID":         fileID.String(),
+			"originalLocation": "%transferDirectory%objects/image.jpg",
+			"currentLocation":  "%SIPDirectory%objects/image.jpg",
+			"fileGrpUse":       "original",
+			"fileGrpUUID":      "",
+			"checksum":         "",
+			"label":            "",
+			"enteredSystem":    time.Now(),
+			"sipUUID":          sipID.String(),
+			"checksumType":     "",
+		})
+
+		files, err := s.Files(ctx, sipID, enums.PackageTypeSIP, ".jpg", "objects/", "%SIPDirectory%")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, files, []File{{
+			ID:               fileID,
+			CurrentLocation:  "%SIPDirectory%objects/image.jpg",
+			OriginalLocation: "%transferDirectory%objects/image.jpg",
+			FileGrpUse:       "original",
+		}})
+	})
+
+	t.Run("Reads settings and users", func(t *testing.T) {
+		t.Parallel()
+
+		s, db := newStore(t)
+		pipelineID := uuid.New()
+		now := time.Now()
+		insert(t, db, "DashboardSettings",
+			goqu.Record{"name": "dashboard_uuid", "value": pipelineID.String(), "lastModified": now, "scope": ""},
+			goqu.Record{"name": "command", "value": "convert", "lastModified": now, "scope": "upload-qubit_v0.0"},
+		)
+		insert(t, db, "auth_user", goqu.Record{
+			"id":           1,
+			"password":     "",
+			"is_superuser": true,
+			"username":     "admin",
+			"first_name":   "",
+			"last_name":    "",
+			"email":        "admin@example.com",
+			"is_staff":     true,
+			"is_active":    true,
+			"date_joined":  now,
+		})
+		insert(t, db, "tastypie_apikey", goqu.Record{"key": "secret", "created": now, "user_id": 1})
+		insert(t, db, "main_userprofile", goqu.Record{"agent_id": 3, "user_id": 1, "system_emails": false})
+
+		id, err := s.ReadPipelineID(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, id, pipelineID)
+
+		dict, err := s.ReadDict(ctx, "upload-qubit_v0.0")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, dict, map[string]string{"command": "convert"})
+
+		user, err := s.ValidateUserAPIKey(ctx, "admin", "secret")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, user, &User{ID: 1, Username: "admin", Email: "admin@example.com", Active: true, AgentID: ref.New(3)})
+
+		user, err = s.ValidateUserAPIKey(ctx, "admin", "wrong")
+		assert.NilError(t, err)
+		assert.Assert(t, user == nil)
+	})
+
+	t.Run("Reads the settings of a scope as a dictionary", func(t *testing.T) {
+		t.Parallel()
+
+		s, db := newStore(t)
+		now := time.Now()
+		insert(t, db, "DashboardSettings",
+			goqu.Record{"name": "url", "value": "http://atom", "lastModified": now, "scope": "upload-qubit_v0.0"},
+			goqu.Record{"name": "email", "value": "archivist@example.com", "lastModified": now, "scope": "upload-qubit_v0.0"},
+			goqu.Record{"name": "url", "value": "http://archivesspace", "lastModified": now, "scope": "upload-archivesspace_v0.0"},
+		)
+
+		dict, err := s.ReadDict(ctx, "upload-qubit_v0.0")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, dict, map[string]string{"url": "http://atom", "email": "archivist@example.com"})
+
+		_, err = s.ReadDict(ctx, "upload-dspace_v0.0")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Manages webhooks", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newStore(t)
+		createdAt := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+		webhook := &Webhook{
+			ID:        uuid.New(),
+			URL:       "https://example.com/hook",
+			Secret:    "s3cr3t",
+			Events:    []string{"package.done", "package.failed"},
+			CreatedAt: createdAt,
+		}
+		assert.NilError(t, s.CreateWebhook(ctx, webhook))
+
+		webhooks, err := s.ListWebhooks(ctx)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, webhooks, []*Webhook{webhook})
+
+		delivery := &WebhookDelivery{
+			ID:          uuid.New(),
+			WebhookID:   webhook.ID,
+			Event:       "package.done",
+			PackageID:   uuid.New(),
+			Payload:     "{}",
+			Attempts:    2,
+			StatusCode:  200,
+			CreatedAt:   createdAt,
+			CompletedAt: createdAt.Add(time.Second),
+		}
+		assert.NilError(t, s.CreateWebhookDelivery(ctx, delivery))
+
+		deliveries, err := s.ListWebhookDeliveries(ctx, webhook.ID, 10)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, deliveries, []*WebhookDelivery{delivery})
+
+		assert.NilError(t, s.DeleteWebhook(ctx, webhook.ID))
+		assert.ErrorIs(t, s.DeleteWebhook(ctx, webhook.ID), ErrNotFound)
+	})
+}
+
+func insert(t *testing.T, db *goqu.Database, table string, rows ...goqu.Record) {
+	t.Helper()
+
+	values := make([]any, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, row)
+	}
+
+	_, err := db.Insert(table).Rows(values...).Executor().Exec()
+	assert.NilError(t, err)
+}
@@ -16,7 +16,6 @@ import (
 	adminv1beta1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
 	store "github.com/artefactual-labs/ccp/internal/store"
 	enums "github.com/artefactual-labs/ccp/internal/store/enums"
-	sqlcmysql "github.com/artefactual-labs/ccp/internal/store/sqlcmysql"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -84,7 +83,7 @@ func (c *MockStoreCloseCall) DoAndReturn(f func() error) *MockStoreCloseCall {
 }
 
 // CreateJob mocks base method.
-func (m *MockStore) CreateJob(ctx context.Context, params *sqlcmysql.CreateJobParams) error {
+func (m *MockStore) CreateJob(ctx context.Context, params *store.CreateJobParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateJob", ctx, params)
 	ret0, _ := ret[0].(error)
@@ -110,13 +109,13 @@ func (c *MockStoreCreateJobCall) Return(arg0 error) *MockStoreCreateJobCall {
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStoreCreateJobCall) Do(f func(context.Context, *sqlcmysql.CreateJobParams) error) *MockStoreCreateJobCall {
+func (c *MockStoreCreateJobCall) Do(f func(context.Context, *store.CreateJobParams) error) *MockStoreCreateJobCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStoreCreateJobCall) DoAndReturn(f func(context.Context, *sqlcmysql.CreateJobParams) error) *MockStoreCreateJobCall {
+func (c *MockStoreCreateJobCall) DoAndReturn(f func(context.Context, *store.CreateJobParams) error) *MockStoreCreateJobCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -235,6 +234,158 @@ func (c *MockStoreCreateUnitVarCall) DoAndReturn(f func(context.Context, uuid.UU
 	return c
 }
 
+// CreateWebhook mocks base method.
+func (m *MockStore) CreateWebhook(ctx context.Context, webhook *store.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockStoreMockRecorder) CreateWebhook(ctx, webhook any) *MockStoreCreateWebhookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockStore)(nil).CreateWebhook), ctx, webhook)
+	return &MockStoreCreateWebhookCall{Call: call}
+}
+
+// MockStoreCreateWebhookCall wrap *gomock.Call
+type MockStoreCreateWebhookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateWebhookCall) Return(arg0 error) *MockStoreCreateWebhookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateWebhookCall) Do(f func(context.Context, *store.Webhook) error) *MockStoreCreateWebhookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateWebhookCall) DoAndReturn(f func(context.Context, *store.Webhook) error) *MockStoreCreateWebhookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockStore) CreateWebhookDelivery(ctx context.Context, delivery *store.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockStoreMockRecorder) CreateWebhookDelivery(ctx, delivery any) *MockStoreCreateWebhookDeliveryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockStore)(nil).CreateWebhookDelivery), ctx, delivery)
+	return &MockStoreCreateWebhookDeliveryCall{Call: call}
+}
+
+// MockStoreCreateWebhookDeliveryCall wrap *gomock.Call
+type MockStoreCreateWebhookDeliveryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateWebhookDeliveryCall) Return(arg0 error) *MockStoreCreateWebhookDeliveryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateWebhookDeliveryCall) Do(f func(context.Context, *store.WebhookDelivery) error) *MockStoreCreateWebhookDeliveryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateWebhookDeliveryCall) DoAndReturn(f func(context.Context, *store.WebhookDelivery) error) *MockStoreCreateWebhookDeliveryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteUnitVar mocks base method.
+func (m *MockStore) DeleteUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnitVar", ctx, id, packageType, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnitVar indicates an expected call of DeleteUnitVar.
+func (mr *MockStoreMockRecorder) DeleteUnitVar(ctx, id, packageType, name any) *MockStoreDeleteUnitVarCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnitVar", reflect.TypeOf((*MockStore)(nil).DeleteUnitVar), ctx, id, packageType, name)
+	return &MockStoreDeleteUnitVarCall{Call: call}
+}
+
+// MockStoreDeleteUnitVarCall wrap *gomock.Call
+type MockStoreDeleteUnitVarCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteUnitVarCall) Return(arg0 error) *MockStoreDeleteUnitVarCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteUnitVarCall) Do(f func(context.Context, uuid.UUID, enums.PackageType, string) error) *MockStoreDeleteUnitVarCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteUnitVarCall) DoAndReturn(f func(context.Context, uuid.UUID, enums.PackageType, string) error) *MockStoreDeleteUnitVarCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockStore) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockStoreMockRecorder) DeleteWebhook(ctx, id any) *MockStoreDeleteWebhookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockStore)(nil).DeleteWebhook), ctx, id)
+	return &MockStoreDeleteWebhookCall{Call: call}
+}
+
+// MockStoreDeleteWebhookCall wrap *gomock.Call
+type MockStoreDeleteWebhookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteWebhookCall) Return(arg0 error) *MockStoreDeleteWebhookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteWebhookCall) Do(f func(context.Context, uuid.UUID) error) *MockStoreDeleteWebhookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteWebhookCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockStoreDeleteWebhookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnsureDIP mocks base method.
 func (m *MockStore) EnsureDIP(ctx context.Context, path string) (uuid.UUID, bool, error) {
 	m.ctrl.T.Helper()
@@ -433,6 +584,44 @@ func (c *MockStoreFindAwaitingJobCall) DoAndReturn(f func(context.Context, *stor
 	return c
 }
 
+// HidePackage mocks base method.
+func (m *MockStore) HidePackage(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HidePackage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HidePackage indicates an expected call of HidePackage.
+func (mr *MockStoreMockRecorder) HidePackage(ctx, id any) *MockStoreHidePackageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HidePackage", reflect.TypeOf((*MockStore)(nil).HidePackage), ctx, id)
+	return &MockStoreHidePackageCall{Call: call}
+}
+
+// MockStoreHidePackageCall wrap *gomock.Call
+type MockStoreHidePackageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreHidePackageCall) Return(arg0 error) *MockStoreHidePackageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreHidePackageCall) Do(f func(context.Context, uuid.UUID) error) *MockStoreHidePackageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreHidePackageCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockStoreHidePackageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListJobs mocks base method.
 func (m *MockStore) ListJobs(ctx context.Context, pkgID uuid.UUID) ([]*adminv1beta1.Job, error) {
 	m.ctrl.T.Helper()
@@ -472,6 +661,123 @@ func (c *MockStoreListJobsCall) DoAndReturn(f func(context.Context, uuid.UUID) (
 	return c
 }
 
+// ListProcessingPackages mocks base method.
+func (m *MockStore) ListProcessingPackages(ctx context.Context) ([]store.ProcessingPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProcessingPackages", ctx)
+	ret0, _ := ret[0].([]store.ProcessingPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProcessingPackages indicates an expected call of ListProcessingPackages.
+func (mr *MockStoreMockRecorder) ListProcessingPackages(ctx any) *MockStoreListProcessingPackagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProcessingPackages", reflect.TypeOf((*MockStore)(nil).ListProcessingPackages), ctx)
+	return &MockStoreListProcessingPackagesCall{Call: call}
+}
+
+// MockStoreListProcessingPackagesCall wrap *gomock.Call
+type MockStoreListProcessingPackagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreListProcessingPackagesCall) Return(arg0 []store.ProcessingPackage, arg1 error) *MockStoreListProcessingPackagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreListProcessingPackagesCall) Do(f func(context.Context) ([]store.ProcessingPackage, error)) *MockStoreListProcessingPackagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreListProcessingPackagesCall) DoAndReturn(f func(context.Context) ([]store.ProcessingPackage, error)) *MockStoreListProcessingPackagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockStore) ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*store.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, webhookID, limit)
+	ret0, _ := ret[0].([]*store.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ListWebhookDeliveries(ctx, webhookID, limit any) *MockStoreListWebhookDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ListWebhookDeliveries), ctx, webhookID, limit)
+	return &MockStoreListWebhookDeliveriesCall{Call: call}
+}
+
+// MockStoreListWebhookDeliveriesCall wrap *gomock.Call
+type MockStoreListWebhookDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreListWebhookDeliveriesCall) Return(arg0 []*store.WebhookDelivery, arg1 error) *MockStoreListWebhookDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreListWebhookDeliveriesCall) Do(f func(context.Context, uuid.UUID, int) ([]*store.WebhookDelivery, error)) *MockStoreListWebhookDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreListWebhookDeliveriesCall) DoAndReturn(f func(context.Context, uuid.UUID, int) ([]*store.WebhookDelivery, error)) *MockStoreListWebhookDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWebhooks mocks base method.
+func (m *MockStore) ListWebhooks(ctx context.Context) ([]*store.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]*store.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockStoreMockRecorder) ListWebhooks(ctx any) *MockStoreListWebhooksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockStore)(nil).ListWebhooks), ctx)
+	return &MockStoreListWebhooksCall{Call: call}
+}
+
+// MockStoreListWebhooksCall wrap *gomock.Call
+type MockStoreListWebhooksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreListWebhooksCall) Return(arg0 []*store.Webhook, arg1 error) *MockStoreListWebhooksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreListWebhooksCall) Do(f func(context.Context) ([]*store.Webhook, error)) *MockStoreListWebhooksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreListWebhooksCall) DoAndReturn(f func(context.Context) ([]*store.Webhook, error)) *MockStoreListWebhooksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReadDIP mocks base method.
 func (m *MockStore) ReadDIP(ctx context.Context, id uuid.UUID) (store.DIP, error) {
 	m.ctrl.T.Helper()
@@ -1014,6 +1320,44 @@ func (c *MockStoreUpdatePackageStatusCall) DoAndReturn(f func(context.Context, u
 	return c
 }
 
+// UpdateTasks mocks base method.
+func (m *MockStore) UpdateTasks(ctx context.Context, tasks []*store.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTasks", ctx, tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTasks indicates an expected call of UpdateTasks.
+func (mr *MockStoreMockRecorder) UpdateTasks(ctx, tasks any) *MockStoreUpdateTasksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTasks", reflect.TypeOf((*MockStore)(nil).UpdateTasks), ctx, tasks)
+	return &MockStoreUpdateTasksCall{Call: call}
+}
+
+// MockStoreUpdateTasksCall wrap *gomock.Call
+type MockStoreUpdateTasksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateTasksCall) Return(arg0 error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateTasksCall) Do(f func(context.Context, []*store.Task) error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateTasksCall) DoAndReturn(f func(context.Context, []*store.Task) error) *MockStoreUpdateTasksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateTransferLocation mocks base method.
 func (m *MockStore) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) error {
 	m.ctrl.T.Helper()
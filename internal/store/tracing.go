@@ -0,0 +1,316 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store/enums"
+)
+
+// tracingStore records a span for every call made to the underlying store.
+type tracingStore struct {
+	next   Store
+	tracer trace.Tracer
+	system attribute.KeyValue
+}
+
+var _ Store = (*tracingStore)(nil)
+
+func newTracingStore(next Store, driver string) *tracingStore {
+	return &tracingStore{
+		next:   next,
+		tracer: otel.Tracer("github.com/artefactual-labs/ccp/internal/store"),
+		system: attribute.String("db.system", driver),
+	}
+}
+
+func (s *tracingStore) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, "store."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(s.system, attribute.String("db.operation.name", method)),
+	)
+}
+
+// end records the error, if any, and ends the span. ErrNotFound is not
+// considered a failure of the store.
+func (s *tracingStore) end(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (s *tracingStore) RemoveTransientData(ctx context.Context) (err error) {
+	ctx, span := s.start(ctx, "RemoveTransientData")
+	defer func() { s.end(span, err) }()
+
+	return s.next.RemoveTransientData(ctx)
+}
+
+func (s *tracingStore) CreateJob(ctx context.Context, params *CreateJobParams) (err error) {
+	ctx, span := s.start(ctx, "CreateJob")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateJob(ctx, params)
+}
+
+func (s *tracingStore) UpdateJobStatus(ctx context.Context, id uuid.UUID, status string) (err error) {
+	ctx, span := s.start(ctx, "UpdateJobStatus")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpdateJobStatus(ctx, id, status)
+}
+
+func (s *tracingStore) FindAwaitingJob(ctx context.Context, params *FindAwaitingJobParams) (_ *adminv1.Job, err error) {
+	ctx, span := s.start(ctx, "FindAwaitingJob")
+	defer func() { s.end(span, err) }()
+
+	return s.next.FindAwaitingJob(ctx, params)
+}
+
+func (s *tracingStore) ListJobs(ctx context.Context, pkgID uuid.UUID) (_ []*adminv1.Job, err error) {
+	ctx, span := s.start(ctx, "ListJobs")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ListJobs(ctx, pkgID)
+}
+
+func (s *tracingStore) CreateTasks(ctx context.Context, tasks []*Task) (err error) {
+	ctx, span := s.start(ctx, "CreateTasks")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateTasks(ctx, tasks)
+}
+
+func (s *tracingStore) UpdateTasks(ctx context.Context, tasks []*Task) (err error) {
+	ctx, span := s.start(ctx, "UpdateTasks")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpdateTasks(ctx, tasks)
+}
+
+func (s *tracingStore) ReadPackagesWithCreationTimestamps(ctx context.Context, packageType adminv1.PackageType) (_ []*adminv1.Package, err error) {
+	ctx, span := s.start(ctx, "ReadPackagesWithCreationTimestamps")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadPackagesWithCreationTimestamps(ctx, packageType)
+}
+
+func (s *tracingStore) UpdatePackageStatus(ctx context.Context, id uuid.UUID, packageType enums.PackageType, status enums.PackageStatus) (err error) {
+	ctx, span := s.start(ctx, "UpdatePackageStatus")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpdatePackageStatus(ctx, id, packageType, status)
+}
+
+func (s *tracingStore) ListProcessingPackages(ctx context.Context) (_ []ProcessingPackage, err error) {
+	ctx, span := s.start(ctx, "ListProcessingPackages")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ListProcessingPackages(ctx)
+}
+
+func (s *tracingStore) ReadTransferLocation(ctx context.Context, id uuid.UUID) (_ string, err error) {
+	ctx, span := s.start(ctx, "ReadTransferLocation")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadTransferLocation(ctx, id)
+}
+
+func (s *tracingStore) CreateTransfer(ctx context.Context, id uuid.UUID, accessionID, accessSystemID string, metadataSetID uuid.UUID) (err error) {
+	ctx, span := s.start(ctx, "CreateTransfer")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateTransfer(ctx, id, accessionID, accessSystemID, metadataSetID)
+}
+
+func (s *tracingStore) ReadTransfer(ctx context.Context, id uuid.UUID) (_ Transfer, err error) {
+	ctx, span := s.start(ctx, "ReadTransfer")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadTransfer(ctx, id)
+}
+
+func (s *tracingStore) UpsertTransfer(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	ctx, span := s.start(ctx, "UpsertTransfer")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpsertTransfer(ctx, id, path)
+}
+
+func (s *tracingStore) EnsureTransfer(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	ctx, span := s.start(ctx, "EnsureTransfer")
+	defer func() { s.end(span, err) }()
+
+	return s.next.EnsureTransfer(ctx, path)
+}
+
+func (s *tracingStore) UpdateTransferLocation(ctx context.Context, id uuid.UUID, path string) (err error) {
+	ctx, span := s.start(ctx, "UpdateTransferLocation")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpdateTransferLocation(ctx, id, path)
+}
+
+func (s *tracingStore) HidePackage(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := s.start(ctx, "HidePackage")
+	defer func() { s.end(span, err) }()
+
+	return s.next.HidePackage(ctx, id)
+}
+
+func (s *tracingStore) ReadSIP(ctx context.Context, id uuid.UUID) (_ SIP, err error) {
+	ctx, span := s.start(ctx, "ReadSIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadSIP(ctx, id)
+}
+
+func (s *tracingStore) UpsertSIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	ctx, span := s.start(ctx, "UpsertSIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpsertSIP(ctx, id, path)
+}
+
+func (s *tracingStore) EnsureSIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	ctx, span := s.start(ctx, "EnsureSIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.EnsureSIP(ctx, path)
+}
+
+func (s *tracingStore) ReadDIP(ctx context.Context, id uuid.UUID) (_ DIP, err error) {
+	ctx, span := s.start(ctx, "ReadDIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadDIP(ctx, id)
+}
+
+func (s *tracingStore) UpsertDIP(ctx context.Context, id uuid.UUID, path string) (_ bool, err error) {
+	ctx, span := s.start(ctx, "UpsertDIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.UpsertDIP(ctx, id, path)
+}
+
+func (s *tracingStore) EnsureDIP(ctx context.Context, path string) (_ uuid.UUID, _ bool, err error) {
+	ctx, span := s.start(ctx, "EnsureDIP")
+	defer func() { s.end(span, err) }()
+
+	return s.next.EnsureDIP(ctx, path)
+}
+
+func (s *tracingStore) ReadUnitVars(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ []UnitVar, err error) {
+	ctx, span := s.start(ctx, "ReadUnitVars")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadUnitVars(ctx, id, packageType, name)
+}
+
+func (s *tracingStore) ReadUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ string, err error) {
+	ctx, span := s.start(ctx, "ReadUnitVar")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadUnitVar(ctx, id, packageType, name)
+}
+
+func (s *tracingStore) ReadUnitLinkID(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (_ uuid.UUID, err error) {
+	ctx, span := s.start(ctx, "ReadUnitLinkID")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadUnitLinkID(ctx, id, packageType, name)
+}
+
+func (s *tracingStore) CreateUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name, value string, linkID uuid.UUID, update bool) (err error) {
+	ctx, span := s.start(ctx, "CreateUnitVar")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateUnitVar(ctx, id, packageType, name, value, linkID, update)
+}
+
+func (s *tracingStore) DeleteUnitVar(ctx context.Context, id uuid.UUID, packageType enums.PackageType, name string) (err error) {
+	ctx, span := s.start(ctx, "DeleteUnitVar")
+	defer func() { s.end(span, err) }()
+
+	return s.next.DeleteUnitVar(ctx, id, packageType, name)
+}
+
+func (s *tracingStore) Files(ctx context.Context, id uuid.UUID, packageType enums.PackageType, filterFilenameEnd, filterSubdir, replacementPath string) (_ []File, err error) {
+	ctx, span := s.start(ctx, "Files")
+	defer func() { s.end(span, err) }()
+
+	return s.next.Files(ctx, id, packageType, filterFilenameEnd, filterSubdir, replacementPath)
+}
+
+func (s *tracingStore) ReadPipelineID(ctx context.Context) (_ uuid.UUID, err error) {
+	ctx, span := s.start(ctx, "ReadPipelineID")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadPipelineID(ctx)
+}
+
+func (s *tracingStore) ReadDict(ctx context.Context, name string) (_ map[string]string, err error) {
+	ctx, span := s.start(ctx, "ReadDict")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ReadDict(ctx, name)
+}
+
+func (s *tracingStore) CreateWebhook(ctx context.Context, webhook *Webhook) (err error) {
+	ctx, span := s.start(ctx, "CreateWebhook")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateWebhook(ctx, webhook)
+}
+
+func (s *tracingStore) ListWebhooks(ctx context.Context) (_ []*Webhook, err error) {
+	ctx, span := s.start(ctx, "ListWebhooks")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ListWebhooks(ctx)
+}
+
+func (s *tracingStore) DeleteWebhook(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := s.start(ctx, "DeleteWebhook")
+	defer func() { s.end(span, err) }()
+
+	return s.next.DeleteWebhook(ctx, id)
+}
+
+func (s *tracingStore) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (err error) {
+	ctx, span := s.start(ctx, "CreateWebhookDelivery")
+	defer func() { s.end(span, err) }()
+
+	return s.next.CreateWebhookDelivery(ctx, delivery)
+}
+
+func (s *tracingStore) ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) (_ []*WebhookDelivery, err error) {
+	ctx, span := s.start(ctx, "ListWebhookDeliveries")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ListWebhookDeliveries(ctx, webhookID, limit)
+}
+
+func (s *tracingStore) ValidateUserAPIKey(ctx context.Context, username, key string) (_ *User, err error) {
+	ctx, span := s.start(ctx, "ValidateUserAPIKey")
+	defer func() { s.end(span, err) }()
+
+	return s.next.ValidateUserAPIKey(ctx, username, key)
+}
+
+func (s *tracingStore) Running() bool {
+	return s.next.Running()
+}
+
+func (s *tracingStore) Close() error {
+	return s.next.Close()
+}
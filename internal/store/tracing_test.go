@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gotest.tools/v3/assert"
+)
+
+// fakeStore implements the methods used by the tests, the rest panic.
+type fakeStore struct {
+	Store
+	err error
+}
+
+func (s *fakeStore) ReadTransfer(ctx context.Context, id uuid.UUID) (Transfer, error) {
+	return Transfer{ID: id}, s.err
+}
+
+func TestTracingStore(t *testing.T) {
+	t.Parallel()
+
+	newStore := func(t *testing.T, err error) (*tracingStore, *tracetest.SpanRecorder) {
+		t.Helper()
+
+		sr := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+		s := newTracingStore(&fakeStore{err: err}, "mysql")
+		s.tracer = tp.Tracer("test")
+
+		return s, sr
+	}
+
+	t.Run("Records a span per call", func(t *testing.T) {
+		t.Parallel()
+
+		s, sr := newStore(t, nil)
+		id := uuid.New()
+
+		transfer, err := s.ReadTransfer(context.Background(), id)
+		assert.NilError(t, err)
+		assert.Equal(t, transfer.ID, id)
+
+		spans := sr.Ended()
+		assert.Equal(t, len(spans), 1)
+		assert.Equal(t, spans[0].Name(), "store.ReadTransfer")
+		assert.Equal(t, spans[0].Status().Code, codes.Unset)
+	})
+
+	t.Run("Records errors", func(t *testing.T) {
+		t.Parallel()
+
+		s, sr := newStore(t, errors.New("connection refused"))
+
+		_, err := s.ReadTransfer(context.Background(), uuid.New())
+		assert.Error(t, err, "connection refused")
+
+		spans := sr.Ended()
+		assert.Equal(t, len(spans), 1)
+		assert.Equal(t, spans[0].Status().Code, codes.Error)
+		assert.Equal(t, spans[0].Status().Description, "connection refused")
+	})
+
+	t.Run("Ignores ErrNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		s, sr := newStore(t, ErrNotFound)
+
+		_, err := s.ReadTransfer(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		spans := sr.Ended()
+		assert.Equal(t, len(spans), 1)
+		assert.Equal(t, spans[0].Status().Code, codes.Unset)
+	})
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	// Subscriptions set in the server configuration, they cannot be modified
+	// via the API.
+	Subscriptions []Subscription
+
+	// MaxAttempts is the number of attempts made before a delivery is recorded
+	// as a dead letter.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, it doubles with every
+	// attempt up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Timeout of every attempt.
+	Timeout time.Duration
+
+	// Workers is the number of events delivered concurrently.
+	Workers int
+
+	// QueueSize is the number of events waiting for a worker, events that do
+	// not fit are recorded as failed deliveries.
+	QueueSize int
+}
+
+// Subscription is a webhook subscription set in the server configuration.
+type Subscription struct {
+	URL string
+
+	// Secret used to sign the payloads. Secrets of webhooks created via the
+	// API are stored unencrypted in the database, restrict access to it
+	// accordingly.
+	Secret string
+
+	Events []string
+}
+
+// ParseSubscription parses a subscription given as a list of space-separated
+// key-value pairs, e.g.:
+//
+//	url=https://example.com/hook secret=s3cr3t events=package.done,package.failed
+//
+// All the events are delivered when events is not given.
+func ParseSubscription(value string) (Subscription, error) {
+	var sub Subscription
+
+	for _, field := range strings.Fields(value) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return sub, fmt.Errorf("invalid field %q: missing value", field)
+		}
+		switch key {
+		case "url":
+			sub.URL = val
+		case "secret":
+			sub.Secret = val
+		case "events":
+			sub.Events = strings.Split(val, ",")
+		default:
+			return sub, fmt.Errorf("invalid field %q: unknown key", field)
+		}
+	}
+
+	if err := sub.validate(); err != nil {
+		return sub, err
+	}
+
+	return sub, nil
+}
+
+func (s Subscription) validate() error {
+	if u, err := url.Parse(s.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %q", s.URL)
+	}
+	if s.Secret == "" {
+		return errors.New("missing secret")
+	}
+
+	return validateEvents(s.Events)
+}
+
+func validateEvents(events []string) error {
+	for _, item := range events {
+		if !slices.Contains(Events, item) {
+			return fmt.Errorf("unknown event %q", item)
+		}
+	}
+
+	return nil
+}
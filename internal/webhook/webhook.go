@@ -0,0 +1,539 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/controller"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/version"
+)
+
+// Events delivered to webhooks.
+const (
+	EventPackageDone      = "package.done"
+	EventPackageFailed    = "package.failed"
+	EventDecisionAwaiting = "decision.awaiting"
+)
+
+// Events lists the events that can be delivered.
+var Events = []string{EventPackageDone, EventPackageFailed, EventDecisionAwaiting}
+
+// eventTypes maps the package events published by the controller to the
+// events delivered.
+var eventTypes = map[adminv1.PackageEventType]string{
+	adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_DONE:      EventPackageDone,
+	adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_FAILED:    EventPackageFailed,
+	adminv1.PackageEventType_PACKAGE_EVENT_TYPE_DECISION_AWAITING: EventDecisionAwaiting,
+}
+
+// ErrReadOnly is returned when modifying a webhook set in the configuration.
+var ErrReadOnly = errors.New("webhook is read-only")
+
+// Headers sent with every delivery.
+const (
+	HeaderEvent     = "X-CCP-Event"
+	HeaderDelivery  = "X-CCP-Delivery"
+	HeaderSignature = "X-CCP-Signature"
+)
+
+// recordTimeout limits the time spent recording a delivery, deliveries are
+// also recorded while the dispatcher is closing.
+const recordTimeout = 10 * time.Second
+
+// Dispatcher delivers the package events published by the controller to the
+// webhooks. Events are queued and delivered by a fixed number of workers.
+// Deliveries are retried with exponential backoff and their final outcome is
+// recorded in the store, failed deliveries as dead letters.
+//
+// Events that cannot be delivered, i.e. those that do not fit in the queue or
+// that are pending when the dispatcher is closed, are recorded as dead letters
+// without attempts.
+type Dispatcher struct {
+	logger logr.Logger
+	config Config
+	store  store.Store
+	client *http.Client
+
+	// static are the webhooks set in the configuration.
+	static []*store.Webhook
+
+	// queue holds the events waiting for a worker.
+	queue chan *adminv1.PackageEvent
+
+	// dropped holds the events that could not be delivered until they are
+	// recorded, see drop.
+	mu        sync.Mutex
+	dropped   []droppedEvent
+	droppedCh chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type droppedEvent struct {
+	event  *adminv1.PackageEvent
+	reason string
+}
+
+func New(logger logr.Logger, config Config, s store.Store) (*Dispatcher, error) {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	if config.MaxBackoff < config.Backoff {
+		config.MaxBackoff = config.Backoff
+	}
+	if config.Workers < 1 {
+		config.Workers = 1
+	}
+	if config.QueueSize < 1 {
+		config.QueueSize = 1
+	}
+
+	d := &Dispatcher{
+		logger:    logger,
+		config:    config,
+		store:     s,
+		client:    &http.Client{Timeout: config.Timeout},
+		queue:     make(chan *adminv1.PackageEvent, config.QueueSize),
+		droppedCh: make(chan struct{}, 1),
+	}
+
+	for _, sub := range config.Subscriptions {
+		if err := sub.validate(); err != nil {
+			return nil, err
+		}
+		id := uuid.NewSHA1(uuid.NameSpaceURL, []byte(sub.URL))
+		if d.ReadOnly(id) {
+			return nil, fmt.Errorf("duplicate subscription %q", sub.URL)
+		}
+		d.static = append(d.static, &store.Webhook{
+			ID:     id,
+			URL:    sub.URL,
+			Secret: sub.Secret,
+			Events: sub.Events,
+		})
+	}
+
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+
+	for range config.Workers {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.work()
+		}()
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case <-d.droppedCh:
+				d.recordDropped()
+			}
+		}
+	}()
+
+	return d, nil
+}
+
+// Run starts delivering the events published by the controller. The
+// subscription is not dropped when the dispatcher falls behind, the events
+// that do not fit are recorded as failed deliveries instead.
+func (d *Dispatcher) Run(ctrl *controller.Controller) {
+	sub := ctrl.SubscribeWithOverflow(uuid.Nil, func(event *adminv1.PackageEvent) {
+		d.drop(event, "event dropped, the dispatcher is not keeping up with events")
+	})
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		for {
+			select {
+			case <-d.ctx.Done():
+				// Events received but not dispatched yet are dropped.
+				ctrl.Unsubscribe(sub)
+				for event := range sub.Events() {
+					d.drop(event, "event dropped, the dispatcher was closed")
+				}
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					return // The controller is closed.
+				}
+				d.dispatch(event)
+			}
+		}
+	}()
+}
+
+// dispatch queues an event for delivery, it does not block. The event is
+// dropped when the queue is full.
+func (d *Dispatcher) dispatch(event *adminv1.PackageEvent) {
+	if _, ok := eventTypes[event.Type]; !ok {
+		return
+	}
+
+	select {
+	case d.queue <- event:
+	default:
+		d.drop(event, "event dropped, the webhook queue is full")
+	}
+}
+
+// work delivers the queued events until the dispatcher is closed.
+func (d *Dispatcher) work() {
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case event := <-d.queue:
+			if d.ctx.Err() != nil {
+				d.drop(event, "event dropped, the dispatcher was closed")
+				return
+			}
+			for _, item := range d.deliveries(d.ctx, event) {
+				d.deliver(d.ctx, item.webhook, item.delivery)
+			}
+		}
+	}
+}
+
+type pendingDelivery struct {
+	webhook  *store.Webhook
+	delivery *store.WebhookDelivery
+}
+
+// deliveries returns a delivery of the event for every webhook subscribed.
+func (d *Dispatcher) deliveries(ctx context.Context, event *adminv1.PackageEvent) []pendingDelivery {
+	name := eventTypes[event.Type]
+
+	packageID, err := uuid.Parse(event.PackageId)
+	if err != nil {
+		d.logger.Error(err, "Failed to parse package identifier.", "event", name)
+		return nil
+	}
+
+	payload, err := newPayload(name, event)
+	if err != nil {
+		d.logger.Error(err, "Failed to encode webhook payload.", "event", name)
+		return nil
+	}
+
+	webhooks, err := d.webhooks(ctx)
+	if err != nil {
+		d.logger.Error(err, "Failed to list webhooks.")
+		webhooks = d.static
+	}
+
+	var ret []pendingDelivery
+	for _, wh := range webhooks {
+		if len(wh.Events) > 0 && !slices.Contains(wh.Events, name) {
+			continue
+		}
+		ret = append(ret, pendingDelivery{
+			webhook: wh,
+			delivery: &store.WebhookDelivery{
+				ID:        uuid.New(),
+				WebhookID: wh.ID,
+				Event:     name,
+				PackageID: packageID,
+				Payload:   string(payload),
+				CreatedAt: event.CreatedAt.AsTime(),
+			},
+		})
+	}
+
+	return ret
+}
+
+// drop records that an event could not be delivered, it does not block. The
+// event is recorded as a failed delivery for every webhook subscribed.
+func (d *Dispatcher) drop(event *adminv1.PackageEvent, reason string) {
+	if _, ok := eventTypes[event.Type]; !ok {
+		return
+	}
+
+	d.mu.Lock()
+	d.dropped = append(d.dropped, droppedEvent{event: event, reason: reason})
+	d.mu.Unlock()
+
+	select {
+	case d.droppedCh <- struct{}{}:
+	default:
+	}
+}
+
+// recordDropped records the dropped events as failed deliveries.
+func (d *Dispatcher) recordDropped() {
+	d.mu.Lock()
+	dropped := d.dropped
+	d.dropped = nil
+	d.mu.Unlock()
+
+	for _, item := range dropped {
+		d.logger.Info("Webhook event dropped.", "reason", item.reason, "package", item.event.PackageId)
+		ctx, cancel := d.recordContext()
+		for _, pending := range d.deliveries(ctx, item.event) {
+			pending.delivery.Error = item.reason
+			pending.delivery.DeadLetter = true
+			pending.delivery.CompletedAt = time.Now()
+			d.record(pending.delivery)
+		}
+		cancel()
+	}
+}
+
+// deliver attempts to deliver the payload until it succeeds, the attempts are
+// exhausted or the error cannot be recovered. The outcome is recorded in the
+// store, a delivery abandoned because the dispatcher is closed is recorded as
+// a dead letter.
+func (d *Dispatcher) deliver(ctx context.Context, wh *store.Webhook, delivery *store.WebhookDelivery) {
+	logger := d.logger.WithValues("webhook", wh.ID, "delivery", delivery.ID, "event", delivery.Event)
+	backoff := d.config.Backoff
+
+	for attempt := 1; ; attempt++ {
+		delivery.Attempts = attempt
+		status, err := d.post(ctx, wh, delivery)
+		delivery.StatusCode = status
+		if err == nil {
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+		if attempt >= d.config.MaxAttempts || !retryable(status) {
+			delivery.DeadLetter = true
+			break
+		}
+
+		logger.V(2).Info("Webhook delivery failed, retrying.", "attempt", attempt, "err", err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		if ctx.Err() != nil {
+			delivery.Error = "delivery abandoned, the dispatcher was closed: " + delivery.Error
+			delivery.DeadLetter = true
+			break
+		}
+		backoff = min(backoff*2, d.config.MaxBackoff)
+	}
+
+	delivery.CompletedAt = time.Now()
+	if delivery.DeadLetter {
+		logger.Info("Webhook delivery failed.", "attempts", delivery.Attempts, "err", delivery.Error)
+	}
+
+	d.record(delivery)
+}
+
+// record stores the outcome of a delivery, also when the dispatcher is closed.
+func (d *Dispatcher) record(delivery *store.WebhookDelivery) {
+	ctx, cancel := d.recordContext()
+	defer cancel()
+
+	if err := d.store.CreateWebhookDelivery(ctx, delivery); err != nil {
+		d.logger.Error(err, "Failed to record webhook delivery.", "webhook", delivery.WebhookID, "delivery", delivery.ID)
+	}
+}
+
+// recordContext returns the context used to record deliveries, it is not
+// cancelled when the dispatcher is closed.
+func (d *Dispatcher) recordContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(d.ctx), recordTimeout)
+}
+
+// post makes a single delivery attempt. It returns the status code of the
+// response, or zero if there was no response.
+func (d *Dispatcher) post(ctx context.Context, wh *store.Webhook, delivery *store.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ccp/"+version.Version())
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, Sign(wh.Secret, []byte(delivery.Payload)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryable reports whether an attempt that received the given status code,
+// or none, is worth retrying.
+func retryable(status int) bool {
+	switch {
+	case status == 0:
+		return true
+	case status == http.StatusRequestTimeout, status == http.StatusTooManyRequests:
+		return true
+	case status >= 500:
+		return true
+	default:
+		return false
+	}
+}
+
+// Sign returns the signature of a payload sent in the X-CCP-Signature header,
+// i.e. the hex-encoded HMAC-SHA256 of the payload prefixed by "sha256=".
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type payload struct {
+	Event      string         `json:"event"`
+	CreatedAt  time.Time      `json:"created_at"`
+	Package    packagePayload `json:"package"`
+	JobID      string         `json:"job_id,omitempty"`
+	DecisionID string         `json:"decision_id,omitempty"`
+}
+
+type packagePayload struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func newPayload(name string, event *adminv1.PackageEvent) ([]byte, error) {
+	p := payload{
+		Event:     name,
+		CreatedAt: event.CreatedAt.AsTime(),
+		Package: packagePayload{
+			ID:   event.PackageId,
+			Type: strings.ToLower(strings.TrimPrefix(event.PackageType.String(), "PACKAGE_TYPE_")),
+			Name: event.PackageName,
+		},
+		JobID:      event.JobId,
+		DecisionID: event.DecisionId,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(p); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
+
+// Webhooks returns the webhooks set in the configuration followed by the
+// webhooks created via the API.
+func (d *Dispatcher) Webhooks(ctx context.Context) ([]*store.Webhook, error) {
+	webhooks, err := d.webhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets are only needed to sign the payloads, they are never listed.
+	ret := make([]*store.Webhook, 0, len(webhooks))
+	for _, wh := range webhooks {
+		item := *wh
+		item.Secret = ""
+		ret = append(ret, &item)
+	}
+
+	return ret, nil
+}
+
+// webhooks returns the webhooks including their secrets.
+func (d *Dispatcher) webhooks(ctx context.Context) ([]*store.Webhook, error) {
+	stored, err := d.store.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(slices.Clone(d.static), stored...), nil
+}
+
+// ReadOnly reports whether the webhook was set in the configuration.
+func (d *Dispatcher) ReadOnly(id uuid.UUID) bool {
+	return slices.ContainsFunc(d.static, func(wh *store.Webhook) bool {
+		return wh.ID == id
+	})
+}
+
+// CreateWebhook creates a webhook subscribed to the given events, or to all of
+// them when none is given.
+func (d *Dispatcher) CreateWebhook(ctx context.Context, url, secret string, events []string) (*store.Webhook, error) {
+	if err := (Subscription{URL: url, Secret: secret, Events: events}).validate(); err != nil {
+		return nil, err
+	}
+
+	wh := &store.Webhook{
+		ID:        uuid.New(),
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := d.store.CreateWebhook(ctx, wh); err != nil {
+		return nil, err
+	}
+
+	return wh, nil
+}
+
+// DeleteWebhook deletes a webhook created via the API.
+func (d *Dispatcher) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	if d.ReadOnly(id) {
+		return ErrReadOnly
+	}
+
+	return d.store.DeleteWebhook(ctx, id)
+}
+
+// Close stops the dispatcher. Deliveries in progress are abandoned and the
+// queued events are dropped, they are recorded as failed deliveries.
+func (d *Dispatcher) Close() error {
+	d.cancel()
+	d.wg.Wait()
+
+	for {
+		select {
+		case event := <-d.queue:
+			d.drop(event, "event dropped, the dispatcher was closed")
+			continue
+		default:
+		}
+		break
+	}
+	d.recordDropped()
+
+	return nil
+}
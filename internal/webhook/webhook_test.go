@@ -0,0 +1,299 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	adminv1 "github.com/artefactual-labs/ccp/internal/api/gen/archivematica/ccp/admin/v1beta1"
+	"github.com/artefactual-labs/ccp/internal/store"
+	"github.com/artefactual-labs/ccp/internal/store/storemock"
+)
+
+func TestParseSubscription(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		want    Subscription
+		wantErr string
+	}{
+		"Parses a subscription": {
+			value: "url=https://example.com/hook secret=s3cr3t events=package.done,package.failed",
+			want: Subscription{
+				URL:    "https://example.com/hook",
+				Secret: "s3cr3t",
+				Events: []string{EventPackageDone, EventPackageFailed},
+			},
+		},
+		"Parses a subscription to all events": {
+			value: "secret=s3cr3t  url=http://localhost:8080/",
+			want: Subscription{
+				URL:    "http://localhost:8080/",
+				Secret: "s3cr3t",
+			},
+		},
+		"Rejects unknown keys": {
+			value:   "url=https://example.com/hook secret=s3cr3t method=PUT",
+			wantErr: `invalid field "method=PUT": unknown key`,
+		},
+		"Rejects invalid URLs": {
+			value:   "url=example.com secret=s3cr3t",
+			wantErr: `invalid url "example.com"`,
+		},
+		"Rejects missing secrets": {
+			value:   "url=https://example.com/hook",
+			wantErr: "missing secret",
+		},
+		"Rejects unknown events": {
+			value:   "url=https://example.com/hook secret=s3cr3t events=package.queued",
+			wantErr: `unknown event "package.queued"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSubscription(tc.value)
+			if tc.wantErr != "" {
+				assert.Error(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		Sign("s3cr3t", []byte(`{"event":"package.done"}`)),
+		"sha256=55a8196ed6418bd38793ed12e3e7829b3b2d93400cb0c088fec755c5514d9f5a",
+	)
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+
+	pkgID := uuid.MustParse("e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4")
+	event := &adminv1.PackageEvent{
+		Type:        adminv1.PackageEventType_PACKAGE_EVENT_TYPE_PACKAGE_DONE,
+		PackageId:   pkgID.String(),
+		PackageType: adminv1.PackageType_PACKAGE_TYPE_TRANSFER,
+		PackageName: "Images",
+		CreatedAt:   timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+	}
+
+	// newDispatcher returns a dispatcher subscribed to the given URL that
+	// reports the deliveries recorded in the store.
+	newDispatcher := func(t *testing.T, url string, opts ...func(*Config)) (*Dispatcher, chan *store.WebhookDelivery) {
+		t.Helper()
+
+		deliveries := make(chan *store.WebhookDelivery, 10)
+		s := storemock.NewMockStore(gomock.NewController(t))
+		s.EXPECT().ListWebhooks(gomock.Any()).Return(nil, nil).AnyTimes()
+		s.EXPECT().CreateWebhookDelivery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, delivery *store.WebhookDelivery) error {
+				deliveries <- delivery
+				return nil
+			},
+		).AnyTimes()
+
+		config := Config{
+			Subscriptions: []Subscription{{URL: url, Secret: "s3cr3t"}},
+			MaxAttempts:   3,
+			Backoff:       time.Millisecond,
+			MaxBackoff:    time.Millisecond,
+			Timeout:       time.Second,
+			Workers:       1,
+			QueueSize:     1,
+		}
+		for _, opt := range opts {
+			opt(&config)
+		}
+		d, err := New(logr.Discard(), config, s)
+		assert.NilError(t, err)
+		t.Cleanup(func() { d.Close() })
+
+		return d, deliveries
+	}
+
+	t.Run("Delivers signed payloads", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			body      []byte
+			signature string
+			kind      string
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			signature = r.Header.Get(HeaderSignature)
+			kind = r.Header.Get(HeaderEvent)
+		}))
+		defer srv.Close()
+
+		d, deliveries := newDispatcher(t, srv.URL)
+		d.dispatch(event)
+
+		delivery := <-deliveries
+		assert.Equal(t, delivery.Attempts, 1)
+		assert.Equal(t, delivery.StatusCode, http.StatusOK)
+		assert.Equal(t, delivery.DeadLetter, false)
+		assert.Equal(t, delivery.PackageID, pkgID)
+		assert.Equal(t, delivery.WebhookID, uuid.NewSHA1(uuid.NameSpaceURL, []byte(srv.URL)))
+
+		assert.Equal(t, string(body), `{"event":"package.done","created_at":"2024-05-01T10:00:00Z","package":{"id":"e5bd8e4c-48e5-4a3b-9a5e-24e6b0b3f2a4","type":"transfer","name":"Images"}}`)
+		assert.Equal(t, signature, Sign("s3cr3t", body))
+		assert.Equal(t, kind, EventPackageDone)
+	})
+
+	t.Run("Records dead letters after exhausting the attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		d, deliveries := newDispatcher(t, srv.URL)
+		d.dispatch(event)
+
+		delivery := <-deliveries
+		assert.Equal(t, delivery.Attempts, 3)
+		assert.Equal(t, attempts.Load(), int32(3))
+		assert.Equal(t, delivery.StatusCode, http.StatusServiceUnavailable)
+		assert.Equal(t, delivery.Error, "unexpected status code: 503")
+		assert.Equal(t, delivery.DeadLetter, true)
+	})
+
+	t.Run("Does not retry client errors", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer srv.Close()
+
+		d, deliveries := newDispatcher(t, srv.URL)
+		d.dispatch(event)
+
+		delivery := <-deliveries
+		assert.Equal(t, delivery.Attempts, 1)
+		assert.Equal(t, delivery.DeadLetter, true)
+	})
+
+	// blockingServer returns a server that reports the requests received and
+	// does not respond until the request is cancelled or the test ends.
+	blockingServer := func(t *testing.T) (*httptest.Server, chan struct{}) {
+		t.Helper()
+
+		received := make(chan struct{}, 10)
+		release := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received <- struct{}{}
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+		}))
+		t.Cleanup(func() {
+			close(release)
+			srv.Close()
+		})
+
+		return srv, received
+	}
+
+	t.Run("Records failed deliveries when the queue is full", func(t *testing.T) {
+		t.Parallel()
+
+		srv, received := blockingServer(t)
+		d, deliveries := newDispatcher(t, srv.URL)
+		d.dispatch(event)
+		<-received
+		d.dispatch(event) // Queued.
+		d.dispatch(event) // Dropped.
+
+		delivery := <-deliveries
+		assert.Equal(t, delivery.Attempts, 0)
+		assert.Equal(t, delivery.Error, "event dropped, the webhook queue is full")
+		assert.Equal(t, delivery.DeadLetter, true)
+		assert.Equal(t, delivery.PackageID, pkgID)
+		assert.Equal(t, delivery.WebhookID, uuid.NewSHA1(uuid.NameSpaceURL, []byte(srv.URL)))
+	})
+
+	t.Run("Records abandoned deliveries on close", func(t *testing.T) {
+		t.Parallel()
+
+		srv, received := blockingServer(t)
+		d, deliveries := newDispatcher(t, srv.URL, func(c *Config) { c.QueueSize = 2 })
+		d.dispatch(event)
+		<-received
+		d.dispatch(event)
+
+		assert.NilError(t, d.Close())
+		assert.Equal(t, len(deliveries), 2)
+
+		abandoned, dropped := <-deliveries, <-deliveries
+		if abandoned.Attempts == 0 {
+			abandoned, dropped = dropped, abandoned
+		}
+		assert.Equal(t, abandoned.Attempts, 1)
+		assert.Assert(t, strings.HasPrefix(abandoned.Error, "delivery abandoned, the dispatcher was closed: "), abandoned.Error)
+		assert.Equal(t, abandoned.DeadLetter, true)
+		assert.Equal(t, dropped.Attempts, 0)
+		assert.Equal(t, dropped.Error, "event dropped, the dispatcher was closed")
+		assert.Equal(t, dropped.DeadLetter, true)
+	})
+
+	t.Run("Ignores events not subscribed", func(t *testing.T) {
+		t.Parallel()
+
+		d, deliveries := newDispatcher(t, "https://example.com/hook")
+		d.dispatch(&adminv1.PackageEvent{
+			Type:      adminv1.PackageEventType_PACKAGE_EVENT_TYPE_JOB_STARTED,
+			PackageId: pkgID.String(),
+		})
+		d.static[0].Events = []string{EventPackageFailed}
+		d.dispatch(event)
+
+		d.Close()
+		assert.Equal(t, len(deliveries), 0)
+	})
+
+	t.Run("Protects webhooks set in the configuration", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := newDispatcher(t, "https://example.com/hook")
+		err := d.DeleteWebhook(context.Background(), d.static[0].ID)
+		assert.ErrorIs(t, err, ErrReadOnly)
+	})
+
+	t.Run("Does not list the secrets", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := newDispatcher(t, "https://example.com/hook")
+		webhooks, err := d.Webhooks(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, len(webhooks), 1)
+		assert.Equal(t, webhooks[0].URL, "https://example.com/hook")
+		assert.Equal(t, webhooks[0].Secret, "")
+		assert.Equal(t, d.static[0].Secret, "s3cr3t")
+	})
+}
@@ -0,0 +1,246 @@
+package workers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Gearman packet types observed by the registry, see
+// https://github.com/gearman/gearmand/blob/master/PROTOCOL.
+const (
+	packetCanDo          = 1
+	packetCantDo         = 2
+	packetResetAbilities = 3
+	packetPreSleep       = 4
+	packetJobAssign      = 11
+	packetWorkComplete   = 13
+	packetWorkFail       = 14
+	packetSetClientID    = 22
+	packetCanDoTimeout   = 23
+	packetWorkException  = 25
+	packetJobAssignUniq  = 31
+	packetJobAssignAll   = 40
+)
+
+const (
+	// headerSize is the size of the header of binary packets: magic code,
+	// packet type and data size.
+	headerSize = 12
+
+	// maxArgsSize is the number of bytes of the data of a packet that are
+	// kept, enough to decode the arguments we care about, e.g. job handles or
+	// function names, without buffering the job payloads.
+	maxArgsSize = 1024
+)
+
+// Listen returns a listener that registers the connections accepted by ln.
+// The job server must be given the listener returned so the registry can
+// observe the requests sent by the workers and the jobs assigned to them.
+func (r *Registry) Listen(ln net.Listener) net.Listener {
+	return &listener{Listener: ln, registry: r}
+}
+
+type listener struct {
+	net.Listener
+	registry *Registry
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	id := l.registry.connect(c.RemoteAddr().String())
+	conn := &conn{Conn: c, registry: l.registry, id: id}
+	conn.requests.handle = conn.handleRequest
+	conn.responses.handle = conn.handleResponse
+
+	return conn, nil
+}
+
+// conn observes the traffic of a connection. Reads carry the requests sent by
+// the peer and writes carry the responses of the job server, each direction
+// is used by a single goroutine in gearmin.
+type conn struct {
+	net.Conn
+	registry  *Registry
+	id        int64
+	requests  decoder
+	responses decoder
+	closeOnce sync.Once
+}
+
+func (c *conn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.registry.update(c.id, func(s *state) { s.lastSeen = c.registry.now() })
+		c.requests.write(b[:n])
+	}
+	if err != nil {
+		c.forget()
+	}
+
+	return n, err
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.responses.write(b[:n])
+	}
+
+	return n, err
+}
+
+func (c *conn) Close() error {
+	c.forget()
+
+	return c.Conn.Close()
+}
+
+func (c *conn) forget() {
+	c.closeOnce.Do(func() { c.registry.disconnect(c.id) })
+}
+
+func (c *conn) handleRequest(kind uint32, args [][]byte) {
+	c.registry.update(c.id, func(s *state) {
+		switch kind {
+		case packetCanDo, packetCanDoTimeout:
+			s.worker = true
+			s.functions[string(args[0])] = struct{}{}
+		case packetCantDo:
+			delete(s.functions, string(args[0]))
+		case packetResetAbilities:
+			clear(s.functions)
+		case packetPreSleep:
+			s.worker = true
+		case packetSetClientID:
+			s.worker = true
+			s.clientID = strings.TrimSpace(string(args[0]))
+		case packetWorkComplete, packetWorkFail, packetWorkException:
+			if _, ok := s.jobs[string(args[0])]; ok {
+				delete(s.jobs, string(args[0]))
+				s.completed++
+			}
+		}
+	})
+}
+
+func (c *conn) handleResponse(kind uint32, args [][]byte) {
+	switch kind {
+	case packetJobAssign, packetJobAssignUniq, packetJobAssignAll:
+		c.registry.update(c.id, func(s *state) {
+			s.jobs[string(args[0])] = struct{}{}
+		})
+	}
+}
+
+// decoder decodes a stream of Gearman packets incrementally. gearmin does not
+// expose the packets it handles, so the decoder mirrors how gearmin v0.3.0
+// reads them to observe the same packets as the job server:
+//
+//   - The first byte of the stream selects the protocol. gearmin does not
+//     serve the text-based administrative protocol: a stream that does not
+//     start with a NUL byte is ignored as a whole.
+//   - Headers and data split across reads are buffered until complete.
+//   - A header with an unknown magic code is skipped after its first four
+//     bytes, gearmin reads it as an empty packet.
+//   - The arguments are split by NUL bytes up to the number of arguments of
+//     the packet type, the last argument holds the rest of the data.
+//   - Only the first maxArgsSize bytes of the data are kept, so the last
+//     argument may be truncated, e.g. a function name longer than
+//     maxArgsSize is truncated the same way in every packet.
+type decoder struct {
+	started   bool
+	ignored   bool
+	header    []byte
+	kind      uint32
+	remaining int
+	data      []byte
+
+	// handle is called with the arguments of every packet of the types
+	// observed, see argCounts.
+	handle func(kind uint32, args [][]byte)
+}
+
+// Magic codes of requests and responses.
+var (
+	magicReq = []byte("\x00REQ")
+	magicRes = []byte("\x00RES")
+)
+
+// argCounts is the number of arguments of the packet types observed.
+var argCounts = map[uint32]int{
+	packetCanDo:          1,
+	packetCantDo:         1,
+	packetResetAbilities: 0,
+	packetPreSleep:       0,
+	packetJobAssign:      3,
+	packetWorkComplete:   2,
+	packetWorkFail:       1,
+	packetSetClientID:    1,
+	packetCanDoTimeout:   2,
+	packetWorkException:  2,
+	packetJobAssignUniq:  4,
+	packetJobAssignAll:   5,
+}
+
+func (d *decoder) write(b []byte) {
+	for len(b) > 0 {
+		switch {
+		case d.ignored:
+			return
+		case !d.started:
+			d.started = true
+			d.ignored = b[0] != 0
+		case d.remaining > 0:
+			n := min(len(b), d.remaining)
+			if room := maxArgsSize - len(d.data); room > 0 {
+				d.data = append(d.data, b[:min(n, room)]...)
+			}
+			b = b[n:]
+			d.remaining -= n
+			if d.remaining == 0 {
+				d.emit()
+			}
+		default:
+			n := min(len(b), headerSize-len(d.header))
+			d.header = append(d.header, b[:n]...)
+			b = b[n:]
+			if len(d.header) >= 4 && !bytes.Equal(d.header[:4], magicReq) && !bytes.Equal(d.header[:4], magicRes) {
+				// Decode the bytes that followed the magic code again.
+				b = append(bytes.Clone(d.header[4:]), b...)
+				d.header = d.header[:0]
+				continue
+			}
+			if len(d.header) == headerSize {
+				d.kind = binary.BigEndian.Uint32(d.header[4:8])
+				d.remaining = int(binary.BigEndian.Uint32(d.header[8:12]))
+				d.header = d.header[:0]
+				if d.remaining == 0 {
+					d.emit()
+				}
+			}
+		}
+	}
+}
+
+func (d *decoder) emit() {
+	defer func() { d.data = d.data[:0] }()
+
+	argc, ok := argCounts[d.kind]
+	if !ok {
+		return
+	}
+	if argc == 0 {
+		d.handle(d.kind, nil)
+		return
+	}
+
+	args := bytes.SplitN(d.data, []byte{0}, argc)
+	d.handle(d.kind, args)
+}
@@ -0,0 +1,145 @@
+package workers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+)
+
+// packet encodes a binary Gearman request.
+func packet(kind uint32, args ...string) []byte {
+	data := strings.Join(args, "\x00")
+	b := bytes.Clone(magicReq)
+	b = binary.BigEndian.AppendUint32(b, kind)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+
+	return append(b, data...)
+}
+
+type decoded struct {
+	kind uint32
+	args []string
+}
+
+var cmpDecoded = cmp.AllowUnexported(decoded{})
+
+func newDecoder() (*decoder, *[]decoded) {
+	var got []decoded
+	d := &decoder{handle: func(kind uint32, args [][]byte) {
+		item := decoded{kind: kind}
+		for _, arg := range args {
+			item.args = append(item.args, string(arg))
+		}
+		got = append(got, item)
+	}}
+
+	return d, &got
+}
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Decodes packets split across writes", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		stream := append(packet(packetCanDo, "hello"), packet(packetWorkComplete, "H:1", "result")...)
+		for i := range stream {
+			d.write(stream[i : i+1])
+		}
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{"hello"}},
+			{kind: packetWorkComplete, args: []string{"H:1", "result"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Decodes packets without data", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write(append(packet(packetPreSleep), packet(packetSetClientID, "worker-1")...))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetPreSleep},
+			{kind: packetSetClientID, args: []string{"worker-1"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Keeps the separators in the last argument", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write(packet(packetCanDo, "hello", "world"))
+		d.write(packet(packetWorkException, "H:1", "a", "b"))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{"hello\x00world"}},
+			{kind: packetWorkException, args: []string{"H:1", "a\x00b"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Truncates the arguments", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		name := strings.Repeat("a", maxArgsSize+10)
+		d.write(packet(packetCanDo, name))
+		d.write(packet(packetCantDo, "hello"))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{name[:maxArgsSize]}},
+			{kind: packetCantDo, args: []string{"hello"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Skips packet types not observed", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write(packet(16, "ping")) // ECHO_REQ
+		d.write(packet(packetCanDo, "hello"))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{"hello"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Skips the magic codes that are not known", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write([]byte("\x00BAD"))
+		d.write(packet(packetCanDo, "hello"))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{"hello"}},
+		}, cmpDecoded)
+	})
+
+	t.Run("Ignores text streams", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write([]byte("workers\n"))
+		d.write(packet(packetCanDo, "hello"))
+
+		assert.Equal(t, len(*got), 0)
+	})
+
+	t.Run("Selects the protocol with the first byte only", func(t *testing.T) {
+		t.Parallel()
+
+		d, got := newDecoder()
+		d.write(packet(packetCanDo, "hello"))
+		d.write([]byte("status\n"))
+
+		assert.DeepEqual(t, *got, []decoded{
+			{kind: packetCanDo, args: []string{"hello"}},
+		}, cmpDecoded)
+	})
+}
@@ -0,0 +1,198 @@
+// Package workers keeps track of the MCPClient workers connected to the
+// embedded Gearman job server.
+//
+// gearmin does not expose its worker sessions, so the registry observes the
+// traffic of every connection accepted by the job server instead, see
+// [Registry.Listen].
+package workers
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Worker describes a worker connected to the job server.
+type Worker struct {
+	// ID identifies the connection of the worker.
+	ID int64
+
+	// ClientID is the identifier set by the worker, it may be empty.
+	ClientID string
+
+	// Addr is the remote address of the worker.
+	Addr string
+
+	// Functions registered by the worker, sorted by name.
+	Functions []string
+
+	// InFlightJobs is the number of jobs assigned to the worker that have
+	// not been completed yet.
+	InFlightJobs int
+
+	// CompletedJobs is the number of jobs completed by the worker, including
+	// those that failed.
+	CompletedJobs int
+
+	ConnectedAt time.Time
+	LastSeenAt  time.Time
+}
+
+// Registry keeps track of the workers connected to the job server. It also
+// implements prometheus.Collector to report the state of the workers.
+type Registry struct {
+	mu      sync.Mutex
+	seq     int64
+	workers map[int64]*state
+	now     func() time.Time
+
+	workersDesc   *prometheus.Desc
+	functionsDesc *prometheus.Desc
+	inFlightDesc  *prometheus.Desc
+	lastSeenDesc  *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*Registry)(nil)
+
+// state is the mutable state of a connection. Connections only become workers
+// once they send a worker request, e.g. CAN_DO.
+type state struct {
+	worker    bool
+	clientID  string
+	addr      string
+	functions map[string]struct{}
+	jobs      map[string]struct{} // Handles of the jobs in flight.
+	completed int
+	connected time.Time
+	lastSeen  time.Time
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		workers: map[int64]*state{},
+		now:     time.Now,
+		workersDesc: prometheus.NewDesc(
+			"mcpserver_gearman_workers",
+			"Number of workers connected to the job server",
+			nil, nil,
+		),
+		functionsDesc: prometheus.NewDesc(
+			"mcpserver_gearman_worker_function_info",
+			"Functions registered by the connected workers, labeled by worker, client identifier and function name",
+			[]string{"worker", "client_id", "function"}, nil,
+		),
+		inFlightDesc: prometheus.NewDesc(
+			"mcpserver_gearman_worker_in_flight_jobs",
+			"Number of jobs in flight in the connected workers, labeled by worker and client identifier",
+			[]string{"worker", "client_id"}, nil,
+		),
+		lastSeenDesc: prometheus.NewDesc(
+			"mcpserver_gearman_worker_last_seen_timestamp_seconds",
+			"Most recent request received from the connected workers, labeled by worker and client identifier",
+			[]string{"worker", "client_id"}, nil,
+		),
+	}
+}
+
+// Workers returns the workers connected, sorted by their identifiers.
+func (r *Registry) Workers() []Worker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ret := make([]Worker, 0, len(r.workers))
+	for _, id := range slices.Sorted(maps.Keys(r.workers)) {
+		s := r.workers[id]
+		if !s.worker {
+			continue
+		}
+		ret = append(ret, Worker{
+			ID:            id,
+			ClientID:      s.clientID,
+			Addr:          s.addr,
+			Functions:     slices.Sorted(maps.Keys(s.functions)),
+			InFlightJobs:  len(s.jobs),
+			CompletedJobs: s.completed,
+			ConnectedAt:   s.connected,
+			LastSeenAt:    s.lastSeen,
+		})
+	}
+
+	return ret
+}
+
+// CanDo reports whether any of the workers connected has registered the given
+// function.
+func (r *Registry) CanDo(funcName string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.workers {
+		if _, ok := s.functions[funcName]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Registry) Describe(ch chan<- *prometheus.Desc) {
+	ch <- r.workersDesc
+	ch <- r.functionsDesc
+	ch <- r.inFlightDesc
+	ch <- r.lastSeenDesc
+}
+
+func (r *Registry) Collect(ch chan<- prometheus.Metric) {
+	workers := r.Workers()
+
+	ch <- prometheus.MustNewConstMetric(r.workersDesc, prometheus.GaugeValue, float64(len(workers)))
+
+	for _, w := range workers {
+		id := strconv.FormatInt(w.ID, 10)
+		for _, fn := range w.Functions {
+			ch <- prometheus.MustNewConstMetric(r.functionsDesc, prometheus.GaugeValue, 1, id, w.ClientID, fn)
+		}
+		ch <- prometheus.MustNewConstMetric(r.inFlightDesc, prometheus.GaugeValue, float64(w.InFlightJobs), id, w.ClientID)
+		ch <- prometheus.MustNewConstMetric(r.lastSeenDesc, prometheus.GaugeValue, float64(w.LastSeenAt.Unix()), id, w.ClientID)
+	}
+}
+
+// connect registers a new connection and returns its identifier.
+func (r *Registry) connect(addr string) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	now := r.now()
+	r.workers[r.seq] = &state{
+		addr:      addr,
+		functions: map[string]struct{}{},
+		jobs:      map[string]struct{}{},
+		connected: now,
+		lastSeen:  now,
+	}
+
+	return r.seq
+}
+
+// disconnect forgets a connection.
+func (r *Registry) disconnect(id int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.workers, id)
+}
+
+// update modifies the state of a connection if it is still registered.
+func (r *Registry) update(id int64, fn func(s *state)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.workers[id]; ok {
+		fn(s)
+	}
+}
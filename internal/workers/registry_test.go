@@ -0,0 +1,106 @@
+package workers_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/artefactual-labs/gearmin"
+	"github.com/mikespook/gearman-go/worker"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+
+	"github.com/artefactual-labs/ccp/internal/workers"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	reg := workers.NewRegistry()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	srv := gearmin.NewServer(reg.Listen(ln))
+	t.Cleanup(srv.Stop)
+
+	assert.Equal(t, reg.CanDo("hello"), false)
+	assert.Equal(t, len(reg.Workers()), 0)
+
+	release := make(chan struct{})
+	w := worker.New(worker.OneByOne)
+	assert.NilError(t, w.AddServer("tcp", ln.Addr().String()))
+	assert.NilError(t, w.AddFunc("hello", func(job worker.Job) ([]byte, error) {
+		<-release
+		return []byte("hi!"), nil
+	}, 0))
+	assert.NilError(t, w.Ready())
+	w.SetId("worker-1")
+	go w.Work()
+
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if workers := reg.Workers(); len(workers) == 1 && workers[0].ClientID == "worker-1" {
+			return poll.Success()
+		}
+		return poll.Continue("worker not registered")
+	}, poll.WithTimeout(5*time.Second), poll.WithDelay(10*time.Millisecond))
+
+	assert.Equal(t, reg.CanDo("hello"), true)
+	assert.Equal(t, reg.CanDo("bye"), false)
+
+	got := reg.Workers()[0]
+	assert.DeepEqual(t, got.Functions, []string{"hello"})
+	assert.Assert(t, got.Addr != "")
+	assert.Assert(t, !got.LastSeenAt.IsZero())
+
+	done := make(chan struct{})
+	srv.Submit(&gearmin.JobRequest{
+		ID:       "job-1",
+		FuncName: "hello",
+		Callback: func(update gearmin.JobUpdate) {
+			if update.Succeeded() {
+				close(done)
+			}
+		},
+	})
+
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if reg.Workers()[0].InFlightJobs == 1 {
+			return poll.Success()
+		}
+		return poll.Continue("job not assigned")
+	}, poll.WithTimeout(5*time.Second), poll.WithDelay(10*time.Millisecond))
+
+	err = testutil.CollectAndCompare(reg, strings.NewReader(`
+# HELP mcpserver_gearman_worker_in_flight_jobs Number of jobs in flight in the connected workers, labeled by worker and client identifier
+# TYPE mcpserver_gearman_worker_in_flight_jobs gauge
+mcpserver_gearman_worker_in_flight_jobs{client_id="worker-1",worker="1"} 1
+# HELP mcpserver_gearman_worker_function_info Functions registered by the connected workers, labeled by worker, client identifier and function name
+# TYPE mcpserver_gearman_worker_function_info gauge
+mcpserver_gearman_worker_function_info{client_id="worker-1",function="hello",worker="1"} 1
+# HELP mcpserver_gearman_workers Number of workers connected to the job server
+# TYPE mcpserver_gearman_workers gauge
+mcpserver_gearman_workers 1
+`), "mcpserver_gearman_workers", "mcpserver_gearman_worker_function_info", "mcpserver_gearman_worker_in_flight_jobs")
+	assert.NilError(t, err)
+
+	close(release)
+	<-done
+
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if w := reg.Workers()[0]; w.InFlightJobs == 0 && w.CompletedJobs == 1 {
+			return poll.Success()
+		}
+		return poll.Continue("job not completed")
+	}, poll.WithTimeout(5*time.Second), poll.WithDelay(10*time.Millisecond))
+
+	w.Close()
+
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if len(reg.Workers()) == 0 {
+			return poll.Success()
+		}
+		return poll.Continue("worker still registered")
+	}, poll.WithTimeout(5*time.Second), poll.WithDelay(10*time.Millisecond))
+	assert.Equal(t, reg.CanDo("hello"), false)
+}
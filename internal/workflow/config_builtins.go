@@ -13,6 +13,13 @@ var builtinConfigs = map[string]ProcessingConfig{
 	"automated": AutomatedConfig,
 }
 
+// BuiltinConfig returns the built-in processing configuration with the given
+// name, i.e. "default" or "automated".
+func BuiltinConfig(name string) (ProcessingConfig, bool) {
+	config, ok := builtinConfigs[name]
+	return config, ok
+}
+
 func InstallBuiltinConfigs(path string) error {
 	var errs error
 	for name, config := range builtinConfigs {
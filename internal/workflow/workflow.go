@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/tailscale/hujson"
@@ -203,6 +205,65 @@ func (d *Document) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// ChainsForLink returns the chains that reach the given link by following the
+// exit codes, the fallback links and the unit variable links of their links,
+// sorted by identifier.
+func (d *Document) ChainsForLink(id uuid.UUID) []*Chain {
+	ret := []*Chain{}
+	for _, wc := range d.Chains {
+		if d.reaches(wc.LinkID, id) {
+			ret = append(ret, wc)
+		}
+	}
+
+	slices.SortFunc(ret, func(a, b *Chain) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	return ret
+}
+
+// reaches reports whether the link target can be reached from the link start
+// without leaving the chain.
+func (d *Document) reaches(start, target uuid.UUID) bool {
+	seen := map[uuid.UUID]struct{}{}
+	pending := []uuid.UUID{start}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if id == target {
+			return true
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		wl, ok := d.Links[id]
+		if !ok {
+			continue
+		}
+		for _, ec := range wl.ExitCodes {
+			if ec.LinkID != nil {
+				pending = append(pending, *ec.LinkID)
+			}
+		}
+		if wl.FallbackLinkID != uuid.Nil {
+			pending = append(pending, wl.FallbackLinkID)
+		}
+		switch config := wl.Config.(type) {
+		case LinkTaskConfigUnitVariableLinkPull:
+			pending = append(pending, config.LinkID)
+		case LinkTaskConfigSetUnitVariable:
+			// The link is pulled later on by the same package.
+			pending = append(pending, config.LinkID)
+		}
+	}
+
+	return false
+}
+
 type LinkMicroServiceChainChoice struct {
 	Model   string      `json:"@model"`
 	Manager string      `json:"@manager"`
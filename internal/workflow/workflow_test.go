@@ -17,3 +17,19 @@ func TestDecodeWorkflow(t *testing.T) {
 	config := link.Config.(workflow.LinkStandardTaskConfig)
 	assert.Equal(t, config.Execute, "moveSIP_v0.0")
 }
+
+func TestChainsForLink(t *testing.T) {
+	amflow, err := workflow.Default()
+	assert.NilError(t, err)
+
+	chains := amflow.ChainsForLink(uuid.MustParse("002716a1-ae29-4f36-98ab-0d97192669c4"))
+	assert.Equal(t, len(chains), 2)
+	assert.Equal(t, chains[0].ID, uuid.MustParse("29881c21-3548-454a-9637-ebc5fd46aee0"))
+	assert.Equal(t, chains[1].ID, uuid.MustParse("65273f18-5b4e-4944-af4f-09be175a88e8"))
+
+	for id := range amflow.Links {
+		assert.Assert(t, len(amflow.ChainsForLink(id)) > 0, "link %s is not reachable", id)
+	}
+
+	assert.Equal(t, len(amflow.ChainsForLink(uuid.New())), 0)
+}
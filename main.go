@@ -10,6 +10,7 @@ import (
 
 	"github.com/artefactual-labs/ccp/internal/cmd/rootcmd"
 	"github.com/artefactual-labs/ccp/internal/cmd/servercmd"
+	"github.com/artefactual-labs/ccp/internal/cmd/workflowcmd"
 	"github.com/artefactual-labs/ccp/internal/version"
 )
 
@@ -26,6 +27,7 @@ func Run(ctx context.Context, out io.Writer, args []string) error {
 
 	rootCommand.Subcommands = []*ffcli.Command{
 		servercmd.New(rootConfig, out),
+		workflowcmd.New(rootConfig, out),
 		version.New(out),
 	}
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { I18n } from "./i18n_pb.js";
 
 /**
@@ -71,6 +71,47 @@ proto3.util.setEnumType(TransferType, "archivematica.ccp.admin.v1beta1.TransferT
   { no: 8, name: "TRANSFER_TYPE_DATAVERSE" },
 ]);
 
+/**
+ * Types of AIP reingest.
+ *
+ * @generated from enum archivematica.ccp.admin.v1beta1.ReingestMode
+ */
+export enum ReingestMode {
+  /**
+   * @generated from enum value: REINGEST_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Only the metadata of the AIP is updated, the original objects are not
+   * placed in the SIP.
+   *
+   * @generated from enum value: REINGEST_MODE_METADATA_ONLY = 1;
+   */
+  METADATA_ONLY = 1,
+
+  /**
+   * The metadata and the preservation derivatives of the AIP can be updated.
+   *
+   * @generated from enum value: REINGEST_MODE_PARTIAL = 2;
+   */
+  PARTIAL = 2,
+
+  /**
+   * The AIP is processed again from the transfer stage.
+   *
+   * @generated from enum value: REINGEST_MODE_FULL = 3;
+   */
+  FULL = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReingestMode)
+proto3.util.setEnumType(ReingestMode, "archivematica.ccp.admin.v1beta1.ReingestMode", [
+  { no: 0, name: "REINGEST_MODE_UNSPECIFIED" },
+  { no: 1, name: "REINGEST_MODE_METADATA_ONLY" },
+  { no: 2, name: "REINGEST_MODE_PARTIAL" },
+  { no: 3, name: "REINGEST_MODE_FULL" },
+]);
+
 /**
  * @generated from enum archivematica.ccp.admin.v1beta1.PackageType
  */
@@ -155,6 +196,150 @@ proto3.util.setEnumType(PackageStatus, "archivematica.ccp.admin.v1beta1.PackageS
   { no: 5, name: "PACKAGE_STATUS_AWAITING_DECISION" },
 ]);
 
+/**
+ * @generated from enum archivematica.ccp.admin.v1beta1.IngestStatus
+ */
+export enum IngestStatus {
+  /**
+   * @generated from enum value: INGEST_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a slot in the ingest pool.
+   *
+   * @generated from enum value: INGEST_STATUS_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * @generated from enum value: INGEST_STATUS_COPYING = 2;
+   */
+  COPYING = 2,
+
+  /**
+   * @generated from enum value: INGEST_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * Validating the bag copied, only for bag transfers.
+   *
+   * @generated from enum value: INGEST_STATUS_VALIDATING = 4;
+   */
+  VALIDATING = 4,
+
+  /**
+   * The bag is not valid, see Ingest.validation_problem.
+   *
+   * @generated from enum value: INGEST_STATUS_INVALID = 5;
+   */
+  INVALID = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(IngestStatus)
+proto3.util.setEnumType(IngestStatus, "archivematica.ccp.admin.v1beta1.IngestStatus", [
+  { no: 0, name: "INGEST_STATUS_UNSPECIFIED" },
+  { no: 1, name: "INGEST_STATUS_QUEUED" },
+  { no: 2, name: "INGEST_STATUS_COPYING" },
+  { no: 3, name: "INGEST_STATUS_FAILED" },
+  { no: 4, name: "INGEST_STATUS_VALIDATING" },
+  { no: 5, name: "INGEST_STATUS_INVALID" },
+]);
+
+/**
+ * @generated from enum archivematica.ccp.admin.v1beta1.PackageEventType
+ */
+export enum PackageEventType {
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_PACKAGE_QUEUED = 1;
+   */
+  PACKAGE_QUEUED = 1,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_PACKAGE_ACTIVATED = 2;
+   */
+  PACKAGE_ACTIVATED = 2,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_JOB_STARTED = 3;
+   */
+  JOB_STARTED = 3,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_JOB_COMPLETED = 4;
+   */
+  JOB_COMPLETED = 4,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_DECISION_AWAITING = 5;
+   */
+  DECISION_AWAITING = 5,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_DECISION_RESOLVED = 6;
+   */
+  DECISION_RESOLVED = 6,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_PACKAGE_DONE = 7;
+   */
+  PACKAGE_DONE = 7,
+
+  /**
+   * @generated from enum value: PACKAGE_EVENT_TYPE_PACKAGE_FAILED = 8;
+   */
+  PACKAGE_FAILED = 8,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PackageEventType)
+proto3.util.setEnumType(PackageEventType, "archivematica.ccp.admin.v1beta1.PackageEventType", [
+  { no: 0, name: "PACKAGE_EVENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "PACKAGE_EVENT_TYPE_PACKAGE_QUEUED" },
+  { no: 2, name: "PACKAGE_EVENT_TYPE_PACKAGE_ACTIVATED" },
+  { no: 3, name: "PACKAGE_EVENT_TYPE_JOB_STARTED" },
+  { no: 4, name: "PACKAGE_EVENT_TYPE_JOB_COMPLETED" },
+  { no: 5, name: "PACKAGE_EVENT_TYPE_DECISION_AWAITING" },
+  { no: 6, name: "PACKAGE_EVENT_TYPE_DECISION_RESOLVED" },
+  { no: 7, name: "PACKAGE_EVENT_TYPE_PACKAGE_DONE" },
+  { no: 8, name: "PACKAGE_EVENT_TYPE_PACKAGE_FAILED" },
+]);
+
+/**
+ * @generated from enum archivematica.ccp.admin.v1beta1.WebhookEvent
+ */
+export enum WebhookEvent {
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_PACKAGE_DONE = 1;
+   */
+  PACKAGE_DONE = 1,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_PACKAGE_FAILED = 2;
+   */
+  PACKAGE_FAILED = 2,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_DECISION_AWAITING = 3;
+   */
+  DECISION_AWAITING = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WebhookEvent)
+proto3.util.setEnumType(WebhookEvent, "archivematica.ccp.admin.v1beta1.WebhookEvent", [
+  { no: 0, name: "WEBHOOK_EVENT_UNSPECIFIED" },
+  { no: 1, name: "WEBHOOK_EVENT_PACKAGE_DONE" },
+  { no: 2, name: "WEBHOOK_EVENT_PACKAGE_FAILED" },
+  { no: 3, name: "WEBHOOK_EVENT_DECISION_AWAITING" },
+]);
+
 /**
  * @generated from enum archivematica.ccp.admin.v1beta1.JobStatus
  */
@@ -260,6 +445,14 @@ export class Package extends Message<Package> {
    */
   job: Job[] = [];
 
+  /**
+   * Copy of the transfer sources into the processing directory, only
+   * populated while the copy is in progress or when it failed.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.Ingest ingest = 10;
+   */
+  ingest?: Ingest;
+
   constructor(data?: PartialMessage<Package>) {
     super();
     proto3.util.initPartial(data, this);
@@ -277,6 +470,7 @@ export class Package extends Message<Package> {
     { no: 7, name: "access_system_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "job", kind: "message", T: Job, repeated: true },
+    { no: 10, name: "ingest", kind: "message", T: Ingest },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Package {
@@ -296,6 +490,144 @@ export class Package extends Message<Package> {
   }
 }
 
+/**
+ * Ingest describes the copy of the sources of a transfer into the processing
+ * directory before the transfer is queued for processing.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.Ingest
+ */
+export class Ingest extends Message<Ingest> {
+  /**
+   * @generated from field: archivematica.ccp.admin.v1beta1.IngestStatus status = 1;
+   */
+  status = IngestStatus.UNSPECIFIED;
+
+  /**
+   * Number of bytes copied so far.
+   *
+   * @generated from field: int64 bytes_copied = 2;
+   */
+  bytesCopied = protoInt64.zero;
+
+  /**
+   * Total number of bytes of the sources.
+   *
+   * @generated from field: int64 bytes_total = 3;
+   */
+  bytesTotal = protoInt64.zero;
+
+  /**
+   * Number of files copied so far.
+   *
+   * @generated from field: int64 files_copied = 4;
+   */
+  filesCopied = protoInt64.zero;
+
+  /**
+   * Total number of files of the sources.
+   *
+   * @generated from field: int64 files_total = 5;
+   */
+  filesTotal = protoInt64.zero;
+
+  /**
+   * Reason of the failure when the status is INGEST_STATUS_FAILED or
+   * INGEST_STATUS_INVALID.
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  /**
+   * Problems found validating the bag when the status is
+   * INGEST_STATUS_INVALID.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.ValidationProblem validation_problem = 7;
+   */
+  validationProblem: ValidationProblem[] = [];
+
+  constructor(data?: PartialMessage<Ingest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.Ingest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(IngestStatus) },
+    { no: 2, name: "bytes_copied", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "bytes_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "files_copied", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "files_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "validation_problem", kind: "message", T: ValidationProblem, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Ingest {
+    return new Ingest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Ingest {
+    return new Ingest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Ingest {
+    return new Ingest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Ingest | PlainMessage<Ingest> | undefined, b: Ingest | PlainMessage<Ingest> | undefined): boolean {
+    return proto3.util.equals(Ingest, a, b);
+  }
+}
+
+/**
+ * ValidationProblem describes a problem found validating a package.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.ValidationProblem
+ */
+export class ValidationProblem extends Message<ValidationProblem> {
+  /**
+   * Path of the file relative to the package, empty when the problem concerns
+   * the whole package.
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ValidationProblem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ValidationProblem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidationProblem {
+    return new ValidationProblem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidationProblem {
+    return new ValidationProblem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidationProblem {
+    return new ValidationProblem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidationProblem | PlainMessage<ValidationProblem> | undefined, b: ValidationProblem | PlainMessage<ValidationProblem> | undefined): boolean {
+    return proto3.util.equals(ValidationProblem, a, b);
+  }
+}
+
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.Job
  */
@@ -532,6 +864,765 @@ export class Choice extends Message<Choice> {
   }
 }
 
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.QueuedPackage
+ */
+export class QueuedPackage extends Message<QueuedPackage> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Name of the package.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Type of the package.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageType type = 3;
+   */
+  type = PackageType.UNSPECIFIED;
+
+  /**
+   * Priority of the package in the processing queue.
+   *
+   * @generated from field: int32 priority = 4;
+   */
+  priority = 0;
+
+  /**
+   * Position of the package in the queue, starting at zero.
+   *
+   * @generated from field: int32 position = 5;
+   */
+  position = 0;
+
+  /**
+   * Timestamp when the package was queued.
+   *
+   * @generated from field: google.protobuf.Timestamp queued_at = 6;
+   */
+  queuedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<QueuedPackage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.QueuedPackage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(PackageType) },
+    { no: 4, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "queued_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueuedPackage {
+    return new QueuedPackage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueuedPackage | PlainMessage<QueuedPackage> | undefined, b: QueuedPackage | PlainMessage<QueuedPackage> | undefined): boolean {
+    return proto3.util.equals(QueuedPackage, a, b);
+  }
+}
+
+/**
+ * PackageEvent describes a change in the processing of a package. Fields that
+ * do not apply to the type of event are left empty.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.PackageEvent
+ */
+export class PackageEvent extends Message<PackageEvent> {
+  /**
+   * Type of the event.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageEventType type = 1;
+   */
+  type = PackageEventType.UNSPECIFIED;
+
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string package_id = 2;
+   */
+  packageId = "";
+
+  /**
+   * Type of the package.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageType package_type = 3;
+   */
+  packageType = PackageType.UNSPECIFIED;
+
+  /**
+   * Name of the package.
+   *
+   * @generated from field: string package_name = 4;
+   */
+  packageName = "";
+
+  /**
+   * Identifier of the job, populated by job and decision events.
+   *
+   * @generated from field: string job_id = 5;
+   */
+  jobId = "";
+
+  /**
+   * Identifier of the workflow link, populated by job events.
+   *
+   * @generated from field: string link_id = 6;
+   */
+  linkId = "";
+
+  /**
+   * Status of the job, populated when the job is completed.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.JobStatus job_status = 7;
+   */
+  jobStatus = JobStatus.UNSPECIFIED;
+
+  /**
+   * Identifier of the decision, populated by decision events.
+   *
+   * @generated from field: string decision_id = 8;
+   */
+  decisionId = "";
+
+  /**
+   * Timestamp of the event.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PackageEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.PackageEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(PackageEventType) },
+    { no: 2, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "package_type", kind: "enum", T: proto3.getEnumType(PackageType) },
+    { no: 4, name: "package_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "link_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "job_status", kind: "enum", T: proto3.getEnumType(JobStatus) },
+    { no: 8, name: "decision_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PackageEvent {
+    return new PackageEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PackageEvent {
+    return new PackageEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PackageEvent {
+    return new PackageEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PackageEvent | PlainMessage<PackageEvent> | undefined, b: PackageEvent | PlainMessage<PackageEvent> | undefined): boolean {
+    return proto3.util.equals(PackageEvent, a, b);
+  }
+}
+
+/**
+ * Webhook is a subscription to package events delivered via HTTP POST
+ * requests signed with a shared secret.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.Webhook
+ */
+export class Webhook extends Message<Webhook> {
+  /**
+   * Identifier of the webhook (UUID).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * URL where the events are delivered.
+   *
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * Events delivered, all events are delivered when empty.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.WebhookEvent events = 3;
+   */
+  events: WebhookEvent[] = [];
+
+  /**
+   * Webhooks set in the server configuration cannot be modified.
+   *
+   * @generated from field: bool read_only = 4;
+   */
+  readOnly = false;
+
+  /**
+   * Creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Webhook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.Webhook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "events", kind: "enum", T: proto3.getEnumType(WebhookEvent), repeated: true },
+    { no: 4, name: "read_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Webhook {
+    return new Webhook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Webhook | PlainMessage<Webhook> | undefined, b: Webhook | PlainMessage<Webhook> | undefined): boolean {
+    return proto3.util.equals(Webhook, a, b);
+  }
+}
+
+/**
+ * WebhookDelivery describes the outcome of the delivery of an event.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.WebhookDelivery
+ */
+export class WebhookDelivery extends Message<WebhookDelivery> {
+  /**
+   * Identifier of the delivery (UUID).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Identifier of the webhook.
+   *
+   * @generated from field: string webhook_id = 2;
+   */
+  webhookId = "";
+
+  /**
+   * Event delivered.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.WebhookEvent event = 3;
+   */
+  event = WebhookEvent.UNSPECIFIED;
+
+  /**
+   * Identifier of the package.
+   *
+   * @generated from field: string package_id = 4;
+   */
+  packageId = "";
+
+  /**
+   * Payload of the request.
+   *
+   * @generated from field: string payload = 5;
+   */
+  payload = "";
+
+  /**
+   * Number of attempts made.
+   *
+   * @generated from field: int32 attempts = 6;
+   */
+  attempts = 0;
+
+  /**
+   * HTTP status code of the last response, zero when no response was received.
+   *
+   * @generated from field: int32 status_code = 7;
+   */
+  statusCode = 0;
+
+  /**
+   * Error of the last attempt.
+   *
+   * @generated from field: string error = 8;
+   */
+  error = "";
+
+  /**
+   * Whether the delivery failed after exhausting all the attempts.
+   *
+   * @generated from field: bool dead_letter = 9;
+   */
+  deadLetter = false;
+
+  /**
+   * Timestamp of the event.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Timestamp of the last attempt.
+   *
+   * @generated from field: google.protobuf.Timestamp completed_at = 11;
+   */
+  completedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<WebhookDelivery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.WebhookDelivery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event", kind: "enum", T: proto3.getEnumType(WebhookEvent) },
+    { no: 4, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "dead_letter", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+    { no: 11, name: "completed_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined, b: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined): boolean {
+    return proto3.util.equals(WebhookDelivery, a, b);
+  }
+}
+
+/**
+ * Worker is a MCPClient worker connected to the job server.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.Worker
+ */
+export class Worker extends Message<Worker> {
+  /**
+   * Identifier of the connection of the worker.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * Identifier set by the worker, it may be empty.
+   *
+   * @generated from field: string client_id = 2;
+   */
+  clientId = "";
+
+  /**
+   * Remote address of the worker.
+   *
+   * @generated from field: string address = 3;
+   */
+  address = "";
+
+  /**
+   * Functions registered by the worker, i.e. the scripts it can run.
+   *
+   * @generated from field: repeated string functions = 4;
+   */
+  functions: string[] = [];
+
+  /**
+   * Number of jobs assigned to the worker that are not completed yet.
+   *
+   * @generated from field: int32 in_flight_jobs = 5;
+   */
+  inFlightJobs = 0;
+
+  /**
+   * Number of jobs completed by the worker, including those that failed.
+   *
+   * @generated from field: int32 completed_jobs = 6;
+   */
+  completedJobs = 0;
+
+  /**
+   * Timestamp of the connection.
+   *
+   * @generated from field: google.protobuf.Timestamp connected_at = 7;
+   */
+  connectedAt?: Timestamp;
+
+  /**
+   * Timestamp of the most recent request received from the worker.
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 8;
+   */
+  lastSeenAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Worker>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.Worker";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "functions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "in_flight_jobs", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "completed_jobs", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "connected_at", kind: "message", T: Timestamp },
+    { no: 8, name: "last_seen_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Worker {
+    return new Worker().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Worker {
+    return new Worker().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Worker {
+    return new Worker().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Worker | PlainMessage<Worker> | undefined, b: Worker | PlainMessage<Worker> | undefined): boolean {
+    return proto3.util.equals(Worker, a, b);
+  }
+}
+
+/**
+ * SimulationStep is a chain started or a link executed in a simulation.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.SimulationStep
+ */
+export class SimulationStep extends Message<SimulationStep> {
+  /**
+   * Identifier of the chain.
+   *
+   * @generated from field: string chain_id = 1;
+   */
+  chainId = "";
+
+  /**
+   * Identifier of the link, empty when the step starts a chain.
+   *
+   * @generated from field: string link_id = 2;
+   */
+  linkId = "";
+
+  /**
+   * Manager of the link, e.g. "linkTaskManagerFiles".
+   *
+   * @generated from field: string manager = 3;
+   */
+  manager = "";
+
+  /**
+   * Description of the chain or the link.
+   *
+   * @generated from field: string description = 4;
+   */
+  description = "";
+
+  /**
+   * Watched directory that started the chain, if any.
+   *
+   * @generated from field: string watched_directory = 5;
+   */
+  watchedDirectory = "";
+
+  /**
+   * Client script run by the link, if any.
+   *
+   * @generated from field: string script = 6;
+   */
+  script = "";
+
+  /**
+   * Exit code returned by the client script.
+   *
+   * @generated from field: int32 exit_code = 7;
+   */
+  exitCode = 0;
+
+  /**
+   * How the decision point was resolved, if any.
+   *
+   * @generated from field: string decision = 8;
+   */
+  decision = "";
+
+  /**
+   * Choices available when the decision point blocks.
+   *
+   * @generated from field: repeated string choices = 9;
+   */
+  choices: string[] = [];
+
+  constructor(data?: PartialMessage<SimulationStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.SimulationStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "chain_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "link_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "manager", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "watched_directory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "script", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "decision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "choices", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulationStep {
+    return new SimulationStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulationStep {
+    return new SimulationStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulationStep {
+    return new SimulationStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulationStep | PlainMessage<SimulationStep> | undefined, b: SimulationStep | PlainMessage<SimulationStep> | undefined): boolean {
+    return proto3.util.equals(SimulationStep, a, b);
+  }
+}
+
+/**
+ * SimulationExitCodes is a sequence of exit codes returned by a client script.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.SimulationExitCodes
+ */
+export class SimulationExitCodes extends Message<SimulationExitCodes> {
+  /**
+   * @generated from field: repeated int32 codes = 1;
+   */
+  codes: number[] = [];
+
+  constructor(data?: PartialMessage<SimulationExitCodes>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.SimulationExitCodes";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "codes", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulationExitCodes {
+    return new SimulationExitCodes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulationExitCodes {
+    return new SimulationExitCodes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulationExitCodes {
+    return new SimulationExitCodes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulationExitCodes | PlainMessage<SimulationExitCodes> | undefined, b: SimulationExitCodes | PlainMessage<SimulationExitCodes> | undefined): boolean {
+    return proto3.util.equals(SimulationExitCodes, a, b);
+  }
+}
+
+/**
+ * UnresolvedBranch is a branch of a link that cannot be followed.
+ *
+ * @generated from message archivematica.ccp.admin.v1beta1.UnresolvedBranch
+ */
+export class UnresolvedBranch extends Message<UnresolvedBranch> {
+  /**
+   * Identifier of the link.
+   *
+   * @generated from field: string link_id = 1;
+   */
+  linkId = "";
+
+  /**
+   * Description of the link.
+   *
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * Exit code of the branch, not set for the fallback branch.
+   *
+   * @generated from field: optional int32 exit_code = 3;
+   */
+  exitCode?: number;
+
+  /**
+   * Why the branch cannot be followed.
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<UnresolvedBranch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.UnresolvedBranch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "link_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnresolvedBranch {
+    return new UnresolvedBranch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnresolvedBranch {
+    return new UnresolvedBranch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnresolvedBranch {
+    return new UnresolvedBranch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnresolvedBranch | PlainMessage<UnresolvedBranch> | undefined, b: UnresolvedBranch | PlainMessage<UnresolvedBranch> | undefined): boolean {
+    return proto3.util.equals(UnresolvedBranch, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.RetentionCandidate
+ */
+export class RetentionCandidate extends Message<RetentionCandidate> {
+  /**
+   * Path of the entry relative to the shared directory, e.g.
+   * "failed/images-4f6a1e5e-1a41-4a5b-9d2e-2c3f7c3ad5b0".
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * Size of the entry in bytes, including its contents.
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * Most recent modification timestamp of the entry or its contents.
+   *
+   * @generated from field: google.protobuf.Timestamp modified_at = 3;
+   */
+  modifiedAt?: Timestamp;
+
+  /**
+   * Identifier of the package found at the end of the name of the entry
+   * (UUIDv4), it may be empty.
+   *
+   * @generated from field: string package_id = 4;
+   */
+  packageId = "";
+
+  /**
+   * Rule that selected the entry, i.e. "max-age" or "max-size".
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<RetentionCandidate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.RetentionCandidate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "modified_at", kind: "message", T: Timestamp },
+    { no: 4, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionCandidate {
+    return new RetentionCandidate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionCandidate {
+    return new RetentionCandidate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionCandidate {
+    return new RetentionCandidate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetentionCandidate | PlainMessage<RetentionCandidate> | undefined, b: RetentionCandidate | PlainMessage<RetentionCandidate> | undefined): boolean {
+    return proto3.util.equals(RetentionCandidate, a, b);
+  }
+}
+
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.ProcessingConfigField
  */
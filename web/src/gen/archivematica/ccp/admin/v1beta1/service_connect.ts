@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreatePackageRequest, CreatePackageResponse, ListDecisionsRequest, ListDecisionsResponse, ListPackagesRequest, ListPackagesResponse, ListProcessingConfigurationFieldsRequest, ListProcessingConfigurationFieldsResponse, ReadPackageRequest, ReadPackageResponse, ResolveDecisionRequest, ResolveDecisionResponse } from "./service_pb.js";
+import { CancelPackageRequest, CancelPackageResponse, CreatePackageRequest, CreatePackageResponse, CreateWebhookRequest, CreateWebhookResponse, DeleteWebhookRequest, DeleteWebhookResponse, DrainServerRequest, DrainServerResponse, ListDecisionsRequest, ListDecisionsResponse, ListPackagesRequest, ListPackagesResponse, ListProcessingConfigurationFieldsRequest, ListProcessingConfigurationFieldsResponse, ListQueuedPackagesRequest, ListQueuedPackagesResponse, ListRetentionCandidatesRequest, ListRetentionCandidatesResponse, ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse, ListWebhooksRequest, ListWebhooksResponse, ListWorkersRequest, ListWorkersResponse, PromotePackageRequest, PromotePackageResponse, ReadPackageRequest, ReadPackageResponse, ResolveDecisionRequest, ResolveDecisionResponse, RetryPackageRequest, RetryPackageResponse, SimulateWorkflowRequest, SimulateWorkflowResponse, StartReingestRequest, StartReingestResponse, WatchPackageRequest, WatchPackageResponse, WatchPackagesRequest, WatchPackagesResponse } from "./service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 import { ApproveJobRequest, ApproveJobResponse, ApprovePartialReingestRequest, ApprovePartialReingestResponse, ApproveTransferByPathRequest, ApproveTransferByPathResponse } from "./deprecated_pb.js";
 
@@ -26,6 +26,20 @@ export const AdminService = {
       O: CreatePackageResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * StartReingest starts the reingest of an AIP. Full reingests start a new
+     * transfer with the contents of the AIP. Partial and metadata-only reingests
+     * place the AIP in the reingestAIP watched directory and process it as a SIP
+     * with the identifier of the AIP, without awaiting the approval.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.StartReingest
+     */
+    startReingest: {
+      name: "StartReingest",
+      I: StartReingestRequest,
+      O: StartReingestResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ReadPackage ...
      *
@@ -90,6 +104,178 @@ export const AdminService = {
       O: ListProcessingConfigurationFieldsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListQueuedPackages lists the packages waiting to be processed in the order
+     * they are going to be picked by the scheduler.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListQueuedPackages
+     */
+    listQueuedPackages: {
+      name: "ListQueuedPackages",
+      I: ListQueuedPackagesRequest,
+      O: ListQueuedPackagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PromotePackage changes the position of a queued package. It updates the
+     * priority of the package when one is given, otherwise it moves the package
+     * to the front of the queue.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.PromotePackage
+     */
+    promotePackage: {
+      name: "PromotePackage",
+      I: PromotePackageRequest,
+      O: PromotePackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CancelPackage stops the processing of a package. Running jobs are
+     * abandoned, the package is removed from the processing queues and it is
+     * marked as failed. The package directory is moved to the rejected directory
+     * when requested.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.CancelPackage
+     */
+    cancelPackage: {
+      name: "CancelPackage",
+      I: CancelPackageRequest,
+      O: CancelPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RetryPackage restarts the processing of a failed package at the link that
+     * failed, or at the given link. The chain context is reloaded from the store.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.RetryPackage
+     */
+    retryPackage: {
+      name: "RetryPackage",
+      I: RetryPackageRequest,
+      O: RetryPackageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchPackages streams the processing events of all packages as they
+     * happen, e.g. when a job starts or when a decision is awaiting.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.WatchPackages
+     */
+    watchPackages: {
+      name: "WatchPackages",
+      I: WatchPackagesRequest,
+      O: WatchPackagesResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * WatchPackage streams the processing events of a given package.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.WatchPackage
+     */
+    watchPackage: {
+      name: "WatchPackage",
+      I: WatchPackageRequest,
+      O: WatchPackageResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * CreateWebhook subscribes a URL to the package events.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: CreateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWebhooks lists the webhooks, including those set in the server
+     * configuration.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListWebhooks
+     */
+    listWebhooks: {
+      name: "ListWebhooks",
+      I: ListWebhooksRequest,
+      O: ListWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteWebhook deletes a webhook. Webhooks set in the server configuration
+     * cannot be deleted.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWebhookDeliveries lists the most recent webhook deliveries, first the
+     * most recent.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListWebhookDeliveries
+     */
+    listWebhookDeliveries: {
+      name: "ListWebhookDeliveries",
+      I: ListWebhookDeliveriesRequest,
+      O: ListWebhookDeliveriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWorkers lists the MCPClient workers connected to the job server.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListWorkers
+     */
+    listWorkers: {
+      name: "ListWorkers",
+      I: ListWorkersRequest,
+      O: ListWorkersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SimulateWorkflow walks the workflow like a package would be processed
+     * without running any job. Decisions are resolved with the processing
+     * configuration and client scripts return the exit codes requested.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.SimulateWorkflow
+     */
+    simulateWorkflow: {
+      name: "SimulateWorkflow",
+      I: SimulateWorkflowRequest,
+      O: SimulateWorkflowResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DrainServer stops picking new packages and shuts down the server once the
+     * active packages reach their next link boundary or decision point, or once
+     * the drain timeout of the server expires. The packages are resumed when the
+     * server is started again.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.DrainServer
+     */
+    drainServer: {
+      name: "DrainServer",
+      I: DrainServerRequest,
+      O: DrainServerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListRetentionCandidates lists the entries of the shared directory that
+     * the retention policies would remove if they were applied now. Nothing is
+     * removed.
+     *
+     * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ListRetentionCandidates
+     */
+    listRetentionCandidates: {
+      name: "ListRetentionCandidates",
+      I: ListRetentionCandidatesRequest,
+      O: ListRetentionCandidatesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ApproveJob ...
      *
@@ -122,6 +308,7 @@ export const AdminService = {
      * ApprovePartialReingest
      *
      * It replaces `approvePartialReingest` (_approve_partial_reingest_handler).
+     * Use StartReingest instead.
      *
      * @generated from rpc archivematica.ccp.admin.v1beta1.AdminService.ApprovePartialReingest
      * @deprecated
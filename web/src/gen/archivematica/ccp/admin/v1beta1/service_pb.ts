@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, StringValue } from "@bufbuild/protobuf";
-import { Choice, Decision, Package, PackageType, ProcessingConfigField, TransferType } from "./admin_pb.js";
+import { Int32Value, Message, proto3, StringValue } from "@bufbuild/protobuf";
+import { Choice, Decision, Package, PackageEvent, PackageType, ProcessingConfigField, QueuedPackage, ReingestMode, RetentionCandidate, SimulationExitCodes, SimulationStep, TransferType, UnresolvedBranch, Webhook, WebhookDelivery, WebhookEvent, Worker } from "./admin_pb.js";
 
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.CreatePackageRequest
@@ -63,6 +63,15 @@ export class CreatePackageRequest extends Message<CreatePackageRequest> {
    */
   processingConfig = "";
 
+  /**
+   * Priority of the package in the processing queue. Packages with a higher
+   * priority are processed first, packages with the same priority are
+   * processed in order of arrival. Defaults to zero.
+   *
+   * @generated from field: int32 priority = 8;
+   */
+  priority = 0;
+
   constructor(data?: PartialMessage<CreatePackageRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -78,6 +87,7 @@ export class CreatePackageRequest extends Message<CreatePackageRequest> {
     { no: 5, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 6, name: "metadata_set_id", kind: "message", T: StringValue },
     { no: 7, name: "processing_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePackageRequest {
@@ -136,6 +146,102 @@ export class CreatePackageResponse extends Message<CreatePackageResponse> {
   }
 }
 
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.StartReingestRequest
+ */
+export class StartReingestRequest extends Message<StartReingestRequest> {
+  /**
+   * Location of the uncompressed AIP as <location_uuid>:<relative_path>, like
+   * the paths of CreatePackageRequest. The name of the AIP must end with its
+   * identifier, e.g. "images-4f6a1e5e-1a41-4a5b-9d2e-2c3f7c3ad5b0".
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * @generated from field: archivematica.ccp.admin.v1beta1.ReingestMode mode = 2;
+   */
+  mode = ReingestMode.UNSPECIFIED;
+
+  /**
+   * Name of the processing configuration, default to "default".
+   *
+   * @generated from field: string processing_config = 3;
+   */
+  processingConfig = "";
+
+  constructor(data?: PartialMessage<StartReingestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.StartReingestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mode", kind: "enum", T: proto3.getEnumType(ReingestMode) },
+    { no: 3, name: "processing_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartReingestRequest {
+    return new StartReingestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartReingestRequest {
+    return new StartReingestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartReingestRequest {
+    return new StartReingestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartReingestRequest | PlainMessage<StartReingestRequest> | undefined, b: StartReingestRequest | PlainMessage<StartReingestRequest> | undefined): boolean {
+    return proto3.util.equals(StartReingestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.StartReingestResponse
+ */
+export class StartReingestResponse extends Message<StartReingestResponse> {
+  /**
+   * Identifier of the package (UUIDv4): the identifier of the AIP for partial
+   * and metadata-only reingests or the identifier of the new transfer for full
+   * reingests.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<StartReingestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.StartReingestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartReingestResponse {
+    return new StartReingestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartReingestResponse {
+    return new StartReingestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartReingestResponse {
+    return new StartReingestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartReingestResponse | PlainMessage<StartReingestResponse> | undefined, b: StartReingestResponse | PlainMessage<StartReingestResponse> | undefined): boolean {
+    return proto3.util.equals(StartReingestResponse, a, b);
+  }
+}
+
 /**
  * @generated from message archivematica.ccp.admin.v1beta1.ReadPackageRequest
  */
@@ -515,3 +621,1163 @@ export class ListProcessingConfigurationFieldsResponse extends Message<ListProce
   }
 }
 
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListQueuedPackagesRequest
+ */
+export class ListQueuedPackagesRequest extends Message<ListQueuedPackagesRequest> {
+  constructor(data?: PartialMessage<ListQueuedPackagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListQueuedPackagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueuedPackagesRequest {
+    return new ListQueuedPackagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueuedPackagesRequest {
+    return new ListQueuedPackagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueuedPackagesRequest {
+    return new ListQueuedPackagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueuedPackagesRequest | PlainMessage<ListQueuedPackagesRequest> | undefined, b: ListQueuedPackagesRequest | PlainMessage<ListQueuedPackagesRequest> | undefined): boolean {
+    return proto3.util.equals(ListQueuedPackagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListQueuedPackagesResponse
+ */
+export class ListQueuedPackagesResponse extends Message<ListQueuedPackagesResponse> {
+  /**
+   * Ordered list of queued packages, the first item is the next to be picked.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.QueuedPackage package = 1;
+   */
+  package: QueuedPackage[] = [];
+
+  constructor(data?: PartialMessage<ListQueuedPackagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListQueuedPackagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "package", kind: "message", T: QueuedPackage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListQueuedPackagesResponse {
+    return new ListQueuedPackagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListQueuedPackagesResponse {
+    return new ListQueuedPackagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListQueuedPackagesResponse {
+    return new ListQueuedPackagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListQueuedPackagesResponse | PlainMessage<ListQueuedPackagesResponse> | undefined, b: ListQueuedPackagesResponse | PlainMessage<ListQueuedPackagesResponse> | undefined): boolean {
+    return proto3.util.equals(ListQueuedPackagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.PromotePackageRequest
+ */
+export class PromotePackageRequest extends Message<PromotePackageRequest> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * New priority of the package. The package is moved to the front of the
+   * queue when not given.
+   *
+   * @generated from field: google.protobuf.Int32Value priority = 2;
+   */
+  priority?: number;
+
+  constructor(data?: PartialMessage<PromotePackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.PromotePackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "priority", kind: "message", T: Int32Value },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotePackageRequest {
+    return new PromotePackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotePackageRequest {
+    return new PromotePackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotePackageRequest {
+    return new PromotePackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotePackageRequest | PlainMessage<PromotePackageRequest> | undefined, b: PromotePackageRequest | PlainMessage<PromotePackageRequest> | undefined): boolean {
+    return proto3.util.equals(PromotePackageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.PromotePackageResponse
+ */
+export class PromotePackageResponse extends Message<PromotePackageResponse> {
+  /**
+   * Position of the package in the queue after the change, starting at zero.
+   *
+   * @generated from field: int32 position = 1;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<PromotePackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.PromotePackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotePackageResponse {
+    return new PromotePackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotePackageResponse {
+    return new PromotePackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotePackageResponse {
+    return new PromotePackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotePackageResponse | PlainMessage<PromotePackageResponse> | undefined, b: PromotePackageResponse | PlainMessage<PromotePackageResponse> | undefined): boolean {
+    return proto3.util.equals(PromotePackageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.CancelPackageRequest
+ */
+export class CancelPackageRequest extends Message<CancelPackageRequest> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Move the package directory to the rejected directory.
+   *
+   * @generated from field: bool reject = 2;
+   */
+  reject = false;
+
+  constructor(data?: PartialMessage<CancelPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.CancelPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reject", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelPackageRequest {
+    return new CancelPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelPackageRequest {
+    return new CancelPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelPackageRequest {
+    return new CancelPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelPackageRequest | PlainMessage<CancelPackageRequest> | undefined, b: CancelPackageRequest | PlainMessage<CancelPackageRequest> | undefined): boolean {
+    return proto3.util.equals(CancelPackageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.CancelPackageResponse
+ */
+export class CancelPackageResponse extends Message<CancelPackageResponse> {
+  constructor(data?: PartialMessage<CancelPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.CancelPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelPackageResponse {
+    return new CancelPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelPackageResponse {
+    return new CancelPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelPackageResponse {
+    return new CancelPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelPackageResponse | PlainMessage<CancelPackageResponse> | undefined, b: CancelPackageResponse | PlainMessage<CancelPackageResponse> | undefined): boolean {
+    return proto3.util.equals(CancelPackageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.RetryPackageRequest
+ */
+export class RetryPackageRequest extends Message<RetryPackageRequest> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Identifier of the link where processing restarts. The link that failed is
+   * used when not given.
+   *
+   * @generated from field: string link_id = 2;
+   */
+  linkId = "";
+
+  /**
+   * Priority of the package in the processing queue.
+   *
+   * @generated from field: int32 priority = 3;
+   */
+  priority = 0;
+
+  constructor(data?: PartialMessage<RetryPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.RetryPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "link_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryPackageRequest {
+    return new RetryPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryPackageRequest {
+    return new RetryPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryPackageRequest {
+    return new RetryPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryPackageRequest | PlainMessage<RetryPackageRequest> | undefined, b: RetryPackageRequest | PlainMessage<RetryPackageRequest> | undefined): boolean {
+    return proto3.util.equals(RetryPackageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.RetryPackageResponse
+ */
+export class RetryPackageResponse extends Message<RetryPackageResponse> {
+  /**
+   * Identifier of the link where processing restarts.
+   *
+   * @generated from field: string link_id = 1;
+   */
+  linkId = "";
+
+  constructor(data?: PartialMessage<RetryPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.RetryPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "link_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryPackageResponse {
+    return new RetryPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryPackageResponse {
+    return new RetryPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryPackageResponse {
+    return new RetryPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryPackageResponse | PlainMessage<RetryPackageResponse> | undefined, b: RetryPackageResponse | PlainMessage<RetryPackageResponse> | undefined): boolean {
+    return proto3.util.equals(RetryPackageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.WatchPackagesRequest
+ */
+export class WatchPackagesRequest extends Message<WatchPackagesRequest> {
+  constructor(data?: PartialMessage<WatchPackagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.WatchPackagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchPackagesRequest {
+    return new WatchPackagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchPackagesRequest {
+    return new WatchPackagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchPackagesRequest {
+    return new WatchPackagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchPackagesRequest | PlainMessage<WatchPackagesRequest> | undefined, b: WatchPackagesRequest | PlainMessage<WatchPackagesRequest> | undefined): boolean {
+    return proto3.util.equals(WatchPackagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.WatchPackagesResponse
+ */
+export class WatchPackagesResponse extends Message<WatchPackagesResponse> {
+  /**
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageEvent event = 1;
+   */
+  event?: PackageEvent;
+
+  constructor(data?: PartialMessage<WatchPackagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.WatchPackagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: PackageEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchPackagesResponse {
+    return new WatchPackagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchPackagesResponse {
+    return new WatchPackagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchPackagesResponse {
+    return new WatchPackagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchPackagesResponse | PlainMessage<WatchPackagesResponse> | undefined, b: WatchPackagesResponse | PlainMessage<WatchPackagesResponse> | undefined): boolean {
+    return proto3.util.equals(WatchPackagesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.WatchPackageRequest
+ */
+export class WatchPackageRequest extends Message<WatchPackageRequest> {
+  /**
+   * Identifier of the package (UUIDv4).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<WatchPackageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.WatchPackageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchPackageRequest {
+    return new WatchPackageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchPackageRequest {
+    return new WatchPackageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchPackageRequest {
+    return new WatchPackageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchPackageRequest | PlainMessage<WatchPackageRequest> | undefined, b: WatchPackageRequest | PlainMessage<WatchPackageRequest> | undefined): boolean {
+    return proto3.util.equals(WatchPackageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.WatchPackageResponse
+ */
+export class WatchPackageResponse extends Message<WatchPackageResponse> {
+  /**
+   * @generated from field: archivematica.ccp.admin.v1beta1.PackageEvent event = 1;
+   */
+  event?: PackageEvent;
+
+  constructor(data?: PartialMessage<WatchPackageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.WatchPackageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: PackageEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchPackageResponse {
+    return new WatchPackageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchPackageResponse {
+    return new WatchPackageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchPackageResponse {
+    return new WatchPackageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchPackageResponse | PlainMessage<WatchPackageResponse> | undefined, b: WatchPackageResponse | PlainMessage<WatchPackageResponse> | undefined): boolean {
+    return proto3.util.equals(WatchPackageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.CreateWebhookRequest
+ */
+export class CreateWebhookRequest extends Message<CreateWebhookRequest> {
+  /**
+   * URL where the events are delivered.
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * Secret used to sign the payloads. It is stored unencrypted in the
+   * database and it is never returned by the API.
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret = "";
+
+  /**
+   * Events delivered, all events are delivered when empty.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.WebhookEvent events = 3;
+   */
+  events: WebhookEvent[] = [];
+
+  constructor(data?: PartialMessage<CreateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.CreateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "events", kind: "enum", T: proto3.getEnumType(WebhookEvent), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined, b: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.CreateWebhookResponse
+ */
+export class CreateWebhookResponse extends Message<CreateWebhookResponse> {
+  /**
+   * @generated from field: archivematica.ccp.admin.v1beta1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<CreateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.CreateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined, b: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWebhooksRequest
+ */
+export class ListWebhooksRequest extends Message<ListWebhooksRequest> {
+  constructor(data?: PartialMessage<ListWebhooksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWebhooksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksRequest {
+    return new ListWebhooksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined, b: ListWebhooksRequest | PlainMessage<ListWebhooksRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWebhooksResponse
+ */
+export class ListWebhooksResponse extends Message<ListWebhooksResponse> {
+  /**
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[] = [];
+
+  constructor(data?: PartialMessage<ListWebhooksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWebhooksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhooks", kind: "message", T: Webhook, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhooksResponse {
+    return new ListWebhooksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined, b: ListWebhooksResponse | PlainMessage<ListWebhooksResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhooksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.DeleteWebhookRequest
+ */
+export class DeleteWebhookRequest extends Message<DeleteWebhookRequest> {
+  /**
+   * Identifier of the webhook (UUID).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.DeleteWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined, b: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.DeleteWebhookResponse
+ */
+export class DeleteWebhookResponse extends Message<DeleteWebhookResponse> {
+  constructor(data?: PartialMessage<DeleteWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.DeleteWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined, b: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWebhookDeliveriesRequest
+ */
+export class ListWebhookDeliveriesRequest extends Message<ListWebhookDeliveriesRequest> {
+  /**
+   * Identifier of the webhook. Deliveries of all webhooks are listed when not
+   * given.
+   *
+   * @generated from field: string webhook_id = 1;
+   */
+  webhookId = "";
+
+  /**
+   * Maximum number of deliveries listed, defaults to 100.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListWebhookDeliveriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWebhookDeliveriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesRequest {
+    return new ListWebhookDeliveriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeliveriesRequest | PlainMessage<ListWebhookDeliveriesRequest> | undefined, b: ListWebhookDeliveriesRequest | PlainMessage<ListWebhookDeliveriesRequest> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeliveriesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWebhookDeliveriesResponse
+ */
+export class ListWebhookDeliveriesResponse extends Message<ListWebhookDeliveriesResponse> {
+  /**
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[] = [];
+
+  constructor(data?: PartialMessage<ListWebhookDeliveriesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWebhookDeliveriesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deliveries", kind: "message", T: WebhookDelivery, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWebhookDeliveriesResponse {
+    return new ListWebhookDeliveriesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWebhookDeliveriesResponse | PlainMessage<ListWebhookDeliveriesResponse> | undefined, b: ListWebhookDeliveriesResponse | PlainMessage<ListWebhookDeliveriesResponse> | undefined): boolean {
+    return proto3.util.equals(ListWebhookDeliveriesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWorkersRequest
+ */
+export class ListWorkersRequest extends Message<ListWorkersRequest> {
+  constructor(data?: PartialMessage<ListWorkersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWorkersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkersRequest {
+    return new ListWorkersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkersRequest {
+    return new ListWorkersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkersRequest {
+    return new ListWorkersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWorkersRequest | PlainMessage<ListWorkersRequest> | undefined, b: ListWorkersRequest | PlainMessage<ListWorkersRequest> | undefined): boolean {
+    return proto3.util.equals(ListWorkersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListWorkersResponse
+ */
+export class ListWorkersResponse extends Message<ListWorkersResponse> {
+  /**
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.Worker workers = 1;
+   */
+  workers: Worker[] = [];
+
+  constructor(data?: PartialMessage<ListWorkersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListWorkersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workers", kind: "message", T: Worker, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkersResponse {
+    return new ListWorkersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkersResponse {
+    return new ListWorkersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkersResponse {
+    return new ListWorkersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWorkersResponse | PlainMessage<ListWorkersResponse> | undefined, b: ListWorkersResponse | PlainMessage<ListWorkersResponse> | undefined): boolean {
+    return proto3.util.equals(ListWorkersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.SimulateWorkflowRequest
+ */
+export class SimulateWorkflowRequest extends Message<SimulateWorkflowRequest> {
+  /**
+   * Watched directory where the package is placed, e.g.
+   * "activeTransfers/standardTransfer".
+   *
+   * @generated from field: string watched_directory = 1;
+   */
+  watchedDirectory = "";
+
+  /**
+   * Type of transfer, the simulation starts like a transfer submitted via
+   * CreatePackage.
+   *
+   * @generated from field: archivematica.ccp.admin.v1beta1.TransferType transfer_type = 2;
+   */
+  transferType = TransferType.UNSPECIFIED;
+
+  /**
+   * Name of the processing configuration, defaults to "default".
+   *
+   * @generated from field: string processing_config = 3;
+   */
+  processingConfig = "";
+
+  /**
+   * Exit codes returned by the client scripts, indexed by link identifier or
+   * by script name, e.g. "hasPackages_v0.0". Consecutive runs return the
+   * codes in order and the last one is repeated. Scripts exit with zero by
+   * default.
+   *
+   * @generated from field: map<string, archivematica.ccp.admin.v1beta1.SimulationExitCodes> exit_codes = 4;
+   */
+  exitCodes: { [key: string]: SimulationExitCodes } = {};
+
+  /**
+   * Continue past the decision points that would block using their first
+   * choice.
+   *
+   * @generated from field: bool resolve_blocking = 5;
+   */
+  resolveBlocking = false;
+
+  constructor(data?: PartialMessage<SimulateWorkflowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.SimulateWorkflowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "watched_directory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "transfer_type", kind: "enum", T: proto3.getEnumType(TransferType) },
+    { no: 3, name: "processing_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "exit_codes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: SimulationExitCodes} },
+    { no: 5, name: "resolve_blocking", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulateWorkflowRequest {
+    return new SimulateWorkflowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulateWorkflowRequest {
+    return new SimulateWorkflowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulateWorkflowRequest {
+    return new SimulateWorkflowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulateWorkflowRequest | PlainMessage<SimulateWorkflowRequest> | undefined, b: SimulateWorkflowRequest | PlainMessage<SimulateWorkflowRequest> | undefined): boolean {
+    return proto3.util.equals(SimulateWorkflowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.SimulateWorkflowResponse
+ */
+export class SimulateWorkflowResponse extends Message<SimulateWorkflowResponse> {
+  /**
+   * Path taken through the workflow.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.SimulationStep steps = 1;
+   */
+  steps: SimulationStep[] = [];
+
+  /**
+   * Decision points that would block the package.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.SimulationStep blocking = 2;
+   */
+  blocking: SimulationStep[] = [];
+
+  /**
+   * Branches of the links visited that cannot be followed.
+   *
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.UnresolvedBranch unresolved = 3;
+   */
+  unresolved: UnresolvedBranch[] = [];
+
+  /**
+   * How the simulation ended, i.e. "done", "blocked", "dead-end" or "loop".
+   *
+   * @generated from field: string outcome = 4;
+   */
+  outcome = "";
+
+  /**
+   * Explanation of the outcome.
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<SimulateWorkflowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.SimulateWorkflowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "steps", kind: "message", T: SimulationStep, repeated: true },
+    { no: 2, name: "blocking", kind: "message", T: SimulationStep, repeated: true },
+    { no: 3, name: "unresolved", kind: "message", T: UnresolvedBranch, repeated: true },
+    { no: 4, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulateWorkflowResponse {
+    return new SimulateWorkflowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulateWorkflowResponse {
+    return new SimulateWorkflowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulateWorkflowResponse {
+    return new SimulateWorkflowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulateWorkflowResponse | PlainMessage<SimulateWorkflowResponse> | undefined, b: SimulateWorkflowResponse | PlainMessage<SimulateWorkflowResponse> | undefined): boolean {
+    return proto3.util.equals(SimulateWorkflowResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.DrainServerRequest
+ */
+export class DrainServerRequest extends Message<DrainServerRequest> {
+  constructor(data?: PartialMessage<DrainServerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.DrainServerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DrainServerRequest {
+    return new DrainServerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DrainServerRequest {
+    return new DrainServerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DrainServerRequest {
+    return new DrainServerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DrainServerRequest | PlainMessage<DrainServerRequest> | undefined, b: DrainServerRequest | PlainMessage<DrainServerRequest> | undefined): boolean {
+    return proto3.util.equals(DrainServerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.DrainServerResponse
+ */
+export class DrainServerResponse extends Message<DrainServerResponse> {
+  /**
+   * Number of packages being processed when the drain was requested.
+   *
+   * @generated from field: int32 active_packages = 1;
+   */
+  activePackages = 0;
+
+  /**
+   * Number of packages awaiting a decision when the drain was requested.
+   *
+   * @generated from field: int32 awaiting_packages = 2;
+   */
+  awaitingPackages = 0;
+
+  /**
+   * Number of queued packages, they are resumed after the restart.
+   *
+   * @generated from field: int32 queued_packages = 3;
+   */
+  queuedPackages = 0;
+
+  constructor(data?: PartialMessage<DrainServerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.DrainServerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "active_packages", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "awaiting_packages", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "queued_packages", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DrainServerResponse {
+    return new DrainServerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DrainServerResponse {
+    return new DrainServerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DrainServerResponse {
+    return new DrainServerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DrainServerResponse | PlainMessage<DrainServerResponse> | undefined, b: DrainServerResponse | PlainMessage<DrainServerResponse> | undefined): boolean {
+    return proto3.util.equals(DrainServerResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListRetentionCandidatesRequest
+ */
+export class ListRetentionCandidatesRequest extends Message<ListRetentionCandidatesRequest> {
+  constructor(data?: PartialMessage<ListRetentionCandidatesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListRetentionCandidatesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRetentionCandidatesRequest {
+    return new ListRetentionCandidatesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRetentionCandidatesRequest {
+    return new ListRetentionCandidatesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRetentionCandidatesRequest {
+    return new ListRetentionCandidatesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRetentionCandidatesRequest | PlainMessage<ListRetentionCandidatesRequest> | undefined, b: ListRetentionCandidatesRequest | PlainMessage<ListRetentionCandidatesRequest> | undefined): boolean {
+    return proto3.util.equals(ListRetentionCandidatesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.admin.v1beta1.ListRetentionCandidatesResponse
+ */
+export class ListRetentionCandidatesResponse extends Message<ListRetentionCandidatesResponse> {
+  /**
+   * @generated from field: repeated archivematica.ccp.admin.v1beta1.RetentionCandidate candidates = 1;
+   */
+  candidates: RetentionCandidate[] = [];
+
+  constructor(data?: PartialMessage<ListRetentionCandidatesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.admin.v1beta1.ListRetentionCandidatesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "candidates", kind: "message", T: RetentionCandidate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRetentionCandidatesResponse {
+    return new ListRetentionCandidatesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRetentionCandidatesResponse {
+    return new ListRetentionCandidatesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRetentionCandidatesResponse {
+    return new ListRetentionCandidatesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRetentionCandidatesResponse | PlainMessage<ListRetentionCandidatesResponse> | undefined, b: ListRetentionCandidatesResponse | PlainMessage<ListRetentionCandidatesResponse> | undefined): boolean {
+    return proto3.util.equals(ListRetentionCandidatesResponse, a, b);
+  }
+}
+
@@ -0,0 +1,58 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file archivematica/ccp/worker/v1beta1/service.proto (package archivematica.ccp.worker.v1beta1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CompleteBatchRequest, CompleteBatchResponse, PullBatchRequest, PullBatchResponse, ReportProgressRequest, ReportProgressResponse } from "./service_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * WorkerService is used by the workers to pull batches of tasks, report their
+ * progress and their results. Workers only pull batches when they are ready to
+ * process them.
+ *
+ * @generated from service archivematica.ccp.worker.v1beta1.WorkerService
+ */
+export const WorkerService = {
+  typeName: "archivematica.ccp.worker.v1beta1.WorkerService",
+  methods: {
+    /**
+     * PullBatch waits for a batch of tasks that the worker can process. The
+     * response has no batch when none is available within the waiting time.
+     *
+     * @generated from rpc archivematica.ccp.worker.v1beta1.WorkerService.PullBatch
+     */
+    pullBatch: {
+      name: "PullBatch",
+      I: PullBatchRequest,
+      O: PullBatchResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReportProgress streams the progress of a batch. The batch is considered
+     * abandoned by the server when the stream fails with NOT_FOUND, e.g. when
+     * the batch times out, and the worker should stop processing it.
+     *
+     * @generated from rpc archivematica.ccp.worker.v1beta1.WorkerService.ReportProgress
+     */
+    reportProgress: {
+      name: "ReportProgress",
+      I: ReportProgressRequest,
+      O: ReportProgressResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * CompleteBatch reports the results of a batch, or why the worker failed to
+     * process it.
+     *
+     * @generated from rpc archivematica.ccp.worker.v1beta1.WorkerService.CompleteBatch
+     */
+    completeBatch: {
+      name: "CompleteBatch",
+      I: CompleteBatchRequest,
+      O: CompleteBatchResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,292 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file archivematica/ccp/worker/v1beta1/service.proto (package archivematica.ccp.worker.v1beta1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Duration, Message, proto3 } from "@bufbuild/protobuf";
+import { Batch, TaskResult } from "./worker_pb.js";
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.PullBatchRequest
+ */
+export class PullBatchRequest extends Message<PullBatchRequest> {
+  /**
+   * Identifier of the worker, e.g. its hostname.
+   *
+   * @generated from field: string worker_id = 1;
+   */
+  workerId = "";
+
+  /**
+   * Functions that the worker can run.
+   *
+   * @generated from field: repeated string functions = 2;
+   */
+  functions: string[] = [];
+
+  /**
+   * Maximum waiting time, defaults to 30 seconds.
+   *
+   * @generated from field: google.protobuf.Duration wait = 3;
+   */
+  wait?: Duration;
+
+  constructor(data?: PartialMessage<PullBatchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.PullBatchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "functions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "wait", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullBatchRequest {
+    return new PullBatchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullBatchRequest {
+    return new PullBatchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullBatchRequest {
+    return new PullBatchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullBatchRequest | PlainMessage<PullBatchRequest> | undefined, b: PullBatchRequest | PlainMessage<PullBatchRequest> | undefined): boolean {
+    return proto3.util.equals(PullBatchRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.PullBatchResponse
+ */
+export class PullBatchResponse extends Message<PullBatchResponse> {
+  /**
+   * Batch assigned to the worker, unset when none was available.
+   *
+   * @generated from field: archivematica.ccp.worker.v1beta1.Batch batch = 1;
+   */
+  batch?: Batch;
+
+  constructor(data?: PartialMessage<PullBatchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.PullBatchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "batch", kind: "message", T: Batch },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullBatchResponse {
+    return new PullBatchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullBatchResponse {
+    return new PullBatchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullBatchResponse {
+    return new PullBatchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullBatchResponse | PlainMessage<PullBatchResponse> | undefined, b: PullBatchResponse | PlainMessage<PullBatchResponse> | undefined): boolean {
+    return proto3.util.equals(PullBatchResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.ReportProgressRequest
+ */
+export class ReportProgressRequest extends Message<ReportProgressRequest> {
+  /**
+   * Identifier of the batch.
+   *
+   * @generated from field: string batch_id = 1;
+   */
+  batchId = "";
+
+  /**
+   * Identifier of the worker.
+   *
+   * @generated from field: string worker_id = 2;
+   */
+  workerId = "";
+
+  /**
+   * Number of tasks completed so far.
+   *
+   * @generated from field: int32 completed_tasks = 3;
+   */
+  completedTasks = 0;
+
+  /**
+   * Optional message describing the progress.
+   *
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ReportProgressRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.ReportProgressRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "batch_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "completed_tasks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportProgressRequest {
+    return new ReportProgressRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportProgressRequest {
+    return new ReportProgressRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportProgressRequest {
+    return new ReportProgressRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportProgressRequest | PlainMessage<ReportProgressRequest> | undefined, b: ReportProgressRequest | PlainMessage<ReportProgressRequest> | undefined): boolean {
+    return proto3.util.equals(ReportProgressRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.ReportProgressResponse
+ */
+export class ReportProgressResponse extends Message<ReportProgressResponse> {
+  constructor(data?: PartialMessage<ReportProgressResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.ReportProgressResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportProgressResponse {
+    return new ReportProgressResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportProgressResponse {
+    return new ReportProgressResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportProgressResponse {
+    return new ReportProgressResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportProgressResponse | PlainMessage<ReportProgressResponse> | undefined, b: ReportProgressResponse | PlainMessage<ReportProgressResponse> | undefined): boolean {
+    return proto3.util.equals(ReportProgressResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.CompleteBatchRequest
+ */
+export class CompleteBatchRequest extends Message<CompleteBatchRequest> {
+  /**
+   * Identifier of the batch.
+   *
+   * @generated from field: string batch_id = 1;
+   */
+  batchId = "";
+
+  /**
+   * Identifier of the worker.
+   *
+   * @generated from field: string worker_id = 2;
+   */
+  workerId = "";
+
+  /**
+   * Results of the tasks, they're ignored when error is set.
+   *
+   * @generated from field: repeated archivematica.ccp.worker.v1beta1.TaskResult results = 3;
+   */
+  results: TaskResult[] = [];
+
+  /**
+   * Reason why the worker failed to process the batch. The batch may be
+   * retried according to the retry policy of the script.
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<CompleteBatchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.CompleteBatchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "batch_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "results", kind: "message", T: TaskResult, repeated: true },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteBatchRequest {
+    return new CompleteBatchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteBatchRequest {
+    return new CompleteBatchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteBatchRequest {
+    return new CompleteBatchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteBatchRequest | PlainMessage<CompleteBatchRequest> | undefined, b: CompleteBatchRequest | PlainMessage<CompleteBatchRequest> | undefined): boolean {
+    return proto3.util.equals(CompleteBatchRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message archivematica.ccp.worker.v1beta1.CompleteBatchResponse
+ */
+export class CompleteBatchResponse extends Message<CompleteBatchResponse> {
+  constructor(data?: PartialMessage<CompleteBatchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.CompleteBatchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteBatchResponse {
+    return new CompleteBatchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteBatchResponse {
+    return new CompleteBatchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteBatchResponse {
+    return new CompleteBatchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteBatchResponse | PlainMessage<CompleteBatchResponse> | undefined, b: CompleteBatchResponse | PlainMessage<CompleteBatchResponse> | undefined): boolean {
+    return proto3.util.equals(CompleteBatchResponse, a, b);
+  }
+}
+
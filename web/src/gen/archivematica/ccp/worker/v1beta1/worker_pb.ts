@@ -0,0 +1,203 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file archivematica/ccp/worker/v1beta1/worker.proto (package archivematica.ccp.worker.v1beta1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * Batch is a group of tasks of the same client script.
+ *
+ * @generated from message archivematica.ccp.worker.v1beta1.Batch
+ */
+export class Batch extends Message<Batch> {
+  /**
+   * Identifier of the batch (UUID).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Function that runs the client script, e.g. "normalize_v1.0".
+   *
+   * @generated from field: string function = 2;
+   */
+  function = "";
+
+  /**
+   * Tasks of the batch.
+   *
+   * @generated from field: repeated archivematica.ccp.worker.v1beta1.Task tasks = 3;
+   */
+  tasks: Task[] = [];
+
+  constructor(data?: PartialMessage<Batch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.Batch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "function", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tasks", kind: "message", T: Task, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Batch {
+    return new Batch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Batch {
+    return new Batch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Batch {
+    return new Batch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Batch | PlainMessage<Batch> | undefined, b: Batch | PlainMessage<Batch> | undefined): boolean {
+    return proto3.util.equals(Batch, a, b);
+  }
+}
+
+/**
+ * Task is a single invocation of a client script.
+ *
+ * @generated from message archivematica.ccp.worker.v1beta1.Task
+ */
+export class Task extends Message<Task> {
+  /**
+   * Identifier of the task (UUID).
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Arguments of the client script.
+   *
+   * @generated from field: string arguments = 2;
+   */
+  arguments = "";
+
+  /**
+   * Whether the output of the script is expected in the result.
+   *
+   * @generated from field: bool wants_output = 3;
+   */
+  wantsOutput = false;
+
+  /**
+   * Creation timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Task>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.Task";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "arguments", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "wants_output", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Task {
+    return new Task().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Task {
+    return new Task().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Task {
+    return new Task().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Task | PlainMessage<Task> | undefined, b: Task | PlainMessage<Task> | undefined): boolean {
+    return proto3.util.equals(Task, a, b);
+  }
+}
+
+/**
+ * TaskResult is the outcome of a task.
+ *
+ * @generated from message archivematica.ccp.worker.v1beta1.TaskResult
+ */
+export class TaskResult extends Message<TaskResult> {
+  /**
+   * Identifier of the task (UUID).
+   *
+   * @generated from field: string task_id = 1;
+   */
+  taskId = "";
+
+  /**
+   * Exit code of the client script.
+   *
+   * @generated from field: int32 exit_code = 2;
+   */
+  exitCode = 0;
+
+  /**
+   * Standard output of the client script.
+   *
+   * @generated from field: string stdout = 3;
+   */
+  stdout = "";
+
+  /**
+   * Standard error of the client script.
+   *
+   * @generated from field: string stderr = 4;
+   */
+  stderr = "";
+
+  /**
+   * Completion timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp finished_at = 5;
+   */
+  finishedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<TaskResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "archivematica.ccp.worker.v1beta1.TaskResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "task_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "stdout", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "stderr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "finished_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TaskResult {
+    return new TaskResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TaskResult {
+    return new TaskResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TaskResult {
+    return new TaskResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TaskResult | PlainMessage<TaskResult> | undefined, b: TaskResult | PlainMessage<TaskResult> | undefined): boolean {
+    return proto3.util.equals(TaskResult, a, b);
+  }
+}
+